@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// configFile is the name of the config in the application's root
+const configFile = "shadcn-gomponents.json"
+
+// config is where components are copied to, and which were
+type config struct {
+	Module   string   `json:"module"`   // Module path of the application
+	Dir      string   `json:"dir"`      // Directory components are copied to, relative to the root
+	Packages []string `json:"packages"` // Upstream packages copied, e.g. "pkg/button" and "lib/icons"
+}
+
+// init writes the config
+func (c cli) init(args []string) error {
+	set := c.flags("init")
+	module := set.String("module", "", "module path of the application (default: from go.mod)")
+	dir := set.String("dir", "components", "directory to copy components to, relative to the module root")
+	force := set.Bool("force", false, "replace an existing config")
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+	if set.NArg() > 0 {
+		set.Usage()
+		return errUsage
+	}
+
+	if !*force {
+		if _, err := os.Stat(filepath.Join(c.dir, configFile)); err == nil {
+			return fmt.Errorf("%s already exists, use -force to replace it", configFile)
+		}
+	}
+	if *module == "" {
+		m, err := modulePath(filepath.Join(c.dir, "go.mod"))
+		if err != nil {
+			return fmt.Errorf("finding the module path, set it with -module: %w", err)
+		}
+		*module = m
+	}
+	cleaned := filepath.ToSlash(filepath.Clean(*dir))
+	if !filepath.IsLocal(cleaned) {
+		return fmt.Errorf("dir %q must be inside the module", *dir)
+	}
+
+	cfg := config{Module: *module, Dir: cleaned, Packages: []string{}}
+	if err := c.save(cfg); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, "Wrote %s: components go to %s, imported as %s/<component>\n", configFile, cfg.Dir, path.Join(cfg.Module, cfg.Dir))
+	return nil
+}
+
+// load reads the config
+func (c cli) load() (config, error) {
+	var cfg config
+	b, err := os.ReadFile(filepath.Join(c.dir, configFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, fmt.Errorf("%s not found, run shadcn-gomponents init first", configFile)
+	}
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("reading %s: %w", configFile, err)
+	}
+	if cfg.Module == "" || cfg.Dir == "" {
+		return cfg, fmt.Errorf("%s needs a module and a dir", configFile)
+	}
+	return cfg, nil
+}
+
+// save writes the config, with its packages sorted
+func (c cli) save(cfg config) error {
+	slices.Sort(cfg.Packages)
+	cfg.Packages = slices.Compact(cfg.Packages)
+	b, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(c.dir, configFile), append(b, '\n'), 0o644)
+}
+
+// localDir returns the directory, relative to the root, the upstream package
+// pkg is copied to: components into the config's dir and lib packages into
+// its lib directory
+func (cfg config) localDir(pkg string) string {
+	if name, ok := strings.CutPrefix(pkg, "pkg/"); ok {
+		return path.Join(cfg.Dir, name)
+	}
+	return path.Join(cfg.Dir, pkg)
+}
+
+// importPath returns the import path of the copy of the upstream package pkg
+func (cfg config) importPath(pkg string) string {
+	return path.Join(cfg.Module, cfg.localDir(pkg))
+}
+
+// modulePath reads the module path from a go.mod file
+func modulePath(gomod string) (string, error) {
+	b, err := os.ReadFile(gomod)
+	if err != nil {
+		return "", err
+	}
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(s.Text()), "module"); ok && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	return "", fmt.Errorf("no module directive in %s", gomod)
+}
@@ -0,0 +1,92 @@
+// Command shadcn-gomponents copies components into an application, so their
+// code can be changed like the application's own, as with shadcn/ui:
+//
+//	shadcn-gomponents init -dir internal/ui
+//	shadcn-gomponents add datatable dialog
+//	shadcn-gomponents diff
+//
+// init writes shadcn-gomponents.json with the application's module path and
+// the directory components are copied to. add copies the sources of
+// components, and of the components and lib packages they import, rewriting
+// their import paths to the copies. diff shows how the sources of this
+// version of the command differ from the copies, to pick up upstream
+// changes after updating it.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	shadcn "github.com/rizome-dev/shadcn-gomponents"
+)
+
+const usage = `Usage:
+
+	shadcn-gomponents init [-module path] [-dir dir] [-force]
+	shadcn-gomponents add [-overwrite] component...
+	shadcn-gomponents diff [component...]
+
+Commands:
+
+	init	write shadcn-gomponents.json with the module and directory to copy components to
+	add	copy components and their dependencies into the directory
+	diff	show upstream changes against the copied components
+`
+
+// errUsage reports wrong arguments, after which the usage is printed
+var errUsage = errors.New("invalid arguments")
+
+func main() {
+	c := cli{dir: ".", source: shadcn.Sources, stdout: os.Stdout, stderr: os.Stderr}
+	if err := c.run(os.Args[1:]); err != nil {
+		if !errors.Is(err, errUsage) && !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "shadcn-gomponents:", err)
+		}
+		os.Exit(2)
+	}
+}
+
+// cli runs commands against the application in dir
+type cli struct {
+	dir    string // Root of the application, where go.mod and the config are
+	source fs.FS  // Upstream sources, laid out like this module
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// run runs the command in args
+func (c cli) run(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, usage)
+		return errUsage
+	}
+
+	switch args[0] {
+	case "init":
+		return c.init(args[1:])
+	case "add":
+		return c.add(args[1:])
+	case "diff":
+		return c.diff(args[1:])
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(c.stdout, usage)
+		return nil
+	default:
+		fmt.Fprintf(c.stderr, "unknown command %q\n\n%s", args[0], usage)
+		return errUsage
+	}
+}
+
+// flags creates the flag set of a command
+func (c cli) flags(name string) *flag.FlagSet {
+	set := flag.NewFlagSet("shadcn-gomponents "+name, flag.ContinueOnError)
+	set.SetOutput(c.stderr)
+	set.Usage = func() {
+		fmt.Fprint(c.stderr, usage)
+	}
+	return set
+}
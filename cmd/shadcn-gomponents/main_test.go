@@ -0,0 +1,162 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	shadcn "github.com/rizome-dev/shadcn-gomponents"
+)
+
+// testCLI runs commands in a new module example.com/app
+func testCLI(t *testing.T) (cli, *strings.Builder) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n\ngo 1.24\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var out strings.Builder
+	return cli{dir: dir, source: shadcn.Sources, stdout: &out, stderr: &out}, &out
+}
+
+func run(t *testing.T, c cli, args ...string) {
+	t.Helper()
+	if err := c.run(args); err != nil {
+		t.Fatalf("%s: %v", strings.Join(args, " "), err)
+	}
+}
+
+func read(t *testing.T, c cli, name string) string {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join(c.dir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestInit(t *testing.T) {
+	c, _ := testCLI(t)
+	run(t, c, "init", "-dir", "internal/ui")
+
+	cfg, err := c.load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Module != "example.com/app" || cfg.Dir != "internal/ui" {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+	if err := c.run([]string{"init"}); err == nil {
+		t.Error("expected init to refuse to replace the config")
+	}
+	if err := c.run([]string{"init", "-force", "-dir", "../ui"}); err == nil {
+		t.Error("expected a dir outside the module to be rejected")
+	}
+}
+
+func TestAdd(t *testing.T) {
+	c, out := testCLI(t)
+	run(t, c, "init", "-dir", "ui")
+	run(t, c, "add", "datatable")
+
+	cfg, err := c.load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range []string{"pkg/datatable", "pkg/button", "pkg/checkbox", "pkg/input", "pkg/table", "lib", "lib/icons"} {
+		if !slices.Contains(cfg.Packages, pkg) {
+			t.Errorf("expected %s to be added, got %v", pkg, cfg.Packages)
+		}
+	}
+
+	src := read(t, c, "ui/datatable/datatable.go")
+	for _, want := range []string{`"example.com/app/ui/lib"`, `"example.com/app/ui/button"`} {
+		if !strings.Contains(src, want) {
+			t.Errorf("expected imports rewritten to %s", want)
+		}
+	}
+	if strings.Contains(src, shadcn.Module) {
+		t.Error("expected no imports of the upstream module")
+	}
+	if _, err := os.Stat(filepath.Join(c.dir, "ui/button/catalog.go")); err == nil {
+		t.Error("expected the catalog registration to be left out")
+	}
+	if _, err := os.Stat(filepath.Join(c.dir, "ui/button/button_test.go")); err == nil {
+		t.Error("expected tests to be left out")
+	}
+	if !strings.Contains(out.String(), "maragu.dev/gomponents") {
+		t.Errorf("expected the external imports to be listed, got %s", out)
+	}
+
+	if err := c.run([]string{"add", "nope"}); err == nil || !strings.Contains(err.Error(), "button") {
+		t.Errorf("expected an unknown component to list the components, got %v", err)
+	}
+}
+
+func TestAddKeepsLocalChanges(t *testing.T) {
+	c, out := testCLI(t)
+	run(t, c, "init")
+	run(t, c, "add", "button")
+
+	path := filepath.Join(c.dir, "components/button/button.go")
+	changed := strings.Replace(read(t, c, "components/button/button.go"), "rounded-md", "rounded-full", 1)
+	if err := os.WriteFile(path, []byte(changed), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+	run(t, c, "add", "badge")
+	if !strings.Contains(out.String(), "Unchanged components/lib\n") {
+		t.Errorf("expected the shared lib to be unchanged, got %s", out)
+	}
+
+	out.Reset()
+	run(t, c, "add", "button")
+	if !strings.Contains(out.String(), "Skipped components/button") || read(t, c, "components/button/button.go") != changed {
+		t.Errorf("expected local changes to be kept, got %s", out)
+	}
+
+	run(t, c, "add", "-overwrite", "button")
+	if read(t, c, "components/button/button.go") == changed {
+		t.Error("expected -overwrite to replace local changes")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	c, out := testCLI(t)
+	run(t, c, "init")
+	run(t, c, "add", "badge")
+
+	out.Reset()
+	run(t, c, "diff")
+	if out.String() != "No upstream changes.\n" {
+		t.Errorf("expected no changes, got %s", out)
+	}
+
+	path := filepath.Join(c.dir, "components/badge/badge.go")
+	src := read(t, c, "components/badge/badge.go")
+	if err := os.WriteFile(path, []byte(strings.Replace(src, "rounded-md", "rounded-full", 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(c.dir, "components/badge/example.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+	run(t, c, "diff", "badge")
+	for _, want := range []string{
+		"--- local/components/badge/badge.go\n+++ upstream/pkg/badge/badge.go\n",
+		"rounded-full",
+		"+++ upstream/pkg/badge/example.go\n@@ -0,0 +1,",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected the diff to contain %q, got %s", want, out)
+		}
+	}
+
+	if err := c.run([]string{"diff", "button"}); err == nil {
+		t.Error("expected diffing a component that wasn't added to fail")
+	}
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	shadcn "github.com/rizome-dev/shadcn-gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/diff"
+)
+
+// add copies components and the packages they import
+func (c cli) add(args []string) error {
+	set := c.flags("add")
+	overwrite := set.Bool("overwrite", false, "replace copies that were changed locally")
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+	if set.NArg() == 0 {
+		set.Usage()
+		return errUsage
+	}
+
+	cfg, err := c.load()
+	if err != nil {
+		return err
+	}
+	var requested []string
+	for _, name := range set.Args() {
+		pkg, err := c.component(name)
+		if err != nil {
+			return err
+		}
+		requested = append(requested, pkg)
+	}
+	pkgs, external, err := c.resolve(requested)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range pkgs {
+		dir := cfg.localDir(pkg)
+		files, err := c.render(cfg, pkg)
+		if err != nil {
+			return err
+		}
+		changed, err := c.changed(dir, files)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case len(changed) == 0:
+			fmt.Fprintf(c.stdout, "Unchanged %s\n", dir)
+		case !*overwrite && slices.Contains(cfg.Packages, pkg):
+			fmt.Fprintf(c.stdout, "Skipped %s: differs from upstream, see shadcn-gomponents diff or use -overwrite to replace it\n", dir)
+			continue
+		case !*overwrite && c.exists(dir, changed):
+			fmt.Fprintf(c.stdout, "Skipped %s: it has files of the same names, use -overwrite to replace them\n", dir)
+			continue
+		default:
+			if err := c.write(dir, files, changed); err != nil {
+				return err
+			}
+			fmt.Fprintf(c.stdout, "Added %s\n", dir)
+		}
+		cfg.Packages = append(cfg.Packages, pkg)
+	}
+	if err := c.save(cfg); err != nil {
+		return err
+	}
+
+	if len(external) > 0 {
+		fmt.Fprintf(c.stdout, "\nThe components import %s.\nRun go mod tidy to add them to go.mod.\n", strings.Join(external, ", "))
+	}
+	return nil
+}
+
+// diff shows how the upstream sources of copied packages differ from the
+// copies, as a patch turning the copies into upstream
+func (c cli) diff(args []string) error {
+	set := c.flags("diff")
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := c.load()
+	if err != nil {
+		return err
+	}
+	pkgs := cfg.Packages
+	if set.NArg() > 0 {
+		pkgs = nil
+		for _, name := range set.Args() {
+			pkg, err := c.component(name)
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(cfg.Packages, pkg) {
+				return fmt.Errorf("%s hasn't been added", name)
+			}
+			pkgs = append(pkgs, pkg)
+		}
+	}
+
+	differs := false
+	for _, pkg := range pkgs {
+		dir := cfg.localDir(pkg)
+		files, err := c.render(cfg, pkg)
+		if err != nil {
+			return err
+		}
+		for _, name := range slices.Sorted(maps.Keys(files)) {
+			local, err := os.ReadFile(filepath.Join(c.dir, dir, name))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+			if d := diff.Unified(path.Join("local", dir, name), path.Join("upstream", pkg, name), string(local), string(files[name])); d != "" {
+				fmt.Fprint(c.stdout, d)
+				differs = true
+			}
+		}
+	}
+	if !differs {
+		fmt.Fprintln(c.stdout, "No upstream changes.")
+	}
+	return nil
+}
+
+// component returns the upstream package of a component name, e.g.
+// "pkg/button" for "button"
+func (c cli) component(name string) (string, error) {
+	pkg := path.Join("pkg", name)
+	if _, err := fs.Stat(c.source, pkg); err != nil || name == "all" || strings.Contains(name, "/") {
+		names, err := c.components()
+		if err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("unknown component %q, choose from: %s", name, strings.Join(names, ", "))
+	}
+	return pkg, nil
+}
+
+// components lists the names of the upstream components
+func (c cli) components() ([]string, error) {
+	entries, err := fs.ReadDir(c.source, "pkg")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() && e.Name() != "all" {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
+
+// resolve returns the upstream packages needed by pkgs, themselves
+// included, and the other modules' packages they import
+func (c cli) resolve(pkgs []string) (resolved []string, external []string, err error) {
+	seen := map[string]bool{}
+	queue := slices.Clone(pkgs)
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+		if seen[pkg] {
+			continue
+		}
+		seen[pkg] = true
+		resolved = append(resolved, pkg)
+
+		files, err := c.files(pkg)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, name := range slices.Sorted(maps.Keys(files)) {
+			f, err := parser.ParseFile(token.NewFileSet(), name, files[name], parser.ImportsOnly)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, imp := range f.Imports {
+				p, _ := strconv.Unquote(imp.Path.Value)
+				if dep, ok := upstream(p); ok {
+					queue = append(queue, dep)
+				} else if first, _, _ := strings.Cut(p, "/"); strings.Contains(first, ".") && !slices.Contains(external, p) {
+					external = append(external, p)
+				}
+			}
+		}
+	}
+	slices.Sort(external)
+	return resolved, external, nil
+}
+
+// files returns the upstream sources of pkg by file name, without tests and
+// the registration in the upstream catalog
+func (c cli) files(pkg string) (map[string][]byte, error) {
+	entries, err := fs.ReadDir(c.source, pkg)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if strings.HasPrefix(pkg, "pkg/") && name == "catalog.go" {
+			continue
+		}
+		src, err := fs.ReadFile(c.source, path.Join(pkg, name))
+		if err != nil {
+			return nil, err
+		}
+		files[name] = src
+	}
+	return files, nil
+}
+
+// render returns the sources of pkg as copied for cfg, with the imports of
+// upstream packages rewritten to their copies
+func (c cli) render(cfg config, pkg string) (map[string][]byte, error) {
+	files, err := c.files(pkg)
+	if err != nil {
+		return nil, err
+	}
+	for name, src := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, name, src, parser.ImportsOnly)
+		if err != nil {
+			return nil, err
+		}
+		// Replace from the end so earlier offsets stay valid
+		out := src
+		for _, imp := range slices.Backward(f.Imports) {
+			p, _ := strconv.Unquote(imp.Path.Value)
+			dep, ok := upstream(p)
+			if !ok {
+				continue
+			}
+			start, end := fset.Position(imp.Path.Pos()).Offset, fset.Position(imp.Path.End()).Offset
+			out = slices.Concat(out[:start], []byte(strconv.Quote(cfg.importPath(dep))), out[end:])
+		}
+		files[name] = out
+	}
+	return files, nil
+}
+
+// changed returns the names of files that differ from the copies in dir
+func (c cli) changed(dir string, files map[string][]byte) ([]string, error) {
+	var changed []string
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		local, err := os.ReadFile(filepath.Join(c.dir, dir, name))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if err != nil || !bytes.Equal(local, files[name]) {
+			changed = append(changed, name)
+		}
+	}
+	return changed, nil
+}
+
+// write writes the named files into dir
+func (c cli) write(dir string, files map[string][]byte, names []string) error {
+	dir = filepath.Join(c.dir, filepath.FromSlash(dir))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upstream returns the package of this module an import path refers to,
+// e.g. "lib/icons"
+func upstream(importPath string) (string, bool) {
+	pkg, ok := strings.CutPrefix(importPath, shadcn.Module+"/")
+	return pkg, ok && (pkg == "lib" || strings.HasPrefix(pkg, "lib/") || strings.HasPrefix(pkg, "pkg/"))
+}
+
+// exists reports whether any of the named files exists in dir
+func (c cli) exists(dir string, names []string) bool {
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(c.dir, dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
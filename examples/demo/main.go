@@ -1,57 +1,46 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	. "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/accordion"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	"github.com/rizome-dev/shadcn-gomponents/lib/flash"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
+	"github.com/rizome-dev/shadcn-gomponents/lib/otp"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	"github.com/rizome-dev/shadcn-gomponents/lib/stream"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/all"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/alert"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/alertdialog"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/aspectratio"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/avatar"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/badge"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/breadcrumb"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/calendar"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/card"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/carousel"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/chart"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/checkbox"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/collapsible"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/combobox"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/command"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/contextmenu"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/dialog"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/drawer"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/dropdownmenu"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/form"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/hovercard"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/input"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/inputotp"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/label"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/menubar"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/navigationmenu"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/pagination"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/popover"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/progress"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/radio"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/resizable"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/scrollarea"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/selector"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/separator"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/sheet"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/sidebar"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/skeleton"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/slider"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/sonner"
-	switchcomp "github.com/rizome-dev/shadcn-gomponents/pkg/switch"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/table"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/tabs"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/textarea"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/toast"
-	"github.com/rizome-dev/shadcn-gomponents/pkg/toggle"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/togglegroup"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/tooltip"
 )
@@ -70,149 +59,34 @@ func main() {
 		ComponentsListPage().Render(w)
 	})
 
-	// Individual component example pages
-	mux.HandleFunc("/accordion", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Accordion", accordion.Example()).Render(w)
-	})
-	mux.HandleFunc("/alert", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Alert", alert.Example()).Render(w)
-	})
-	mux.HandleFunc("/alert-dialog", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Alert Dialog", alertdialog.Example()).Render(w)
-	})
-	mux.HandleFunc("/aspect-ratio", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Aspect Ratio", aspectratio.Example()).Render(w)
-	})
-	mux.HandleFunc("/avatar", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Avatar", avatar.Example()).Render(w)
-	})
-	mux.HandleFunc("/badge", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Badge", badge.Example()).Render(w)
-	})
-	mux.HandleFunc("/breadcrumb", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Breadcrumb", breadcrumb.Example()).Render(w)
-	})
-	mux.HandleFunc("/button", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Button", button.Example()).Render(w)
-	})
-	mux.HandleFunc("/calendar", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Calendar", calendar.Example()).Render(w)
-	})
-	mux.HandleFunc("/card", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Card", card.Examples()).Render(w)
-	})
-	mux.HandleFunc("/carousel", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Carousel", carousel.ExampleBasic()).Render(w)
-	})
-	mux.HandleFunc("/chart", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Chart", chart.ExampleLineChart()).Render(w)
-	})
-	mux.HandleFunc("/checkbox", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Checkbox", checkbox.Example()).Render(w)
-	})
-	mux.HandleFunc("/collapsible", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Collapsible", collapsible.Example()).Render(w)
-	})
-	mux.HandleFunc("/command", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Command", command.Example()).Render(w)
-	})
-	mux.HandleFunc("/context-menu", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Context Menu", contextmenu.Example()).Render(w)
-	})
-	mux.HandleFunc("/dialog", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Dialog", dialog.Example()).Render(w)
-	})
-	mux.HandleFunc("/drawer", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Drawer", drawer.Example()).Render(w)
-	})
-	mux.HandleFunc("/dropdown-menu", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Dropdown Menu", dropdownmenu.Example()).Render(w)
-	})
-	mux.HandleFunc("/form", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Form", form.Example()).Render(w)
-	})
-	mux.HandleFunc("/hover-card", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Hover Card", hovercard.Example()).Render(w)
-	})
-	mux.HandleFunc("/input", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Input", input.Examples()).Render(w)
-	})
-	mux.HandleFunc("/input-otp", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Input OTP", inputotp.Examples()).Render(w)
-	})
-	mux.HandleFunc("/label", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Label", label.Examples()).Render(w)
-	})
-	mux.HandleFunc("/menubar", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Menubar", menubar.Examples()).Render(w)
-	})
-	mux.HandleFunc("/navigation-menu", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Navigation Menu", navigationmenu.Examples()).Render(w)
-	})
+	// Individual component example pages, from the catalog. Pagination and
+	// Sonner render from the request, so they have their own pages.
+	for _, c := range catalog.Components() {
+		if c.Slug == "pagination" || c.Slug == "sonner" {
+			continue
+		}
+		mux.HandleFunc("/"+c.Slug, func(w http.ResponseWriter, r *http.Request) {
+			ComponentPage(c.Name, catalogExamples(c)).Render(w)
+		})
+	}
 	mux.HandleFunc("/pagination", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Pagination", pagination.Examples()).Render(w)
-	})
-	mux.HandleFunc("/popover", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Popover", popover.Example()).Render(w)
-	})
-	mux.HandleFunc("/progress", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Progress", progress.Example()).Render(w)
-	})
-	mux.HandleFunc("/radio-group", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Radio Group", radio.Example()).Render(w)
-	})
-	mux.HandleFunc("/resizable", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Resizable", resizable.Example()).Render(w)
-	})
-	mux.HandleFunc("/scroll-area", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Scroll Area", scrollarea.Example()).Render(w)
-	})
-	mux.HandleFunc("/select", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Select", selector.Example()).Render(w)
-	})
-	mux.HandleFunc("/separator", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Separator", separator.Example()).Render(w)
-	})
-	mux.HandleFunc("/sheet", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Sheet", sheet.Example()).Render(w)
-	})
-	mux.HandleFunc("/sidebar", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Sidebar", sidebar.Examples()).Render(w)
-	})
-	mux.HandleFunc("/skeleton", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Skeleton", skeleton.Example()).Render(w)
-	})
-	mux.HandleFunc("/slider", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Slider", slider.Examples()).Render(w)
+		ComponentPage("Pagination", Div(pagination.Examples(), pagination.ExampleResults(r))).Render(w)
 	})
 	mux.HandleFunc("/sonner", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Sonner", sonner.Examples()).Render(w)
-	})
-	mux.HandleFunc("/switch", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Switch", switchcomp.Example()).Render(w)
-	})
-	mux.HandleFunc("/table", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Table", table.Examples()).Render(w)
+		messages, _ := flash.Drain(w, r)
+		ComponentPage("Sonner", Group{
+			sonner.Examples(),
+			sonner.Toaster(sonner.ToasterProps{ID: "flash-toaster", CloseButton: true, Flash: messages}),
+		}).Render(w)
 	})
-	mux.HandleFunc("/tabs", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Tabs", tabs.Example()).Render(w)
-	})
-	mux.HandleFunc("/textarea", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Textarea", textarea.Example()).Render(w)
-	})
-	mux.HandleFunc("/toast", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Toast", toast.Example()).Render(w)
-	})
-	mux.HandleFunc("/toggle", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Toggle", toggle.Example()).Render(w)
-	})
-	mux.HandleFunc("/toggle-group", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Toggle Group", togglegroup.Example()).Render(w)
-	})
-	mux.HandleFunc("/tooltip", func(w http.ResponseWriter, r *http.Request) {
-		ComponentPage("Tooltip", tooltip.Example()).Render(w)
+	mux.HandleFunc("POST /sonner/flash", func(w http.ResponseWriter, r *http.Request) {
+		_ = flash.Add(w, r, flash.Success, "Settings saved", "Your preferences were updated.")
+		http.Redirect(w, r, "/sonner", http.StatusSeeOther)
 	})
 
+	// Gallery with each component's props playground and example source
+	router.Mount(router.WithPrefix(router.ServeMux(mux), "/gallery"), catalog.Gallery{Layout: BasePage})
+
 	// Static file server
 	fs := http.FileServer(http.Dir("../../public"))
 	mux.Handle("/public/", http.StripPrefix("/public/", fs))
@@ -220,111 +94,218 @@ func main() {
 	// Register HTMX handlers for components
 	registerHTMXHandlers(mux)
 
+	// The demo is a development server, so log accessibility violations in
+	// every page and HTMX response. The checker buffers HTML, so the streamed
+	// page is served around it.
+	root := http.NewServeMux()
+	root.Handle("/", a11y.Middleware(a11y.Options{}, nil)(mux))
+	root.HandleFunc("/streaming", func(w http.ResponseWriter, r *http.Request) {
+		s := stream.New()
+		s.Timeout = 3 * time.Second
+		_ = s.Render(w, r, ComponentPage("Streaming", streamingExample(s)))
+	})
+
 	// Start server
 	fmt.Println("Demo app running at http://localhost:8080")
 	fmt.Println("View all components at http://localhost:8080/components/")
-	log.Fatal(http.ListenAndServe(":8080", mux))
+	log.Fatal(http.ListenAndServe(":8080", root))
 }
 
 // registerHTMXHandlers registers all HTMX endpoints for interactive components
 func registerHTMXHandlers(mux *http.ServeMux) {
-	// NOTE: Some components (alertdialog, carousel, chart, inputotp, menubar, navigationmenu)
+	// NOTE: Some components (alertdialog, carousel, chart, menubar, navigationmenu)
 	// don't have handler registration functions yet
 
-	// Calendar handlers
-	calendar.CalendarHandlers(mux)
-
-	// Collapsible handlers
-	collapsible.CollapsibleHandlers(mux)
-
-	// Command handlers
-	command.CommandHandlers(mux)
-
-	// Context Menu handlers
-	contextmenu.ContextMenuHandlers(mux)
-
-	// Dialog handlers
-	dialog.DialogHandlers(mux)
-
-	// Drawer handlers
-	drawer.DrawerHandlers(mux)
-
-	// Dropdown Menu handlers
-	dropdownmenu.DropdownMenuHandlers(mux)
-
-	// Hover Card handlers
-	hovercard.HoverCardHandlers(mux)
-
-	// Popover handlers
-	popover.PopoverHandlers(mux)
-
-	// Sheet handlers
-	sheet.SheetHandlers(mux)
-
-	// Sidebar handlers
-	sidebar.SidebarHandlers(mux, sidebar.Props{
-		Side: "left",
-		Open: true,
-	}, sidebar.HTMXProps{
-		ID:         "demo-sidebar",
-		TogglePath: "/htmx/sidebar/toggle",
-		StatePath:  "/htmx/sidebar/state",
-	})
+	// One-time codes are logged instead of emailed
+	otpIssuer := &otp.Issuer{
+		Sender: otp.SenderFunc(func(ctx context.Context, to, code string) error {
+			log.Printf("OTP for %s: %s", to, code)
+			return nil
+		}),
+	}
 
-	// Slider handlers
-	slider.SliderHandlers(mux, slider.Props{
-		Min:  0,
-		Max:  100,
-		Step: 1,
-		Value: []int{50},
-	}, slider.HTMXProps{
-		ID:         "demo-slider",
-		UpdatePath: "/htmx/slider/update",
-		DragPath:   "/htmx/slider/drag",
-		InitPath:   "/htmx/slider/init",
-	})
+	// Jobs behind the promise toasts
+	jobs := toast.NewJobs()
+	promiseProps := toast.PromiseHTMXProps{
+		StatusPath: "/htmx/toast/promise/status",
+		CancelPath: "/htmx/toast/promise/cancel",
+	}
 
-	// Sonner (toast) handlers
-	sonner.ToasterHandlers(mux, sonner.ToasterProps{
-		Position: sonner.PositionBottomRight,
-	}, sonner.HTMXToasterProps{
-		ID:         "demo-sonner",
-		AddPath:    "/htmx/sonner/add",
-		RemovePath: "/htmx/sonner/remove",
-		UpdatePath: "/htmx/sonner/update",
-	})
+	router.Mount(router.WithPrefix(router.ServeMux(mux), "/api/dropdown"), dropdownmenu.Handlers{})
+	router.Mount(router.ServeMux(mux),
+		calendar.Handlers{},
+		collapsible.Handlers{},
+		command.Handlers{},
+		contextmenu.Handlers{},
+		dialog.Handlers{},
+		drawer.Handlers{},
+		hovercard.Handlers{},
+		inputotp.Handlers{
+			HTMX:       inputotp.HTMXProps{ID: "otp-example", VerifyPath: "/api/otp/verify"},
+			ResendPath: "/api/otp/resend",
+			Verify: func(r *http.Request, code string) error {
+				return otpIssuer.Verify(r.Context(), "demo", code)
+			},
+			Resend: func(r *http.Request) (time.Duration, error) {
+				err := otpIssuer.Issue(r.Context(), "demo", "demo@example.com")
+				return otpIssuer.Cooldown, err
+			},
+		},
+		popover.Handlers{},
+		sheet.Handlers{},
+		sidebar.Handlers{
+			Props: sidebar.Props{
+				Side: "left",
+				Open: true,
+			},
+			HTMX: sidebar.HTMXProps{
+				ID:         "demo-sidebar",
+				TogglePath: "/htmx/sidebar/toggle",
+				StatePath:  "/htmx/sidebar/state",
+			},
+		},
+		slider.Handlers{
+			Props: slider.Props{
+				Min:   0,
+				Max:   100,
+				Step:  1,
+				Value: []float64{50},
+			},
+			HTMX: slider.HTMXProps{
+				ID:         "demo-slider",
+				UpdatePath: "/htmx/slider/update",
+				DragPath:   "/htmx/slider/drag",
+				InitPath:   "/htmx/slider/init",
+			},
+		},
+		sonner.Handlers{
+			Props: sonner.ToasterProps{
+				Position: sonner.PositionBottomRight,
+			},
+			HTMX: sonner.HTMXToasterProps{
+				ID:         "demo-sonner",
+				AddPath:    "/htmx/sonner/add",
+				RemovePath: "/htmx/sonner/remove",
+				UpdatePath: "/htmx/sonner/update",
+			},
+		},
+		table.Handlers{
+			HTMX: table.HTMXProps{
+				ID:           "demo-table",
+				LoadPath:     "/htmx/table/load",
+				SortPath:     "/htmx/table/sort",
+				SelectPath:   "/htmx/table/select",
+				FilterPath:   "/htmx/table/filter",
+				PaginatePath: "/htmx/table/page",
+			},
+		},
+		toast.Handlers{
+			HTMX: toast.HTMXProps{
+				ToasterID:   "demo-toast",
+				ShowPath:    "/htmx/toast/show",
+				DismissPath: "/htmx/toast/dismiss",
+			},
+		},
+		toast.PromiseHandlers{Jobs: jobs, HTMX: promiseProps},
+		router.Func(func(rt router.Router) {
+			// Starts a fake export that reports progress for a few seconds
+			rt.Handle(http.MethodPost, "/htmx/toast/promise", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				job := jobs.Start("Exporting...", func(ctx context.Context, job *toast.Job) (string, error) {
+					for i := 1; i <= 5; i++ {
+						select {
+						case <-time.After(time.Second):
+							job.Progress(i*20, fmt.Sprintf("Exported %d of 5 files", i))
+						case <-ctx.Done():
+							return "", ctx.Err()
+						}
+					}
+					return "Export ready", nil
+				})
+				toast.PromiseToast(job, promiseProps).Render(w)
+			}))
+		}),
+		togglegroup.Handlers{
+			Props: togglegroup.Props{
+				Type: "single",
+			},
+			HTMX: togglegroup.HTMXProps{
+				ID:         "demo-toggle-group",
+				TogglePath: "/htmx/toggle-group/toggle",
+				LoadPath:   "/htmx/toggle-group/load",
+			},
+		},
+		tooltip.Handlers{
+			HTMX: tooltip.HTMXProps{
+				ID:       "demo-tooltip",
+				ShowPath: "/htmx/tooltip/show",
+			},
+		},
+	)
+	router.Mount(router.ServeMux(mux), scrollarea.ExampleHandlers()...)
+	router.Mount(router.ServeMux(mux), combobox.ExampleHandlers()...)
 
-	// Table handlers
-	table.TableHandlers(mux, table.HTMXProps{
-		ID:           "demo-table",
-		LoadPath:     "/htmx/table/load",
-		SortPath:     "/htmx/table/sort",
-		SelectPath:   "/htmx/table/select",
-		FilterPath:   "/htmx/table/filter",
-		PaginatePath: "/htmx/table/page",
-	})
+	// Sidebar state persisted by the static provider script
+	mux.Handle("/htmx/sidebar/persist", sidebar.CookieState{}.Handler())
 
-	// Toast handlers
-	toast.ToastHandlers(mux, toast.HTMXProps{
-		ToasterID:   "demo-toast",
-		ShowPath:    "/htmx/toast/show",
-		DismissPath: "/htmx/toast/dismiss",
-	})
+	// Resizable panel layouts
+	mux.Handle("/htmx/resizable/layout", resizable.CookieState{}.Handler())
+}
 
-	// Toggle Group handlers
-	togglegroup.ToggleGroupHandlers(mux, togglegroup.Props{
-		Type: "single",
-	}, togglegroup.HTMXProps{
-		ID:         "demo-toggle-group",
-		TogglePath: "/htmx/toggle-group/toggle",
-		LoadPath:   "/htmx/toggle-group/load",
-	})
+// streamingExample renders a dashboard whose cards load after the page:
+// each one after a delay, one after the stream's timeout and one failing
+func streamingExample(s *stream.Stream) Node {
+	slow := func(delay time.Duration, content Node) stream.Loader {
+		return func(ctx context.Context) (Node, error) {
+			select {
+			case <-time.After(delay):
+				return content, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+	section := func(title string, body Node) Node {
+		return card.Card(
+			card.CardHeader(card.CardTitle(Text(title))),
+			card.CardContent(body),
+		)
+	}
 
-	// Tooltip handlers
-	tooltip.TooltipHandlers(mux, tooltip.Props{}, tooltip.HTMXProps{
-		ID:       "demo-tooltip",
-		ShowPath: "/htmx/tooltip/show",
-	})
+	return Div(Class("space-y-4"),
+		P(Class("text-sm text-muted-foreground"),
+			Text("The page is sent at once with skeletons; each card streams in when its query finishes."),
+		),
+		Div(Class("grid gap-4 md:grid-cols-2"),
+			section("Revenue", s.Defer(stream.Props{Placeholder: skeleton.TextLines(2)},
+				slow(500*time.Millisecond, P(Class("text-3xl font-bold"), Text("$45,231.89"))),
+			)),
+			section("Active users", s.Defer(stream.Props{Placeholder: skeleton.TextLines(2)},
+				slow(1500*time.Millisecond, P(Class("text-3xl font-bold"), Text("2,350"))),
+			)),
+			section("Recent orders", s.Defer(stream.Props{Placeholder: skeleton.ListComponent(3)},
+				slow(2500*time.Millisecond, Ul(Class("space-y-2 text-sm"),
+					Li(Text("#3210 — Olivia Martin — $1,999.00")),
+					Li(Text("#3209 — Jackson Lee — $39.00")),
+					Li(Text("#3208 — Isabella Nguyen — $299.00")),
+				)),
+			)),
+			section("Forecast", s.Defer(stream.Props{Placeholder: skeleton.Card()},
+				slow(10*time.Second, Text("Never shown: the forecast takes longer than the timeout")),
+			)),
+			section("Inventory", s.Defer(stream.Props{
+				Placeholder: skeleton.TextLines(3),
+				Fallback: func(err error) Node {
+					return alert.Destructive(
+						alert.Title(Text("Inventory unavailable")),
+						alert.Description(Text("The warehouse service didn't respond. Try again later.")),
+					)
+				},
+			}, func(ctx context.Context) (Node, error) {
+				time.Sleep(time.Second)
+				return nil, fmt.Errorf("warehouse service unavailable")
+			})),
+		),
+	)
 }
 
 // BasePage creates the base HTML structure
@@ -342,6 +323,7 @@ func BasePage(title string, content Node) Node {
 		Body(
 			Class("min-h-screen bg-background text-foreground"),
 			content,
+			floating.Script(),
 		),
 	)
 }
@@ -373,6 +355,22 @@ func ComponentPage(name string, example Node) Node {
 	)
 }
 
+// catalogExamples renders the examples of a catalog component one after
+// another
+func catalogExamples(c catalog.Component) Node {
+	if len(c.Examples) == 1 {
+		return c.Examples[0].Render()
+	}
+	return Div(Class("space-y-8 p-6"),
+		Map(c.Examples, func(ex catalog.Example) Node {
+			return Section(
+				H2(Class("text-lg font-semibold mb-4"), Text(ex.Name)),
+				ex.Render(),
+			)
+		}),
+	)
+}
+
 // DemoPage creates the main landing page
 func DemoPage() Node {
 	return BasePage("Home",
@@ -467,71 +465,18 @@ func DemoPage() Node {
 
 // ComponentsListPage shows all available components
 func ComponentsListPage() Node {
-	components := []struct {
+	type component struct {
 		Name        string
 		Description string
 		Path        string
 		Category    string
-	}{
-		// Layout
-		{"Aspect Ratio", "Displays content within a desired ratio", "/aspect-ratio", "Layout"},
-		{"Card", "Displays content in a card container", "/card", "Layout"},
-		{"Resizable", "Resizable panel groups", "/resizable", "Layout"},
-		{"Scroll Area", "Augments scrolling functionality", "/scroll-area", "Layout"},
-		{"Separator", "Visually separates content", "/separator", "Layout"},
-		
-		// Forms
-		{"Button", "Interactive button component", "/button", "Forms"},
-		{"Checkbox", "Checkbox input component", "/checkbox", "Forms"},
-		{"Form", "Form layout and validation", "/form", "Forms"},
-		{"Input", "Text input fields", "/input", "Forms"},
-		{"Input OTP", "One-time password input", "/input-otp", "Forms"},
-		{"Label", "Accessible label for inputs", "/label", "Forms"},
-		{"Radio Group", "Radio button groups", "/radio-group", "Forms"},
-		{"Select", "Select dropdown component", "/select", "Forms"},
-		{"Slider", "Input slider for ranges", "/slider", "Forms"},
-		{"Switch", "Toggle switch component", "/switch", "Forms"},
-		{"Textarea", "Multiline text input", "/textarea", "Forms"},
-		{"Toggle", "Toggle button component", "/toggle", "Forms"},
-		{"Toggle Group", "Group of toggle buttons", "/toggle-group", "Forms"},
-		
-		// Data Display
-		{"Avatar", "User avatar display", "/avatar", "Data Display"},
-		{"Badge", "Small count and labeling", "/badge", "Data Display"},
-		{"Calendar", "Date picker calendar", "/calendar", "Data Display"},
-		{"Chart", "Data visualization charts", "/chart", "Data Display"},
-		{"Progress", "Progress indicators", "/progress", "Data Display"},
-		{"Skeleton", "Loading placeholder", "/skeleton", "Data Display"},
-		{"Table", "Data table component", "/table", "Data Display"},
-		
-		// Feedback
-		{"Alert", "Alert messages", "/alert", "Feedback"},
-		{"Alert Dialog", "Modal alert dialogs", "/alert-dialog", "Feedback"},
-		{"Dialog", "Modal dialog windows", "/dialog", "Feedback"},
-		{"Drawer", "Sliding panel overlay", "/drawer", "Feedback"},
-		{"Sheet", "Sliding sheet overlay", "/sheet", "Feedback"},
-		{"Sonner", "Toast notifications (Sonner)", "/sonner", "Feedback"},
-		{"Toast", "Toast notifications", "/toast", "Feedback"},
-		{"Tooltip", "Informative tooltips", "/tooltip", "Feedback"},
-		
-		// Navigation
-		{"Breadcrumb", "Navigation breadcrumbs", "/breadcrumb", "Navigation"},
-		{"Command", "Command palette", "/command", "Navigation"},
-		{"Context Menu", "Right-click context menus", "/context-menu", "Navigation"},
-		{"Dropdown Menu", "Dropdown menu component", "/dropdown-menu", "Navigation"},
-		{"Hover Card", "Card shown on hover", "/hover-card", "Navigation"},
-		{"Menubar", "Application menubar", "/menubar", "Navigation"},
-		{"Navigation Menu", "Navigation menu component", "/navigation-menu", "Navigation"},
-		{"Pagination", "Pagination controls", "/pagination", "Navigation"},
-		{"Popover", "Popover component", "/popover", "Navigation"},
-		{"Sidebar", "Sidebar navigation", "/sidebar", "Navigation"},
-		{"Tabs", "Tabbed interface", "/tabs", "Navigation"},
-		
-		// Display
-		{"Accordion", "Collapsible content panels", "/accordion", "Display"},
-		{"Carousel", "Image/content carousel", "/carousel", "Display"},
-		{"Collapsible", "Collapsible content section", "/collapsible", "Display"},
 	}
+	var components []component
+	for _, c := range catalog.Components() {
+		components = append(components, component{c.Name, c.Description, "/" + c.Slug, c.Category})
+	}
+	// Streaming is a demo of lib/stream rather than a component
+	components = append(components, component{"Streaming", "Sections streamed in after the page", "/streaming", "Data Display"})
 
 	// Group components by category
 	categories := make(map[string][]struct {
@@ -0,0 +1,53 @@
+//go:build ignore
+
+// gen_sources writes sources_gen.go, which embeds the non-test Go files of
+// the pkg and lib packages. Embed patterns can't exclude files, so the files
+// are listed one per directory.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+func main() {
+	var dirs []string
+	files := map[string][]string{}
+	for _, pattern := range []string{"lib/*.go", "lib/*/*.go", "pkg/*/*.go"} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, name := range matches {
+			if strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+			dir := filepath.ToSlash(filepath.Dir(name))
+			if files[dir] == nil {
+				dirs = append(dirs, dir)
+			}
+			files[dir] = append(files[dir], filepath.ToSlash(name))
+		}
+	}
+	slices.Sort(dirs)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_sources.go; DO NOT EDIT.\n\npackage shadcn\n\nimport \"embed\"\n\n")
+	b.WriteString("// Sources holds the Go files of the pkg and lib packages, without their\n// tests\n//\n")
+	for _, dir := range dirs {
+		b.WriteString("//go:embed " + strings.Join(files[dir], " ") + "\n")
+	}
+	b.WriteString("var Sources embed.FS\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("sources_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
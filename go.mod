@@ -4,12 +4,14 @@ go 1.24
 
 require (
 	github.com/go-chi/chi/v5 v5.2.2
+	golang.org/x/net v0.43.0
 	golang.org/x/sync v0.16.0
 	maragu.dev/env v0.2.0
 	maragu.dev/gomponents v1.1.0
 	maragu.dev/gomponents-htmx v0.6.1
 	maragu.dev/httph v0.3.7
 	maragu.dev/is v0.3.1
+	rsc.io/qr v0.2.0
 )
 
 require github.com/mitchellh/mapstructure v1.5.0 // indirect
@@ -0,0 +1,154 @@
+// Package a11y audits rendered HTML for common accessibility problems.
+//
+// Check parses a document or fragment and reports Violations of a small set
+// of rules: elements without an accessible name, SVGs that are neither hidden
+// nor labelled, unknown ARIA roles and attributes, invalid ARIA values,
+// references to missing IDs, labels not tied to a form control, duplicate
+// IDs, skipped heading levels and interactive elements nested inside other
+// interactive elements.
+//
+// Use a11ytest.Assert from component tests and Middleware in development to log the
+// violations found in every HTML response. Input that does not start with a
+// doctype or <html> tag is treated as a fragment, as returned for HTMX swaps;
+// references from a fragment to IDs it does not contain are not reported
+// because they usually point into the page the fragment is swapped into.
+package a11y
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+	g "maragu.dev/gomponents"
+)
+
+// Rule IDs reported in Violation.Rule
+const (
+	RuleAccessibleName    = "accessible-name"
+	RuleSVGHidden         = "svg-hidden"
+	RuleARIARole          = "aria-role"
+	RuleARIAAttr          = "aria-attr"
+	RuleARIAIDRef         = "aria-idref"
+	RuleLabelFor          = "label-for"
+	RuleDuplicateID       = "duplicate-id"
+	RuleHeadingOrder      = "heading-order"
+	RuleNestedInteractive = "nested-interactive"
+)
+
+// Violation is a single rule failure
+type Violation struct {
+	Rule    string // Rule ID, one of the Rule constants
+	Message string // What is wrong
+	Element string // Start tag of the offending element, shortened
+}
+
+// String formats the violation for logs and test failures
+func (v Violation) String() string {
+	return fmt.Sprintf("[%s] %s: %s", v.Rule, v.Element, v.Message)
+}
+
+// Options configures a check
+type Options struct {
+	Disable []string // Rule IDs to skip
+	// Fragment forces fragment mode even when the input starts with a
+	// doctype or <html> tag
+	Fragment bool
+}
+
+// Check parses HTML from r and returns the violations found
+func Check(r io.Reader, opts Options) ([]Violation, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := html.Parse(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+
+	c := newChecker(doc, opts.Fragment || !isDocument(src))
+	for _, rule := range opts.Disable {
+		c.disabled[rule] = true
+	}
+	c.run()
+	return c.violations, nil
+}
+
+// CheckNode renders node and checks the result
+func CheckNode(node g.Node, opts Options) ([]Violation, error) {
+	var buf bytes.Buffer
+	if err := node.Render(&buf); err != nil {
+		return nil, err
+	}
+	return Check(&buf, opts)
+}
+
+func isDocument(src []byte) bool {
+	src = bytes.TrimPrefix(src, []byte("\xef\xbb\xbf"))
+	src = bytes.ToLower(bytes.TrimSpace(src))
+	return bytes.HasPrefix(src, []byte("<!doctype")) || bytes.HasPrefix(src, []byte("<html"))
+}
+
+// describe renders a short start tag identifying n in reports
+func describe(n *html.Node) string {
+	var b strings.Builder
+	b.WriteString("<" + n.Data)
+	for _, key := range []string{"id", "role", "type", "name", "for", "href", "aria-label", "class"} {
+		value, ok := attr(n, key)
+		if !ok {
+			continue
+		}
+		if key == "class" {
+			if _, hasID := attr(n, "id"); hasID {
+				continue
+			}
+			if len(value) > 40 {
+				value = value[:40] + "…"
+			}
+		}
+		fmt.Fprintf(&b, " %s=%q", key, value)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func attrValue(n *html.Node, key string) string {
+	value, _ := attr(n, key)
+	return strings.TrimSpace(value)
+}
+
+func hasAttr(n *html.Node, key string) bool {
+	_, ok := attr(n, key)
+	return ok
+}
+
+// roles returns the element's explicit role tokens
+func roles(n *html.Node) []string {
+	return strings.Fields(strings.ToLower(attrValue(n, "role")))
+}
+
+// role returns the first valid explicit role, the one browsers apply
+func role(n *html.Node) string {
+	for _, r := range roles(n) {
+		if validRoles[r] {
+			return r
+		}
+	}
+	return ""
+}
+
+func hasRole(n *html.Node, names ...string) bool {
+	return slices.Contains(names, role(n))
+}
@@ -0,0 +1,210 @@
+package a11y
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+func rules(violations []Violation) string {
+	var ids []string
+	for _, v := range violations {
+		ids = append(ids, v.Rule)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{name: "labelled button", html: `<button>Save</button>`},
+		{name: "icon button", html: `<button><svg aria-hidden="true"></svg></button>`, want: RuleAccessibleName},
+		{name: "icon button with label", html: `<button aria-label="Close"><svg aria-hidden="true"></svg></button>`},
+		{name: "icon button with sr-only text", html: `<button><svg aria-hidden="true"></svg><span class="sr-only">Close</span></button>`},
+		{name: "labelledby", html: `<div role="dialog" aria-labelledby="t"><h2 id="t">Title</h2></div>`},
+		{name: "link without href", html: `<a></a>`},
+		{name: "empty link", html: `<a href="/"></a>`, want: RuleAccessibleName},
+		{name: "hidden input", html: `<input type="hidden" name="csrf">`},
+		{name: "unlabelled input", html: `<input type="text">`, want: RuleAccessibleName},
+		{name: "placeholder", html: `<input type="text" placeholder="Search">`},
+		{name: "wrapping label", html: `<label>Email <input type="email"></label>`},
+		{name: "label for", html: `<label for="e">Email</label><input id="e" type="email">`},
+		{name: "label for missing id", html: `<!DOCTYPE html><html><body><label for="e">Email</label><input type="email"></body></html>`, want: RuleLabelFor + "," + RuleAccessibleName},
+		{name: "label for non-control", html: `<label for="e">Email</label><div id="e"></div>`, want: RuleLabelFor},
+		{name: "img without alt", html: `<img src="a.png">`, want: RuleAccessibleName},
+		{name: "decorative img", html: `<img src="a.png" alt="">`},
+		{name: "bare svg", html: `<svg></svg>`, want: RuleSVGHidden},
+		{name: "svg image without name", html: `<svg role="img"></svg>`, want: RuleAccessibleName},
+		{name: "svg image with title", html: `<svg role="img"><title>Logo</title></svg>`},
+		{name: "aria-hidden subtree", html: `<div aria-hidden="true"><svg></svg><button></button></div>`},
+		{name: "invalid role", html: `<div role="dropdown"></div>`, want: RuleARIARole},
+		{name: "abstract role", html: `<div role="widget"></div>`, want: RuleARIARole},
+		{name: "fallback role", html: `<div role="switch checkbox" aria-checked="false">On</div>`},
+		{name: "unknown aria attribute", html: `<div aria-labeledby="x"></div>`, want: RuleARIAAttr},
+		{name: "invalid aria value", html: `<button aria-expanded="yes">Menu</button>`, want: RuleARIAAttr},
+		{name: "invalid aria number", html: `<div role="progressbar" aria-label="Upload" aria-valuenow="half"></div>`, want: RuleARIAAttr},
+		{name: "fragment idref", html: `<button aria-controls="menu">Menu</button>`},
+		{name: "document idref", html: `<!DOCTYPE html><html><body><button aria-controls="menu">Menu</button></body></html>`, want: RuleARIAIDRef},
+		{name: "duplicate id", html: `<div id="a"></div><div id="a"></div>`, want: RuleDuplicateID},
+		{name: "heading order", html: `<h1>A</h1><h2>B</h2><h3>C</h3><h2>D</h2>`},
+		{name: "skipped heading", html: `<h1>A</h1><h3>B</h3>`, want: RuleHeadingOrder},
+		{name: "aria heading", html: `<h2>A</h2><div role="heading" aria-level="4">B</div>`, want: RuleHeadingOrder},
+		{name: "nested interactive", html: `<button>Open <a href="/">link</a></button>`, want: RuleNestedInteractive},
+		{name: "nested in widget role", html: `<div role="option" aria-selected="false">A <input type="checkbox" aria-label="x"></div>`, want: RuleNestedInteractive},
+		{name: "menu items", html: `<div role="menu"><div role="menuitem">A</div><div role="menuitem">B</div></div>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := Check(strings.NewReader(tt.html), Options{})
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if got := rules(violations); got != tt.want {
+				t.Errorf("Check() rules = %q, want %q (%v)", got, tt.want, violations)
+			}
+		})
+	}
+}
+
+func TestCheckOptions(t *testing.T) {
+	doc := `<!DOCTYPE html><html><body><button aria-controls="menu"></button></body></html>`
+
+	violations, err := Check(strings.NewReader(doc), Options{Disable: []string{RuleAccessibleName}})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if got := rules(violations); got != RuleARIAIDRef {
+		t.Errorf("Check() with disabled rule = %q, want %q", got, RuleARIAIDRef)
+	}
+
+	violations, err = Check(strings.NewReader(doc), Options{Fragment: true})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if got := rules(violations); got != RuleAccessibleName {
+		t.Errorf("Check() as fragment = %q, want %q", got, RuleAccessibleName)
+	}
+
+	// A label swapped in by HTMX may point at a control elsewhere on the page
+	label := `<!DOCTYPE html><html><body><label for="email">Email</label></body></html>`
+	violations, err = Check(strings.NewReader(label), Options{Fragment: true})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("Check() label as fragment = %v, want none", violations)
+	}
+	violations, err = Check(strings.NewReader(label), Options{})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if got := rules(violations); got != RuleLabelFor {
+		t.Errorf("Check() label as document = %q, want %q", got, RuleLabelFor)
+	}
+}
+
+func TestCheckNode(t *testing.T) {
+	violations, err := CheckNode(html.Button(html.ID("save")), Options{})
+	if err != nil {
+		t.Fatalf("CheckNode() error = %v", err)
+	}
+	if len(violations) != 1 {
+		t.Fatalf("CheckNode() = %v, want one violation", violations)
+	}
+	if want := `[accessible-name] <button id="save">: element has no accessible name`; violations[0].String() != want {
+		t.Errorf("Violation.String() = %q, want %q", violations[0].String(), want)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		wantStatus  int
+		wantBody    string
+		wantReports int
+	}{
+		{
+			name: "html",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = html.Div(html.Button(), g.Raw("<svg></svg>")).Render(w)
+			},
+			wantStatus:  http.StatusOK,
+			wantBody:    `<div><button></button><svg></svg></div>`,
+			wantReports: 2,
+		},
+		{
+			name: "html with status",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`<!DOCTYPE html><html><body><h1>Not found</h1></body></html>`))
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `<!DOCTYPE html><html><body><h1>Not found</h1></body></html>`,
+		},
+		{
+			name: "json",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"html":"<button></button>"}`))
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"html":"<button></button>"}`,
+		},
+		{
+			name: "event stream",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = w.Write([]byte("data: <button></button>\n\n"))
+				w.(http.Flusher).Flush()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "data: <button></button>\n\n",
+		},
+		{
+			name: "no content",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "empty html",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reported []Violation
+			report := func(r *http.Request, violations []Violation) {
+				reported = append(reported, violations...)
+			}
+
+			w := httptest.NewRecorder()
+			Middleware(Options{}, report)(tt.handler).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if w.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+			if len(reported) != tt.wantReports {
+				t.Errorf("reported %d violations, want %d: %v", len(reported), tt.wantReports, reported)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package a11ytest asserts in tests that rendered components pass the a11y
+// checks and render the markup their keyboard and ARIA behaviour relies on.
+// It is kept apart from a11y so that applications using the middleware don't
+// link the testing package.
+package a11ytest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	g "maragu.dev/gomponents"
+)
+
+// Assert fails the test for every violation found in node
+func Assert(t testing.TB, node g.Node, opts a11y.Options) {
+	t.Helper()
+	violations, err := a11y.CheckNode(node, opts)
+	if err != nil {
+		t.Fatalf("a11y check error = %v", err)
+	}
+	for _, v := range violations {
+		t.Errorf("a11y violation %s", v)
+	}
+}
+
+// Render renders node, failing the test if it can't be rendered
+func Render(t testing.TB, node g.Node) string {
+	t.Helper()
+	var b strings.Builder
+	if err := node.Render(&b); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	return b.String()
+}
+
+// Markup fails the test for each of contains that markup lacks and each of
+// excludes that it has
+func Markup(t testing.TB, markup string, contains, excludes []string) {
+	t.Helper()
+	for _, s := range contains {
+		if !strings.Contains(markup, s) {
+			t.Errorf("expected output to contain %q, got %s", s, markup)
+		}
+	}
+	for _, s := range excludes {
+		if strings.Contains(markup, s) {
+			t.Errorf("expected output not to contain %q, got %s", s, markup)
+		}
+	}
+}
+
+// Conformance checks node with Assert and Markup and returns its markup, for
+// the keyboard and ARIA conformance tests of interactive components
+func Conformance(t testing.TB, node g.Node, opts a11y.Options, contains, excludes []string) string {
+	t.Helper()
+	Assert(t, node, opts)
+	markup := Render(t, node)
+	Markup(t, markup, contains, excludes)
+	return markup
+}
@@ -0,0 +1,25 @@
+package a11ytest
+
+import (
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+func TestAssert(t *testing.T) {
+	Assert(t, html.Button(g.Text("Save")), a11y.Options{})
+}
+
+func TestConformance(t *testing.T) {
+	markup := Conformance(t,
+		html.Button(g.Attr("aria-expanded", "false"), g.Text("Menu")),
+		a11y.Options{},
+		[]string{`aria-expanded="false"`},
+		[]string{`role="menuitem"`},
+	)
+	if markup != `<button aria-expanded="false">Menu</button>` {
+		t.Errorf("unexpected markup %s", markup)
+	}
+}
@@ -0,0 +1,152 @@
+package a11y
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// validRoles are the non-abstract WAI-ARIA 1.2 and Graphics ARIA roles
+var validRoles = set(
+	"alert", "alertdialog", "application", "article", "banner", "blockquote", "button",
+	"caption", "cell", "checkbox", "code", "columnheader", "combobox", "complementary",
+	"contentinfo", "definition", "deletion", "dialog", "directory", "document", "emphasis",
+	"feed", "figure", "form", "generic", "grid", "gridcell", "group", "heading", "img",
+	"insertion", "link", "list", "listbox", "listitem", "log", "main", "mark", "marquee",
+	"math", "menu", "menubar", "menuitem", "menuitemcheckbox", "menuitemradio", "meter",
+	"navigation", "none", "note", "option", "paragraph", "presentation", "progressbar",
+	"radio", "radiogroup", "region", "row", "rowgroup", "rowheader", "scrollbar", "search",
+	"searchbox", "separator", "slider", "spinbutton", "status", "strong", "subscript",
+	"superscript", "switch", "tab", "table", "tablist", "tabpanel", "term", "textbox",
+	"time", "timer", "toolbar", "tooltip", "tree", "treegrid", "treeitem",
+	"graphics-document", "graphics-object", "graphics-symbol",
+)
+
+// namedRoles require an accessible name
+var namedRoles = set(
+	"alertdialog", "button", "checkbox", "combobox", "dialog", "link", "listbox",
+	"menuitem", "menuitemcheckbox", "menuitemradio", "meter", "option", "progressbar",
+	"radio", "radiogroup", "searchbox", "slider", "spinbutton", "switch", "tab",
+	"textbox", "treeitem",
+)
+
+// contentRoles take their name from their content
+var contentRoles = set(
+	"button", "cell", "checkbox", "columnheader", "gridcell", "heading", "link",
+	"menuitem", "menuitemcheckbox", "menuitemradio", "option", "radio", "row",
+	"rowheader", "switch", "tab", "tooltip", "treeitem",
+)
+
+// interactiveRoles are widgets that must not contain other widgets
+var interactiveRoles = set(
+	"button", "checkbox", "combobox", "link", "menuitem", "menuitemcheckbox",
+	"menuitemradio", "option", "radio", "searchbox", "slider", "spinbutton",
+	"switch", "tab", "textbox", "treeitem",
+)
+
+// ariaValue describes the values an ARIA attribute accepts
+type ariaValue struct {
+	tokens  []string // Allowed tokens; nil accepts any string
+	list    bool     // Space separated list of tokens
+	number  bool     // Numeric value
+	integer bool     // Integer value
+	idrefs  bool     // Space separated list of IDs
+}
+
+func (v ariaValue) valid(value string) bool {
+	switch {
+	case v.integer:
+		_, err := strconv.Atoi(value)
+		return err == nil
+	case v.number:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case v.idrefs:
+		return value != ""
+	case v.tokens == nil:
+		return true
+	case v.list:
+		for _, token := range strings.Fields(value) {
+			if !slices.Contains(v.tokens, token) {
+				return false
+			}
+		}
+		return value != ""
+	}
+	return slices.Contains(v.tokens, value)
+}
+
+var (
+	boolean    = ariaValue{tokens: []string{"true", "false"}}
+	booleanUnd = ariaValue{tokens: []string{"true", "false", "undefined"}}
+	tristate   = ariaValue{tokens: []string{"true", "false", "mixed", "undefined"}}
+	integer    = ariaValue{integer: true}
+	number     = ariaValue{number: true}
+	idrefs     = ariaValue{idrefs: true}
+	text       = ariaValue{}
+)
+
+// ariaAttrs are the WAI-ARIA 1.2 states and properties
+var ariaAttrs = map[string]ariaValue{
+	"aria-activedescendant":       idrefs,
+	"aria-atomic":                 boolean,
+	"aria-autocomplete":           {tokens: []string{"inline", "list", "both", "none"}},
+	"aria-braillelabel":           text,
+	"aria-brailleroledescription": text,
+	"aria-busy":                   boolean,
+	"aria-checked":                tristate,
+	"aria-colcount":               integer,
+	"aria-colindex":               integer,
+	"aria-colindextext":           text,
+	"aria-colspan":                integer,
+	"aria-controls":               idrefs,
+	"aria-current":                {tokens: []string{"page", "step", "location", "date", "time", "true", "false"}},
+	"aria-describedby":            idrefs,
+	"aria-description":            text,
+	"aria-details":                idrefs,
+	"aria-disabled":               boolean,
+	"aria-dropeffect":             {tokens: []string{"copy", "execute", "link", "move", "none", "popup"}, list: true},
+	"aria-errormessage":           idrefs,
+	"aria-expanded":               booleanUnd,
+	"aria-flowto":                 idrefs,
+	"aria-grabbed":                booleanUnd,
+	"aria-haspopup":               {tokens: []string{"false", "true", "menu", "listbox", "tree", "grid", "dialog"}},
+	"aria-hidden":                 booleanUnd,
+	"aria-invalid":                {tokens: []string{"grammar", "false", "spelling", "true"}},
+	"aria-keyshortcuts":           text,
+	"aria-label":                  text,
+	"aria-labelledby":             idrefs,
+	"aria-level":                  integer,
+	"aria-live":                   {tokens: []string{"assertive", "off", "polite"}},
+	"aria-modal":                  boolean,
+	"aria-multiline":              boolean,
+	"aria-multiselectable":        boolean,
+	"aria-orientation":            {tokens: []string{"horizontal", "vertical", "undefined"}},
+	"aria-owns":                   idrefs,
+	"aria-placeholder":            text,
+	"aria-posinset":               integer,
+	"aria-pressed":                tristate,
+	"aria-readonly":               boolean,
+	"aria-relevant":               {tokens: []string{"additions", "all", "removals", "text"}, list: true},
+	"aria-required":               boolean,
+	"aria-roledescription":        text,
+	"aria-rowcount":               integer,
+	"aria-rowindex":               integer,
+	"aria-rowindextext":           text,
+	"aria-rowspan":                integer,
+	"aria-selected":               booleanUnd,
+	"aria-setsize":                integer,
+	"aria-sort":                   {tokens: []string{"ascending", "descending", "none", "other"}},
+	"aria-valuemax":               number,
+	"aria-valuemin":               number,
+	"aria-valuenow":               number,
+	"aria-valuetext":              text,
+}
+
+func set(values ...string) map[string]bool {
+	m := make(map[string]bool, len(values))
+	for _, v := range values {
+		m[v] = true
+	}
+	return m
+}
@@ -0,0 +1,141 @@
+package a11y
+
+import (
+	"bytes"
+	"cmp"
+	"log"
+	"mime"
+	"net/http"
+)
+
+// Reporter receives the violations found in a response
+type Reporter func(r *http.Request, violations []Violation)
+
+// LogReporter writes each violation to the standard logger
+func LogReporter(r *http.Request, violations []Violation) {
+	for _, v := range violations {
+		log.Printf("a11y: %s %s: %s", r.Method, r.URL.Path, v)
+	}
+}
+
+// Middleware checks every uncompressed HTML response and passes the
+// violations to report, or to LogReporter when report is nil. HTML responses
+// are buffered until the handler returns, so streaming is lost; use it in
+// development only.
+func Middleware(opts Options, report Reporter) func(http.Handler) http.Handler {
+	if report == nil {
+		report = LogReporter
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &recorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			if !rec.decided {
+				_ = rec.decide()
+			}
+			if !rec.html {
+				return
+			}
+
+			body := rec.buf.Bytes()
+			if violations, err := Check(bytes.NewReader(body), opts); err != nil {
+				log.Printf("a11y: %s %s: %v", r.Method, r.URL.Path, err)
+			} else if len(violations) > 0 {
+				report(r, violations)
+			}
+
+			// Handlers that set an HTML content type but never write leave
+			// the status unset
+			w.WriteHeader(cmp.Or(rec.status, http.StatusOK))
+			_, _ = w.Write(body)
+		})
+	}
+}
+
+// sniffLen is the amount of body net/http reads to detect a content type
+const sniffLen = 512
+
+// recorder buffers HTML responses and passes everything else through. Until
+// the content type is known the body is held back so it can be sniffed.
+type recorder struct {
+	http.ResponseWriter
+	buf     bytes.Buffer
+	status  int
+	decided bool
+	html    bool
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	if rec.decided && !rec.html {
+		rec.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if rec.status == 0 {
+		rec.status = status
+	}
+	if !rec.decided && rec.Header().Get("Content-Type") != "" {
+		_ = rec.decide()
+	}
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	if rec.decided && !rec.html {
+		return rec.ResponseWriter.Write(b)
+	}
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	n, _ := rec.buf.Write(b)
+	if !rec.decided && (rec.Header().Get("Content-Type") != "" || rec.buf.Len() >= sniffLen) {
+		if err := rec.decide(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// decide chooses between buffering the whole response and passing it
+// through, writing out anything held back in the latter case
+func (rec *recorder) decide() error {
+	rec.decided = true
+
+	header := rec.Header()
+	contentType := header.Get("Content-Type")
+	if contentType == "" && rec.buf.Len() > 0 {
+		contentType = http.DetectContentType(rec.buf.Bytes())
+		header.Set("Content-Type", contentType)
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	rec.html = mediaType == "text/html" && header.Get("Content-Encoding") == ""
+	if rec.html {
+		header.Del("Content-Length")
+		return nil
+	}
+
+	if rec.status != 0 {
+		rec.ResponseWriter.WriteHeader(rec.status)
+	}
+	_, err := rec.ResponseWriter.Write(rec.buf.Bytes())
+	rec.buf.Reset()
+	return err
+}
+
+// Flush flushes responses that are passed through. Buffered HTML responses
+// are only written once the handler returns, so flushing them does nothing.
+func (rec *recorder) Flush() {
+	if !rec.decided {
+		_ = rec.decide()
+	}
+	if rec.html {
+		return
+	}
+	if f, ok := rec.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (rec *recorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,418 @@
+package a11y
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+type checker struct {
+	doc        *html.Node
+	fragment   bool
+	disabled   map[string]bool
+	ids        map[string][]*html.Node
+	labels     map[string][]*html.Node
+	heading    int
+	violations []Violation
+}
+
+func newChecker(doc *html.Node, fragment bool) *checker {
+	c := &checker{
+		doc:      doc,
+		fragment: fragment,
+		disabled: map[string]bool{},
+		ids:      map[string][]*html.Node{},
+		labels:   map[string][]*html.Node{},
+	}
+	c.index(doc)
+	return c
+}
+
+func (c *checker) index(n *html.Node) {
+	if n.Type == html.ElementNode {
+		if id, ok := attr(n, "id"); ok && id != "" {
+			c.ids[id] = append(c.ids[id], n)
+		}
+		if n.DataAtom == atom.Label {
+			if target := attrValue(n, "for"); target != "" {
+				c.labels[target] = append(c.labels[target], n)
+			}
+		}
+	}
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.index(child)
+	}
+}
+
+func (c *checker) report(rule string, n *html.Node, format string, args ...any) {
+	if c.disabled[rule] {
+		return
+	}
+	c.violations = append(c.violations, Violation{
+		Rule:    rule,
+		Message: fmt.Sprintf(format, args...),
+		Element: describe(n),
+	})
+}
+
+// scope is the state inherited from an element's ancestors
+type scope struct {
+	hidden      bool       // Inside an aria-hidden or hidden subtree
+	svg         bool       // Inside an svg element
+	interactive *html.Node // Nearest interactive ancestor
+}
+
+func (c *checker) run() {
+	c.checkDuplicateIDs(c.doc)
+	c.walk(c.doc, scope{})
+}
+
+func (c *checker) walk(n *html.Node, s scope) {
+	if n.Type == html.ElementNode {
+		switch n.DataAtom {
+		case atom.Script, atom.Style, atom.Template, atom.Noscript:
+			return
+		}
+
+		if hasAttr(n, "hidden") || attrValue(n, "aria-hidden") == "true" {
+			s.hidden = true
+		}
+
+		c.checkARIA(n)
+		if n.DataAtom == atom.Label {
+			c.checkLabel(n)
+		}
+		if !s.hidden {
+			c.checkHeading(n)
+			if n.DataAtom == atom.Svg && !s.svg {
+				c.checkSVG(n)
+			} else if !s.svg {
+				c.checkName(n)
+			}
+			if interactive(n) {
+				if s.interactive != nil {
+					c.report(RuleNestedInteractive, n, "interactive element is nested inside %s", describe(s.interactive))
+				} else {
+					s.interactive = n
+				}
+			}
+		}
+		if n.DataAtom == atom.Svg {
+			s.svg = true
+		}
+	}
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.walk(child, s)
+	}
+}
+
+func (c *checker) checkDuplicateIDs(n *html.Node) {
+	reported := map[string]bool{}
+	var visit func(*html.Node)
+	visit = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			if id, ok := attr(n, "id"); ok && id != "" && len(c.ids[id]) > 1 && !reported[id] {
+				reported[id] = true
+				c.report(RuleDuplicateID, n, "id %q is used by %d elements", id, len(c.ids[id]))
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			visit(child)
+		}
+	}
+	visit(n)
+}
+
+func (c *checker) checkARIA(n *html.Node) {
+	if tokens := roles(n); len(tokens) > 0 && role(n) == "" {
+		c.report(RuleARIARole, n, "role %q is not a valid ARIA role", attrValue(n, "role"))
+	}
+
+	for _, a := range n.Attr {
+		if a.Namespace != "" || !strings.HasPrefix(a.Key, "aria-") {
+			continue
+		}
+		kind, ok := ariaAttrs[a.Key]
+		if !ok {
+			c.report(RuleARIAAttr, n, "%s is not a valid ARIA attribute", a.Key)
+			continue
+		}
+		value := strings.TrimSpace(a.Val)
+		if !kind.valid(value) {
+			c.report(RuleARIAAttr, n, "%s has invalid value %q", a.Key, a.Val)
+			continue
+		}
+		if kind.idrefs && !c.fragment {
+			for _, id := range strings.Fields(value) {
+				if len(c.ids[id]) == 0 {
+					c.report(RuleARIAIDRef, n, "%s references missing id %q", a.Key, id)
+				}
+			}
+		}
+	}
+}
+
+func (c *checker) checkLabel(n *html.Node) {
+	target := attrValue(n, "for")
+	if target == "" {
+		return
+	}
+	controls := c.ids[target]
+	if len(controls) == 0 {
+		if !c.fragment {
+			c.report(RuleLabelFor, n, "label references missing id %q", target)
+		}
+		return
+	}
+	if !labelable(controls[0]) {
+		c.report(RuleLabelFor, n, "label references %s, which is not a form control", describe(controls[0]))
+	}
+}
+
+func (c *checker) checkHeading(n *html.Node) {
+	level := headingLevel(n)
+	if level == 0 {
+		return
+	}
+	if c.heading != 0 && level > c.heading+1 {
+		c.report(RuleHeadingOrder, n, "heading level %d follows level %d", level, c.heading)
+	}
+	c.heading = level
+}
+
+func (c *checker) checkSVG(n *html.Node) {
+	if hasRole(n, "presentation", "none") {
+		return
+	}
+	if !hasRole(n, "img", "graphics-document", "graphics-symbol") {
+		c.report(RuleSVGHidden, n, `svg must have aria-hidden="true", or role="img" and an accessible name`)
+		return
+	}
+	if name, known := c.name(n); known && name == "" {
+		c.report(RuleAccessibleName, n, "svg image has no accessible name")
+	}
+}
+
+func (c *checker) checkName(n *html.Node) {
+	if hasRole(n, "presentation", "none") {
+		return
+	}
+
+	if n.DataAtom == atom.Img {
+		if !hasAttr(n, "alt") && !hasAttr(n, "aria-label") && !hasAttr(n, "aria-labelledby") {
+			c.report(RuleAccessibleName, n, `img must have an alt attribute (alt="" for decorative images)`)
+		}
+		return
+	}
+	if !needsName(n) {
+		return
+	}
+	if name, known := c.name(n); known && name == "" {
+		c.report(RuleAccessibleName, n, "element has no accessible name")
+	}
+}
+
+// name computes a simplified accessible name. known is false when the name
+// depends on elements outside a fragment.
+func (c *checker) name(n *html.Node) (name string, known bool) {
+	if refs := strings.Fields(attrValue(n, "aria-labelledby")); len(refs) > 0 {
+		var parts []string
+		for _, id := range refs {
+			targets := c.ids[id]
+			if len(targets) == 0 {
+				if c.fragment {
+					return "", false
+				}
+				continue
+			}
+			parts = append(parts, textOf(targets[0]))
+		}
+		if name := normalize(strings.Join(parts, " ")); name != "" {
+			return name, true
+		}
+	}
+	if label := attrValue(n, "aria-label"); label != "" {
+		return label, true
+	}
+
+	if labelable(n) {
+		if id := attrValue(n, "id"); id != "" {
+			for _, label := range c.labels[id] {
+				if text := textOf(label); text != "" {
+					return text, true
+				}
+			}
+		}
+		for p := n.Parent; p != nil; p = p.Parent {
+			if p.Type == html.ElementNode && p.DataAtom == atom.Label {
+				if text := textOf(p); text != "" {
+					return text, true
+				}
+			}
+		}
+	}
+
+	switch n.DataAtom {
+	case atom.Input:
+		switch strings.ToLower(attrValue(n, "type")) {
+		case "submit", "reset":
+			return "default", true
+		case "button":
+			if value := attrValue(n, "value"); value != "" {
+				return value, true
+			}
+		case "image":
+			if alt := attrValue(n, "alt"); alt != "" {
+				return alt, true
+			}
+		}
+		if placeholder := attrValue(n, "placeholder"); placeholder != "" {
+			return placeholder, true
+		}
+	case atom.Textarea:
+		if placeholder := attrValue(n, "placeholder"); placeholder != "" {
+			return placeholder, true
+		}
+	case atom.Svg:
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type == html.ElementNode && child.Data == "title" {
+				if text := textOf(child); text != "" {
+					return text, true
+				}
+			}
+		}
+	}
+
+	if nameFromContent(n) {
+		if text := textOf(n); text != "" {
+			return text, true
+		}
+	}
+	return attrValue(n, "title"), true
+}
+
+// textOf returns the visible text of n, including the names of images and
+// labelled descendants
+func textOf(n *html.Node) string {
+	var b strings.Builder
+	var visit func(*html.Node)
+	visit = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			b.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			switch n.DataAtom {
+			case atom.Script, atom.Style, atom.Template, atom.Noscript:
+				return
+			}
+			if hasAttr(n, "hidden") || attrValue(n, "aria-hidden") == "true" {
+				return
+			}
+			if label := attrValue(n, "aria-label"); label != "" {
+				b.WriteString(" " + label + " ")
+				return
+			}
+			if n.DataAtom == atom.Img {
+				b.WriteString(" " + attrValue(n, "alt") + " ")
+				return
+			}
+			if n.DataAtom == atom.Svg {
+				for child := n.FirstChild; child != nil; child = child.NextSibling {
+					if child.Type == html.ElementNode && child.Data == "title" {
+						b.WriteString(" " + textOf(child) + " ")
+					}
+				}
+				return
+			}
+			b.WriteString(" ")
+			defer b.WriteString(" ")
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			visit(child)
+		}
+	}
+	visit(n)
+	return normalize(b.String())
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// needsName reports whether n must have an accessible name
+func needsName(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.Button, atom.Select, atom.Textarea, atom.Iframe:
+		return true
+	case atom.A:
+		return hasAttr(n, "href")
+	case atom.Input:
+		return strings.ToLower(attrValue(n, "type")) != "hidden"
+	}
+	return namedRoles[role(n)]
+}
+
+// nameFromContent reports whether n takes its name from its content
+func nameFromContent(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.Button, atom.A, atom.Label, atom.Legend, atom.Caption, atom.Summary,
+		atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th, atom.Td:
+		return true
+	}
+	return contentRoles[role(n)]
+}
+
+// labelable reports whether n can be associated with a label element
+func labelable(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.Button, atom.Meter, atom.Output, atom.Progress, atom.Select, atom.Textarea:
+		return true
+	case atom.Input:
+		return strings.ToLower(attrValue(n, "type")) != "hidden"
+	}
+	return false
+}
+
+// interactive reports whether n is a control that must not contain other
+// controls
+func interactive(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.Button, atom.Select, atom.Textarea, atom.Iframe, atom.Summary:
+		return true
+	case atom.A:
+		return hasAttr(n, "href")
+	case atom.Input:
+		return strings.ToLower(attrValue(n, "type")) != "hidden"
+	case atom.Audio, atom.Video:
+		return hasAttr(n, "controls")
+	}
+	return interactiveRoles[role(n)]
+}
+
+func headingLevel(n *html.Node) int {
+	switch n.DataAtom {
+	case atom.H1:
+		return 1
+	case atom.H2:
+		return 2
+	case atom.H3:
+		return 3
+	case atom.H4:
+		return 4
+	case atom.H5:
+		return 5
+	case atom.H6:
+		return 6
+	}
+	if role(n) == "heading" {
+		if level, err := strconv.Atoi(attrValue(n, "aria-level")); err == nil && level > 0 {
+			return level
+		}
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,116 @@
+package lib
+
+import (
+	"html"
+	"strings"
+
+	g "maragu.dev/gomponents"
+)
+
+// MergeAttrs combines a component's own nodes with caller-supplied attributes
+// so that no attribute is rendered twice:
+//
+//   - class values are merged with CN, component classes first
+//   - style values are joined with "; "
+//   - any other attribute, including id, replaces the component's own value
+//
+// Groups are flattened. Nodes in extra that are not attributes are appended
+// after the component's nodes.
+func MergeAttrs(nodes []g.Node, extra []g.Node) []g.Node {
+	if len(extra) == 0 {
+		return nodes
+	}
+
+	result := flatten(nil, nodes)
+	index := make(map[string]int)
+	for i, n := range result {
+		if name, _, ok := attrOf(n); ok {
+			index[name] = i
+		}
+	}
+
+	for _, n := range flatten(nil, extra) {
+		name, value, ok := attrOf(n)
+		if !ok {
+			result = append(result, n)
+			continue
+		}
+		i, exists := index[name]
+		if !exists {
+			index[name] = len(result)
+			result = append(result, n)
+			continue
+		}
+		switch name {
+		case "class":
+			_, own, _ := attrOf(result[i])
+			result[i] = g.Attr("class", CN(own, value))
+		case "style":
+			_, own, _ := attrOf(result[i])
+			result[i] = g.Attr("style", joinStyles(own, value))
+		default:
+			result[i] = n
+		}
+	}
+	return result
+}
+
+func flatten(dst []g.Node, nodes []g.Node) []g.Node {
+	for _, n := range nodes {
+		switch v := n.(type) {
+		case nil:
+		case g.Group:
+			dst = flatten(dst, v)
+		default:
+			dst = append(dst, n)
+		}
+	}
+	return dst
+}
+
+// attrOf returns the name and unescaped value of an attribute node.
+// Attributes created with Attr and Static are read directly; other attribute
+// nodes, such as gomponents' own, have to be rendered.
+func attrOf(n g.Node) (name, value string, ok bool) {
+	switch a := n.(type) {
+	case *attr:
+		return strings.ToLower(a.name), a.value, a.name != ""
+	case *static:
+		if a.typ != g.AttributeType {
+			return "", "", false
+		}
+		return parseAttr(a.html)
+	case interface{ Type() g.NodeType }:
+		if a.Type() != g.AttributeType {
+			return "", "", false
+		}
+	default:
+		return "", "", false
+	}
+	var b strings.Builder
+	if err := n.Render(&b); err != nil {
+		return "", "", false
+	}
+	return parseAttr(b.String())
+}
+
+// parseAttr splits a rendered attribute into its name and unescaped value
+func parseAttr(s string) (name, value string, ok bool) {
+	name, value, hasValue := strings.Cut(strings.TrimSpace(s), "=")
+	if hasValue {
+		value = html.UnescapeString(strings.Trim(value, `"`))
+	}
+	return strings.ToLower(name), value, name != ""
+}
+
+func joinStyles(a, b string) string {
+	a = strings.TrimRight(strings.TrimSpace(a), ";")
+	b = strings.TrimSpace(b)
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	}
+	return a + "; " + b
+}
@@ -0,0 +1,94 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+func TestMergeAttrs(t *testing.T) {
+	tests := []struct {
+		name     string
+		own      []g.Node
+		extra    []g.Node
+		expected string
+	}{
+		{
+			name:     "no extra attributes",
+			own:      []g.Node{html.Class("a")},
+			expected: `<div class="a"></div>`,
+		},
+		{
+			name:     "class is merged",
+			own:      []g.Node{html.Class("a b"), html.ID("x")},
+			extra:    []g.Node{html.Class("b c")},
+			expected: `<div class="a b c" id="x"></div>`,
+		},
+		{
+			name:     "id is replaced",
+			own:      []g.Node{html.ID("own")},
+			extra:    []g.Node{html.ID("caller")},
+			expected: `<div id="caller"></div>`,
+		},
+		{
+			name:     "style is joined",
+			own:      []g.Node{html.Style("width: 50%;")},
+			extra:    []g.Node{html.Style("color: red")},
+			expected: `<div style="width: 50%; color: red"></div>`,
+		},
+		{
+			name:     "other attributes are replaced",
+			own:      []g.Node{html.Type("button"), g.Attr("data-state", "off")},
+			extra:    []g.Node{g.Attr("data-state", "on"), g.Attr("data-x", "1")},
+			expected: `<div type="button" data-state="on" data-x="1"></div>`,
+		},
+		{
+			name:     "groups are flattened",
+			own:      []g.Node{g.Group{html.Class("a"), g.Text("child")}},
+			extra:    []g.Node{g.Group{html.Class("b"), html.Disabled()}},
+			expected: `<div class="a b" disabled>child</div>`,
+		},
+		{
+			name:     "escaped values round-trip",
+			own:      []g.Node{g.Attr("title", `a "b"`)},
+			extra:    []g.Node{html.Class(`c&d`)},
+			expected: `<div title="a &#34;b&#34;" class="c&amp;d"></div>`,
+		},
+		{
+			name:     "Attr and Static attributes",
+			own:      []g.Node{Attr("class", "a"), Static(html.ID("own")), Static(html.Span())},
+			extra:    []g.Node{Attr("CLASS", "b"), html.ID("caller")},
+			expected: `<div class="a b" id="caller"><span></span></div>`,
+		},
+		{
+			name:     "non-attribute nodes are appended",
+			own:      []g.Node{html.Class("a"), nil},
+			extra:    []g.Node{g.Text("after")},
+			expected: `<div class="a">after</div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			_ = html.Div(MergeAttrs(tt.own, tt.extra)...).Render(&b)
+			if b.String() != tt.expected {
+				t.Errorf("MergeAttrs() = %s, want %s", b.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestAttrOfAllocations(t *testing.T) {
+	nodes := []g.Node{Attr("data-id", "row-1"), Static(html.Class("p-2"))}
+	n := testing.AllocsPerRun(100, func() {
+		for _, node := range nodes {
+			_, _, _ = attrOf(node)
+		}
+	})
+	if n != 0 {
+		t.Errorf("expected reading Attr and Static attributes to not allocate, got %v allocs", n)
+	}
+}
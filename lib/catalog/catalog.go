@@ -0,0 +1,241 @@
+// Package catalog is a registry of the components, their examples and their
+// props, and a gallery that renders it.
+//
+// Each component package registers itself when it is imported, with its
+// examples, a schema of the props the playground can change and the source
+// of its example.go:
+//
+//	//go:embed example.go
+//	var exampleSource string
+//
+//	func init() {
+//		catalog.Register(catalog.Component{
+//			Name:     "Button",
+//			Category: "Forms",
+//			Source:   exampleSource,
+//			Examples: []catalog.Example{{Name: "Variants", Func: "Example", Render: Example}},
+//			Props: []catalog.Prop{
+//				{Name: "Variant", Type: catalog.Enum, Default: "default", Options: []string{"default", "outline"}},
+//			},
+//			Playground: func(v catalog.Values) g.Node {
+//				return New(Props{Variant: v.String("Variant")}, g.Text("Button"))
+//			},
+//		})
+//	}
+//
+// The Gallery serves a page per component with its live examples, their Go
+// source and the props playground:
+//
+//	router.Mount(router.WithPrefix(router.ServeMux(mux), "/gallery"), catalog.Gallery{Layout: page})
+package catalog
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	g "maragu.dev/gomponents"
+)
+
+// Component describes a component package for the gallery
+type Component struct {
+	Name        string                // Display name, e.g. "Toggle Group"
+	Slug        string                // URL path segment (default: the name in kebab case)
+	Category    string                // Gallery section, e.g. "Forms"
+	Description string                // One-line summary
+	Source      string                // Go source the examples' code is taken from, usually the embedded example.go
+	Examples    []Example             // Live examples, in order
+	Props       []Prop                // Props the playground can change
+	Playground  func(v Values) g.Node // Renders the component with the playground's props (optional)
+}
+
+// Example is a live example of a component
+type Example struct {
+	Name        string        // Display name
+	Description string        // Optional explanation
+	Func        string        // Name of the function in the component's Source shown as the example's code
+	Render      func() g.Node // Renders the example
+}
+
+// PropType is the kind of value a prop holds, which decides its playground
+// control
+type PropType string
+
+const (
+	String PropType = "string" // Text input
+	Bool   PropType = "bool"   // Checkbox
+	Int    PropType = "int"    // Number input
+	Enum   PropType = "enum"   // Select of Options
+)
+
+// Prop describes a prop of a component
+type Prop struct {
+	Name        string   // Name of the prop, e.g. "Variant"
+	Type        PropType // Kind of value (default: String)
+	Default     string   // Value the playground starts with
+	Options     []string // Values of an Enum
+	Description string   // Optional explanation
+}
+
+// Values holds the playground's prop values by name
+type Values map[string]string
+
+// String returns the value of the prop name
+func (v Values) String(name string) string {
+	return v[name]
+}
+
+// Bool returns the value of a Bool prop
+func (v Values) Bool(name string) bool {
+	b, _ := strconv.ParseBool(v[name])
+	return b
+}
+
+// Int returns the value of an Int prop, or 0 when it isn't a number
+func (v Values) Int(name string) int {
+	i, _ := strconv.Atoi(v[name])
+	return i
+}
+
+// Registry holds registered components by slug
+type Registry struct {
+	mu         sync.RWMutex
+	components map[string]*entry
+}
+
+// entry is a registered component and the example sources parsed from it
+type entry struct {
+	Component
+	once    sync.Once
+	sources map[string]string
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{components: map[string]*entry{}}
+}
+
+// DefaultRegistry holds the components registered with Register. Component
+// packages register themselves in it when imported.
+var DefaultRegistry = NewRegistry()
+
+// Register adds c to the DefaultRegistry
+func Register(c Component) {
+	DefaultRegistry.Register(c)
+}
+
+// Components returns the components of the DefaultRegistry
+func Components() []Component {
+	return DefaultRegistry.Components()
+}
+
+// Register adds c. It panics when c has no name or its slug is taken, as
+// both are programming errors found at startup.
+func (r *Registry) Register(c Component) {
+	if c.Name == "" {
+		panic("catalog: component name is required")
+	}
+	if c.Slug == "" {
+		c.Slug = Slug(c.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.components[c.Slug]; exists {
+		panic(fmt.Sprintf("catalog: component %q registered twice", c.Slug))
+	}
+	r.components[c.Slug] = &entry{Component: c}
+}
+
+// Components returns the registered components sorted by category and name
+func (r *Registry) Components() []Component {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	components := make([]Component, 0, len(r.components))
+	for _, e := range r.components {
+		components = append(components, e.Component)
+	}
+	slices.SortFunc(components, func(a, b Component) int {
+		return cmp.Or(cmp.Compare(a.Category, b.Category), cmp.Compare(a.Name, b.Name))
+	})
+	return components
+}
+
+// Lookup returns the component registered under slug
+func (r *Registry) Lookup(slug string) (Component, bool) {
+	e, ok := r.entry(slug)
+	if !ok {
+		return Component{}, false
+	}
+	return e.Component, true
+}
+
+// ExampleSource returns the Go source of the function behind example, taken
+// from the component's Source. Sources are parsed on first use.
+func (r *Registry) ExampleSource(slug string, example Example) (string, bool) {
+	e, ok := r.entry(slug)
+	if !ok || example.Func == "" {
+		return "", false
+	}
+	e.once.Do(func() {
+		e.sources = funcSources(e.Source)
+	})
+	source, ok := e.sources[example.Func]
+	return source, ok
+}
+
+func (r *Registry) entry(slug string) (*entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.components[slug]
+	return e, ok
+}
+
+// Slug returns name in kebab case, e.g. "toggle-group" for "Toggle Group"
+func Slug(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), "-")
+}
+
+// Defaults returns the default value of each prop
+func Defaults(props []Prop) Values {
+	values := make(Values, len(props))
+	for _, p := range props {
+		values[p.Name] = p.Default
+	}
+	return values
+}
+
+// Parse returns the values of props submitted by a playground form. A Bool
+// prop is false when its checkbox is missing, other missing props and Enum
+// values that aren't among the Options take their default.
+func Parse(props []Prop, form map[string][]string) Values {
+	values := Defaults(props)
+	for _, p := range props {
+		submitted, ok := form[p.Name]
+		value := ""
+		if ok && len(submitted) > 0 {
+			value = submitted[0]
+		}
+		switch p.Type {
+		case Bool:
+			values[p.Name] = strconv.FormatBool(value == "true" || value == "on")
+		case Int:
+			if _, err := strconv.Atoi(value); err == nil {
+				values[p.Name] = value
+			}
+		case Enum:
+			if slices.Contains(p.Options, value) {
+				values[p.Name] = value
+			}
+		default:
+			if ok {
+				values[p.Name] = value
+			}
+		}
+	}
+	return values
+}
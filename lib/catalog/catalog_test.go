@@ -0,0 +1,201 @@
+package catalog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+const testSource = `package badge
+
+// Example shows a badge
+func Example() g.Node {
+	return New(Props{}, g.Text("Badge"))
+}
+
+func (p Props) method() {}
+
+func Other() g.Node { return nil }
+`
+
+var testProps = []Prop{
+	{Name: "Variant", Type: Enum, Default: "default", Options: []string{"default", "outline"}},
+	{Name: "Disabled", Type: Bool, Default: "true"},
+	{Name: "Count", Type: Int, Default: "3"},
+	{Name: "Label", Default: "Badge"},
+}
+
+func testRegistry() *Registry {
+	r := NewRegistry()
+	r.Register(Component{
+		Name:     "Toggle Group",
+		Category: "Forms",
+		Examples: []Example{{Name: "Basic", Render: func() g.Node { return html.Span(g.Text("group")) }}},
+	})
+	r.Register(Component{
+		Name:        "Badge",
+		Category:    "Data Display",
+		Description: "Small count and labeling",
+		Source:      testSource,
+		Examples:    []Example{{Name: "Overview", Func: "Example", Render: func() g.Node { return html.Span(g.Text("badge")) }}},
+		Props:       testProps,
+		Playground: func(v Values) g.Node {
+			return html.Span(html.Class(v.String("Variant")), g.Text(v.String("Label")))
+		},
+	})
+	return r
+}
+
+func TestRegistry(t *testing.T) {
+	r := testRegistry()
+
+	var slugs []string
+	for _, c := range r.Components() {
+		slugs = append(slugs, c.Slug)
+	}
+	if got := strings.Join(slugs, ","); got != "badge,toggle-group" {
+		t.Errorf("expected components sorted by category, got %s", got)
+	}
+
+	if _, ok := r.Lookup("toggle-group"); !ok {
+		t.Error("expected toggle-group to be registered")
+	}
+
+	t.Run("duplicate slug", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected registering a slug twice to panic")
+			}
+		}()
+		r.Register(Component{Name: "badge"})
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected registering without a name to panic")
+			}
+		}()
+		r.Register(Component{Slug: "nameless"})
+	})
+}
+
+func TestExampleSource(t *testing.T) {
+	r := testRegistry()
+
+	source, ok := r.ExampleSource("badge", Example{Func: "Example"})
+	want := "// Example shows a badge\nfunc Example() g.Node {\n\treturn New(Props{}, g.Text(\"Badge\"))\n}"
+	if !ok || source != want {
+		t.Errorf("expected %q, got %q", want, source)
+	}
+	if source, _ := r.ExampleSource("badge", Example{Func: "Other"}); source != "func Other() g.Node { return nil }" {
+		t.Errorf("unexpected source of Other: %q", source)
+	}
+	if _, ok := r.ExampleSource("badge", Example{Func: "method"}); ok {
+		t.Error("expected methods to be skipped")
+	}
+	if _, ok := r.ExampleSource("toggle-group", Example{Func: "Example"}); ok {
+		t.Error("expected no source without a Source")
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		form url.Values
+		want Values
+	}{
+		{"defaults", nil, Values{"Variant": "default", "Disabled": "false", "Count": "3", "Label": "Badge"}},
+		{"submitted", url.Values{"Variant": {"outline"}, "Disabled": {"on"}, "Count": {"7"}, "Label": {""}},
+			Values{"Variant": "outline", "Disabled": "true", "Count": "7", "Label": ""}},
+		{"invalid", url.Values{"Variant": {"ghost"}, "Count": {"many"}},
+			Values{"Variant": "default", "Disabled": "false", "Count": "3", "Label": "Badge"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(testProps, tt.form)
+			for name, want := range tt.want {
+				if got[name] != want {
+					t.Errorf("expected %s=%q, got %q", name, want, got[name])
+				}
+			}
+		})
+	}
+
+	v := Parse(testProps, url.Values{"Disabled": {"true"}, "Count": {"5"}})
+	if !v.Bool("Disabled") || v.Int("Count") != 5 || v.String("Variant") != "default" {
+		t.Errorf("unexpected typed values: %v", v)
+	}
+}
+
+func TestSlug(t *testing.T) {
+	for name, want := range map[string]string{
+		"Button":          "button",
+		"Toggle Group":    "toggle-group",
+		" Input  OTP ":    "input-otp",
+		"Navigation Menu": "navigation-menu",
+	} {
+		if got := Slug(name); got != want {
+			t.Errorf("Slug(%q): expected %q, got %q", name, want, got)
+		}
+	}
+}
+
+func TestGallery(t *testing.T) {
+	h := Gallery{Registry: testRegistry()}.Handler()
+
+	get := func(t *testing.T, target string) (int, string) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec.Code, rec.Body.String()
+	}
+
+	tests := []struct {
+		name   string
+		target string
+		code   int
+		want   []string
+	}{
+		{"index", "/", http.StatusOK, []string{`<a href="/badge"`, "Small count and labeling", "Toggle Group"}},
+		{"unknown", "/missing", http.StatusNotFound, nil},
+		{"component", "/badge", http.StatusOK, []string{
+			`aria-current="page"`,
+			`hx-get="/badge/playground"`,
+			`<span class="default">Badge</span>`,
+			`<span>badge</span>`,
+			"// Example shows a badge",
+		}},
+		{"component with props", "/badge?Variant=outline&Label=New", http.StatusOK, []string{
+			`<option value="outline" selected>outline</option>`,
+			`<span class="outline">New</span>`,
+		}},
+		{"without playground", "/toggle-group", http.StatusOK, []string{"<span>group</span>"}},
+		{"playground", "/badge/playground?Variant=outline&Label=New", http.StatusOK, []string{`<span class="outline">New</span>`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := get(t, tt.target)
+			if code != tt.code {
+				t.Fatalf("expected status %d, got %d", tt.code, code)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(body, want) {
+					t.Errorf("expected body to contain %s, got %s", want, body)
+				}
+			}
+		})
+	}
+
+	if _, body := get(t, "/toggle-group"); strings.Contains(body, "Playground") {
+		t.Error("expected no playground without a Playground func")
+	}
+	if _, body := get(t, "/badge/playground"); strings.Contains(body, "<html") {
+		t.Errorf("expected the playground to render a fragment, got %s", body)
+	}
+}
@@ -0,0 +1,304 @@
+package catalog
+
+import (
+	"cmp"
+	"net/http"
+	"strings"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	g "maragu.dev/gomponents"
+	hx "maragu.dev/gomponents-htmx"
+	html "maragu.dev/gomponents/html"
+)
+
+// previewID is the ID of the playground's preview, which the playground
+// endpoint re-renders
+const previewID = "catalog-preview"
+
+// Gallery serves the components of a registry: an index, a page per
+// component with its live examples, their Go source and a props playground,
+// and the playground endpoint that re-renders the preview when a prop
+// changes. Without JavaScript the playground form reloads the page.
+//
+// Routes are registered for the components registered when Register is
+// called, so register the gallery after importing the component packages.
+type Gallery struct {
+	Registry *Registry                                 // Components shown (default: DefaultRegistry)
+	Layout   func(title string, content g.Node) g.Node // Wraps each page, e.g. to add stylesheets and the HTMX script (default: a bare HTML document)
+}
+
+// Register adds the gallery's routes to rt
+func (gl Gallery) Register(rt router.Router) {
+	registry := gl.registry()
+	index := rt.Path("/")
+
+	rt.Handle(http.MethodGet, "/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != index {
+			http.NotFound(w, r)
+			return
+		}
+		gl.page("Components", gl.index(rt, registry)).Render(w)
+	}))
+
+	for _, c := range registry.Components() {
+		rt.Handle(http.MethodGet, "/"+c.Slug, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			values := Defaults(c.Props)
+			if len(r.URL.Query()) > 0 {
+				values = Parse(c.Props, r.URL.Query())
+			}
+			gl.page(c.Name, gl.component(rt, registry, c, values)).Render(w)
+		}))
+		if c.Playground == nil {
+			continue
+		}
+		rt.Handle(http.MethodGet, "/"+c.Slug+"/playground", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.Playground(Parse(c.Props, r.URL.Query())).Render(w)
+		}))
+	}
+}
+
+// Handler returns the gallery as an http.Handler serving from the root, for
+// mounting with http.StripPrefix
+func (gl Gallery) Handler() http.Handler {
+	return router.Handler(gl)
+}
+
+func (gl Gallery) registry() *Registry {
+	if gl.Registry == nil {
+		return DefaultRegistry
+	}
+	return gl.Registry
+}
+
+// page wraps content in the layout
+func (gl Gallery) page(title string, content g.Node) g.Node {
+	if gl.Layout != nil {
+		return gl.Layout(title, content)
+	}
+	return html.Doctype(html.HTML(
+		html.Lang("en"),
+		html.Head(
+			html.Meta(html.Charset("utf-8")),
+			html.Meta(html.Name("viewport"), html.Content("width=device-width, initial-scale=1")),
+			html.TitleEl(g.Text(title)),
+		),
+		html.Body(content),
+	))
+}
+
+// shell renders the navigation beside the page's content
+func (gl Gallery) shell(rt router.Router, registry *Registry, current string, content ...g.Node) g.Node {
+	var sections []g.Node
+	for _, group := range byCategory(registry.Components()) {
+		sections = append(sections, html.Div(
+			html.Class("space-y-1"),
+			html.H2(html.Class("px-2 text-xs font-semibold uppercase tracking-wide text-muted-foreground"), g.Text(group.category)),
+			html.Ul(g.Map(group.components, func(c Component) g.Node {
+				return html.Li(html.A(
+					html.Href(rt.Path("/"+c.Slug)),
+					html.Class(lib.CN(
+						"block rounded-md px-2 py-1 text-sm hover:bg-accent hover:text-accent-foreground",
+						lib.CNIf(c.Slug == current, "bg-accent font-medium text-accent-foreground", "text-muted-foreground"),
+					)),
+					g.If(c.Slug == current, g.Attr("aria-current", "page")),
+					g.Text(c.Name),
+				))
+			})),
+		))
+	}
+
+	return html.Div(
+		html.Class("mx-auto flex max-w-7xl gap-8 px-4 py-8"),
+		html.Nav(
+			html.Class("hidden w-52 shrink-0 space-y-6 md:block"),
+			html.Aria("label", "Components"),
+			html.A(html.Href(rt.Path("/")), html.Class("block px-2 text-lg font-semibold"), g.Text("Components")),
+			g.Group(sections),
+		),
+		html.Main(html.Class("min-w-0 flex-1 space-y-10"), g.Group(content)),
+	)
+}
+
+// index renders a card for every component, by category
+func (gl Gallery) index(rt router.Router, registry *Registry) g.Node {
+	var sections []g.Node
+	for _, group := range byCategory(registry.Components()) {
+		sections = append(sections, html.Section(
+			html.Class("space-y-4"),
+			html.H2(html.Class("text-xl font-semibold"), g.Text(group.category)),
+			html.Div(
+				html.Class("grid gap-4 sm:grid-cols-2 lg:grid-cols-3"),
+				g.Map(group.components, func(c Component) g.Node {
+					return html.A(
+						html.Href(rt.Path("/"+c.Slug)),
+						html.Class("block rounded-lg border p-4 transition-colors hover:bg-accent"),
+						html.H3(html.Class("font-medium"), g.Text(c.Name)),
+						g.If(c.Description != "", html.P(html.Class("mt-1 text-sm text-muted-foreground"), g.Text(c.Description))),
+					)
+				}),
+			),
+		))
+	}
+	return gl.shell(rt, registry, "",
+		html.H1(html.Class("text-3xl font-bold"), g.Text("Components")),
+		g.Group(sections),
+	)
+}
+
+// component renders the page of c with the playground at values
+func (gl Gallery) component(rt router.Router, registry *Registry, c Component, values Values) g.Node {
+	return gl.shell(rt, registry, c.Slug,
+		html.Header(
+			html.Class("space-y-2"),
+			html.P(html.Class("text-sm text-muted-foreground"), g.Text(c.Category)),
+			html.H1(html.Class("text-3xl font-bold"), g.Text(c.Name)),
+			g.If(c.Description != "", html.P(html.Class("text-muted-foreground"), g.Text(c.Description))),
+		),
+		g.Iff(c.Playground != nil, func() g.Node { return playground(rt, c, values) }),
+		g.Group(g.Map(c.Examples, func(ex Example) g.Node {
+			source, _ := registry.ExampleSource(c.Slug, ex)
+			return example(ex, source)
+		})),
+	)
+}
+
+// playground renders the props form, the preview and the props table of c
+func playground(rt router.Router, c Component, values Values) g.Node {
+	return html.Section(
+		html.Class("space-y-4"),
+		html.Aria("labelledby", "catalog-playground"),
+		html.H2(html.ID("catalog-playground"), html.Class("text-xl font-semibold"), g.Text("Playground")),
+		html.Form(
+			html.Class("grid gap-4 rounded-lg border p-4 sm:grid-cols-2 lg:grid-cols-3"),
+			html.Method("get"),
+			html.Action(rt.Path("/"+c.Slug)),
+			hx.Get(rt.Path("/"+c.Slug+"/playground")),
+			hx.Trigger("input delay:250ms, change"),
+			hx.Target("#"+previewID),
+			hx.Sync("this:replace"),
+			g.Group(g.Map(c.Props, func(p Prop) g.Node { return control(p, values[p.Name]) })),
+			html.NoScript(html.Button(
+				html.Type("submit"),
+				html.Class("rounded-md border px-3 py-1.5 text-sm"),
+				g.Text("Update"),
+			)),
+		),
+		html.Div(
+			html.ID(previewID),
+			html.Class("flex min-h-32 items-center justify-center rounded-lg border p-6"),
+			g.Attr("aria-live", "polite"),
+			c.Playground(values),
+		),
+		propsTable(c.Props),
+	)
+}
+
+// control renders the playground input of p
+func control(p Prop, value string) g.Node {
+	id := "catalog-prop-" + p.Name
+	inputClass := "h-9 w-full rounded-md border bg-background px-3 text-sm"
+
+	var input g.Node
+	switch p.Type {
+	case Bool:
+		return html.Label(
+			html.For(id),
+			html.Class("flex items-center gap-2 self-end text-sm font-medium"),
+			html.Input(
+				html.Type("checkbox"),
+				html.ID(id),
+				html.Name(p.Name),
+				html.Value("true"),
+				g.If(value == "true", html.Checked()),
+			),
+			g.Text(p.Name),
+		)
+	case Enum:
+		input = html.Select(
+			html.ID(id),
+			html.Name(p.Name),
+			html.Class(inputClass),
+			g.Map(p.Options, func(option string) g.Node {
+				return html.Option(html.Value(option), g.If(option == value, html.Selected()), g.Text(option))
+			}),
+		)
+	case Int:
+		input = html.Input(html.Type("number"), html.ID(id), html.Name(p.Name), html.Value(value), html.Class(inputClass))
+	default:
+		input = html.Input(html.Type("text"), html.ID(id), html.Name(p.Name), html.Value(value), html.Class(inputClass))
+	}
+	return html.Div(
+		html.Class("space-y-1.5"),
+		html.Label(html.For(id), html.Class("text-sm font-medium"), g.Text(p.Name)),
+		input,
+	)
+}
+
+// propsTable lists the props of a component
+func propsTable(props []Prop) g.Node {
+	cell := "border-b px-3 py-2 text-left align-top"
+	return html.Div(
+		html.Class("overflow-x-auto"),
+		html.Table(
+			html.Class("w-full text-sm"),
+			html.THead(html.Tr(
+				html.Th(html.Class(cell+" font-medium"), g.Text("Prop")),
+				html.Th(html.Class(cell+" font-medium"), g.Text("Type")),
+				html.Th(html.Class(cell+" font-medium"), g.Text("Default")),
+				html.Th(html.Class(cell+" font-medium"), g.Text("Description")),
+			)),
+			html.TBody(g.Map(props, func(p Prop) g.Node {
+				typ := string(cmp.Or(p.Type, String))
+				if p.Type == Enum {
+					typ = strings.Join(p.Options, " | ")
+				}
+				return html.Tr(
+					html.Td(html.Class(cell), html.Code(g.Text(p.Name))),
+					html.Td(html.Class(cell+" text-muted-foreground"), g.Text(typ)),
+					html.Td(html.Class(cell), g.If(p.Default != "", html.Code(g.Text(p.Default)))),
+					html.Td(html.Class(cell+" text-muted-foreground"), g.Text(p.Description)),
+				)
+			})),
+		),
+	)
+}
+
+// example renders a live example and its source
+func example(ex Example, source string) g.Node {
+	id := "example-" + Slug(ex.Name)
+	return html.Section(
+		html.Class("space-y-3"),
+		html.Aria("labelledby", id),
+		html.H2(html.ID(id), html.Class("text-xl font-semibold"), g.Text(ex.Name)),
+		g.If(ex.Description != "", html.P(html.Class("text-sm text-muted-foreground"), g.Text(ex.Description))),
+		html.Div(html.Class("rounded-lg border p-6"), ex.Render()),
+		g.If(source != "", html.Details(
+			html.Class("rounded-lg border"),
+			html.Summary(html.Class("cursor-pointer px-4 py-2 text-sm font-medium"), g.Text("Go source")),
+			html.Pre(
+				html.Class("overflow-x-auto border-t bg-muted p-4 text-sm"),
+				html.Code(html.Class("language-go"), g.Text(source)),
+			),
+		)),
+	)
+}
+
+// category is the components of a gallery section
+type category struct {
+	category   string
+	components []Component
+}
+
+// byCategory groups sorted components by category
+func byCategory(components []Component) []category {
+	var groups []category
+	for _, c := range components {
+		if len(groups) == 0 || groups[len(groups)-1].category != c.Category {
+			groups = append(groups, category{category: c.Category})
+		}
+		last := &groups[len(groups)-1]
+		last.components = append(last.components, c)
+	}
+	return groups
+}
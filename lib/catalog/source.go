@@ -0,0 +1,38 @@
+package catalog
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+)
+
+// funcSources returns the source of each top-level function in src, with its
+// doc comment, keyed by name. Methods are skipped. Source that doesn't parse
+// yields the functions parsed before the error.
+func funcSources(src string) map[string]string {
+	sources := map[string]string{}
+	if src == "" {
+		return sources
+	}
+	fset := token.NewFileSet()
+	file, _ := parser.ParseFile(fset, "example.go", src, parser.ParseComments|parser.SkipObjectResolution)
+	if file == nil {
+		return sources
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil {
+			continue
+		}
+		start := fn.Pos()
+		if fn.Doc != nil {
+			start = fn.Doc.Pos()
+		}
+		from, to := fset.Position(start).Offset, fset.Position(fn.End()).Offset
+		if from >= 0 && to <= len(src) && from < to {
+			sources[fn.Name.Name] = src[from:to]
+		}
+	}
+	return sources
+}
@@ -0,0 +1,8 @@
+package lib
+
+import "time"
+
+// Now returns the current time. Components that render dates, such as the
+// calendar's today marker, read the time through it so tests can render a
+// fixed date.
+var Now = time.Now
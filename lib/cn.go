@@ -6,22 +6,115 @@ import (
 
 // CN combines multiple class strings, filtering out empty strings and duplicates.
 // This is similar to the cn() function in shadcn/ui which uses clsx and tailwind-merge.
+//
+// CN is on the rendering hot path, so it avoids allocating: when a single
+// argument already holds the whole result it is returned as is, and
+// otherwise the result is built in one allocation.
 func CN(classes ...string) string {
-	classMap := make(map[string]bool)
-	var result []string
+	if class, ok := single(classes); ok {
+		return class
+	}
 
+	size := 0
+	for _, class := range classes {
+		size += len(class) + 1
+	}
+	var b strings.Builder
+	b.Grow(size)
 	for _, class := range classes {
-		// Split by spaces to handle multiple classes in one string
-		parts := strings.Fields(class)
-		for _, part := range parts {
-			if part != "" && !classMap[part] {
-				classMap[part] = true
-				result = append(result, part)
+		for rest := class; ; {
+			var token string
+			if token, rest = nextClass(rest); token == "" {
+				break
+			}
+			if hasClass(b.String(), token) {
+				continue
+			}
+			if b.Len() > 0 {
+				b.WriteByte(' ')
 			}
+			b.WriteString(token)
 		}
 	}
+	return b.String()
+}
 
-	return strings.Join(result, " ")
+// single returns the only non-blank class string, when it is already
+// normalized: separated by single spaces and free of duplicates
+func single(classes []string) (string, bool) {
+	found := ""
+	for _, class := range classes {
+		if isBlank(class) {
+			continue
+		}
+		if found != "" {
+			return "", false
+		}
+		found = class
+	}
+	if found == "" {
+		return "", true
+	}
+
+	for i := 0; i < len(found); i++ {
+		if c := found[i]; isSpace(c) && (c != ' ' || i == 0 || i == len(found)-1 || found[i+1] == ' ') {
+			return "", false
+		}
+	}
+	for seen, rest := 0, found; ; {
+		token, next := nextClass(rest)
+		if token == "" {
+			return found, true
+		}
+		if hasClass(found[:seen], token) {
+			return "", false
+		}
+		seen = len(found) - len(next)
+		rest = next
+	}
+}
+
+// nextClass returns the first class in s and the rest of s after it
+func nextClass(s string) (class, rest string) {
+	start := 0
+	for start < len(s) && isSpace(s[start]) {
+		start++
+	}
+	end := start
+	for end < len(s) && !isSpace(s[end]) {
+		end++
+	}
+	return s[start:end], s[end:]
+}
+
+// hasClass reports whether the space-separated list contains class
+func hasClass(list, class string) bool {
+	for i := 0; ; {
+		j := strings.Index(list[i:], class)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(class)
+		if (start == 0 || list[start-1] == ' ') && (end == len(list) || list[end] == ' ') {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isBlank(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isSpace(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSpace reports whether c separates classes. Class names are ASCII in
+// practice, so only ASCII whitespace is considered.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
 }
 
 // CNIf conditionally includes a class string based on a condition
@@ -38,4 +131,4 @@ func MergeClasses(classes ...string) string {
 	// For now, this is a simple implementation
 	// In a more complex version, we'd parse Tailwind classes and handle conflicts
 	return CN(classes...)
-}
\ No newline at end of file
+}
@@ -38,6 +38,21 @@ func TestCN(t *testing.T) {
 			classes:  []string{"", "", ""},
 			expected: "",
 		},
+		{
+			name:     "irregular whitespace",
+			classes:  []string{"  foo\tbar\n", " "},
+			expected: "foo bar",
+		},
+		{
+			name:     "duplicates within one string",
+			classes:  []string{"foo bar foo"},
+			expected: "foo bar",
+		},
+		{
+			name:     "prefixes are distinct classes",
+			classes:  []string{"p-2 p-20", "p-2", "px-2 p-20"},
+			expected: "p-2 p-20 px-2",
+		},
 	}
 
 	for _, tt := range tests {
@@ -82,4 +97,21 @@ func TestCNIf(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestCNAllocations(t *testing.T) {
+	base := "inline-flex items-center rounded-md text-sm"
+	if n := testing.AllocsPerRun(100, func() { CN(base, "", "  ") }); n != 0 {
+		t.Errorf("expected a single normalized class string to be returned without allocating, got %v allocs", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { CN(base, "w-full", "text-sm") }); n != 1 {
+		t.Errorf("expected one allocation when combining classes, got %v", n)
+	}
+}
+
+func BenchmarkCN(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		CN("inline-flex items-center justify-center rounded-md text-sm font-medium", "h-9 px-4 py-2", "w-full")
+	}
+}
@@ -0,0 +1,58 @@
+package lib
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCookieSigner(t *testing.T) {
+	signer := NewCookieSigner([]byte("test-secret"))
+
+	t.Run("round trip", func(t *testing.T) {
+		signed := signer.Sign("state", "true")
+		value, ok := signer.Verify("state", signed)
+		if !ok || value != "true" {
+			t.Errorf("Verify() = %q, %v, want %q, true", value, ok, "true")
+		}
+	})
+
+	t.Run("rejects tampered value", func(t *testing.T) {
+		signed := signer.Sign("state", "true")
+		forged := signer.Sign("state", "false")
+		tampered := forged[:len(forged)/2] + signed[len(signed)/2:]
+		if _, ok := signer.Verify("state", tampered); ok {
+			t.Error("Verify() accepted a tampered value")
+		}
+	})
+
+	t.Run("binds signature to cookie name", func(t *testing.T) {
+		signed := signer.Sign("state", "true")
+		if _, ok := signer.Verify("other", signed); ok {
+			t.Error("Verify() accepted a value signed for another cookie")
+		}
+	})
+
+	t.Run("rejects other key", func(t *testing.T) {
+		signed := NewCookieSigner([]byte("other-secret")).Sign("state", "true")
+		if _, ok := signer.Verify("state", signed); ok {
+			t.Error("Verify() accepted a value signed with another key")
+		}
+	})
+
+	t.Run("cookie round trip", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		signer.SetCookie(rec, "state", "a=1&b=2", time.Hour)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, c := range rec.Result().Cookies() {
+			req.AddCookie(c)
+		}
+
+		value, ok := signer.Cookie(req, "state")
+		if !ok || value != "a=1&b=2" {
+			t.Errorf("Cookie() = %q, %v, want %q, true", value, ok, "a=1&b=2")
+		}
+	})
+}
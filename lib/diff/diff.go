@@ -0,0 +1,188 @@
+// Package diff renders the line differences of two texts as a unified
+// diff, as shown by snapshot test failures and the shadcn-gomponents diff
+// command.
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// context is the number of unchanged lines shown around each change
+const context = 3
+
+// maxEdits bounds the work of finding the shortest diff. Texts that differ
+// by more lines are diffed as a replacement of everything between their
+// common prefix and suffix.
+const maxEdits = 1000
+
+// edit is a line of a diff: ' ' kept, '-' removed or '+' added
+type edit struct {
+	op   byte
+	line string
+}
+
+// Unified returns the unified diff of the lines of a and b, named aName and
+// bName in its header, or "" when they're equal
+func Unified(aName, bName, a, b string) string {
+	if a == b {
+		return ""
+	}
+	edits := diffLines(lines(a), lines(b))
+
+	// aAt[i] and bAt[i] count the lines of a and b before edits[i]
+	aAt, bAt := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for i, e := range edits {
+		aAt[i+1], bAt[i+1] = aAt[i], bAt[i]
+		if e.op != '+' {
+			aAt[i+1]++
+		}
+		if e.op != '-' {
+			bAt[i+1]++
+		}
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", aName, bName)
+	for i := 0; i < len(edits); i++ {
+		if edits[i].op == ' ' {
+			continue
+		}
+		// A hunk takes in the changes closer than twice the context lines
+		last := i
+		for j := i + 1; j < len(edits) && j-last <= 2*context; j++ {
+			if edits[j].op != ' ' {
+				last = j
+			}
+		}
+		start, end := max(i-context, 0), min(last+context+1, len(edits))
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n",
+			span(aAt[start]+1, aAt[end]-aAt[start]),
+			span(bAt[start]+1, bAt[end]-bAt[start]))
+		for _, e := range edits[start:end] {
+			out.WriteByte(e.op)
+			out.WriteString(e.line)
+			out.WriteByte('\n')
+		}
+		i = end - 1
+	}
+	return out.String()
+}
+
+// span formats the range of a hunk header
+func span(start, count int) string {
+	if count == 0 {
+		start--
+	}
+	if count == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// lines splits s into lines, without the final newline
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns the edits turning a into b
+func diffLines(a, b []string) []edit {
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var edits []edit
+	for _, l := range a[:prefix] {
+		edits = append(edits, edit{' ', l})
+	}
+	edits = append(edits, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		edits = append(edits, edit{' ', l})
+	}
+	return edits
+}
+
+// myers finds the shortest edits turning a into b with Myers' algorithm,
+// falling back to replacing a with b after maxEdits
+func myers(a, b []string) []edit {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int // trace[d] is v[-d:d+1] after d edits
+
+	for d := 0; d <= min(n+m, maxEdits); d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+				return backtrack(a, b, trace)
+			}
+		}
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+	}
+
+	edits := make([]edit, 0, n+m)
+	for _, l := range a {
+		edits = append(edits, edit{'-', l})
+	}
+	for _, l := range b {
+		edits = append(edits, edit{'+', l})
+	}
+	return edits
+}
+
+// backtrack follows the trace of myers back from the end of a and b
+func backtrack(a, b []string, trace [][]int) []edit {
+	var edits []edit
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1]
+		at := func(k int) int { return prev[k+d-1] }
+
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			edits = append(edits, edit{' ', a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			edits = append(edits, edit{'+', b[y-1]})
+			y--
+		} else {
+			edits = append(edits, edit{'-', a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		edits = append(edits, edit{' ', a[x-1]})
+		x--
+		y--
+	}
+	slices.Reverse(edits)
+	return edits
+}
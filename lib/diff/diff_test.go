@@ -0,0 +1,57 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	lines := func(n int, change map[int]string) string {
+		var b strings.Builder
+		for i := 1; i <= n; i++ {
+			if l, ok := change[i]; ok {
+				if l != "" {
+					b.WriteString(l + "\n")
+				}
+				continue
+			}
+			b.WriteString("line " + string(rune('a'+i-1)) + "\n")
+		}
+		return b.String()
+	}
+
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"equal", lines(3, nil), lines(3, nil), ""},
+		{
+			"change",
+			lines(10, nil),
+			lines(10, map[int]string{5: "changed"}),
+			"--- want\n+++ got\n@@ -2,7 +2,7 @@\n line b\n line c\n line d\n-line e\n+changed\n line f\n line g\n line h\n",
+		},
+		{
+			"insert and delete",
+			lines(3, nil),
+			lines(3, map[int]string{1: "", 3: "line c\nline d"}),
+			"--- want\n+++ got\n@@ -1,3 +1,3 @@\n-line a\n line b\n line c\n+line d\n",
+		},
+		{
+			"separate hunks",
+			lines(20, nil),
+			lines(20, map[int]string{2: "x", 19: "y"}),
+			"--- want\n+++ got\n@@ -1,5 +1,5 @@\n line a\n-line b\n+x\n line c\n line d\n line e\n" +
+				"@@ -16,5 +16,5 @@\n line p\n line q\n line r\n-line s\n+y\n line t\n",
+		},
+		{"from empty", "", "a\n", "--- want\n+++ got\n@@ -0,0 +1 @@\n+a\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("want", "got", tt.a, tt.b); got != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
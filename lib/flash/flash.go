@@ -0,0 +1,199 @@
+// Package flash carries one-time messages across a redirect so that a
+// Post/Redirect/Get flow can show the same toasts as an HTMX action.
+//
+// A handler records a message before redirecting, and the next full-page
+// render drains the messages into the toaster:
+//
+//	flash.Add(w, r, flash.Success, "Signed in", "Welcome back, Ada.")
+//	http.Redirect(w, r, "/", http.StatusSeeOther)
+//	...
+//	messages, _ := flash.Drain(w, r)
+//	sonner.Toaster(sonner.ToasterProps{Flash: messages})
+//
+// Messages are kept in a signed cookie by default. Applications with a
+// server-side session can plug in their own Store.
+package flash
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+)
+
+// Type is the kind of message, matching the toast types of the sonner and
+// toast components
+type Type string
+
+const (
+	Default Type = "default"
+	Success Type = "success"
+	Error   Type = "error"
+	Warning Type = "warning"
+	Info    Type = "info"
+)
+
+// Message is a single flash message
+type Message struct {
+	Type        Type   `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// Store persists pending messages between requests. Load must return the
+// messages saved earlier in the same response as well as those from previous
+// requests, so that several messages can be added before a redirect.
+type Store interface {
+	Load(w http.ResponseWriter, r *http.Request) ([]Message, error)
+	Save(w http.ResponseWriter, r *http.Request, messages []Message) error
+}
+
+// DefaultStore is used by Add and Drain
+var DefaultStore Store = CookieStore{}
+
+// Add records a message to be shown on the next page render
+func Add(w http.ResponseWriter, r *http.Request, typ Type, title, description string) error {
+	return AddMessage(DefaultStore, w, r, Message{Type: typ, Title: title, Description: description})
+}
+
+// Drain returns the pending messages and clears them
+func Drain(w http.ResponseWriter, r *http.Request) ([]Message, error) {
+	return DrainStore(DefaultStore, w, r)
+}
+
+// AddMessage records a message in store
+func AddMessage(store Store, w http.ResponseWriter, r *http.Request, m Message) error {
+	if m.Type == "" {
+		m.Type = Default
+	}
+	messages, err := store.Load(w, r)
+	if err != nil {
+		return err
+	}
+	return store.Save(w, r, append(messages, m))
+}
+
+// DrainStore returns the pending messages in store and clears them
+func DrainStore(store Store, w http.ResponseWriter, r *http.Request) ([]Message, error) {
+	messages, err := store.Load(w, r)
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+	return messages, store.Save(w, r, nil)
+}
+
+const (
+	// CookieName is the default name of the flash cookie
+	CookieName = "flash"
+	// CookieMaxAge is how long unread messages are kept by default
+	CookieMaxAge = 5 * time.Minute
+	// MaxMessages is the default number of messages kept; older ones are
+	// dropped so the cookie stays within browser size limits
+	MaxMessages = 10
+)
+
+// CookieStore keeps messages in a signed cookie.
+// The zero value uses CookieName and lib.DefaultCookieSigner.
+type CookieStore struct {
+	Name     string            // Cookie name (defaults to CookieName)
+	Signer   *lib.CookieSigner // Signer (defaults to lib.DefaultCookieSigner)
+	MaxAge   time.Duration     // Cookie lifetime (defaults to CookieMaxAge)
+	Messages int               // Messages kept (defaults to MaxMessages)
+}
+
+// Load returns the messages set on the response, or else those sent with the
+// request. A cookie that fails verification is ignored.
+func (s CookieStore) Load(w http.ResponseWriter, r *http.Request) ([]Message, error) {
+	value, ok := s.pending(w)
+	if !ok {
+		value, ok = s.signer().Cookie(r, s.name())
+	}
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var messages []Message
+	if err := json.Unmarshal([]byte(value), &messages); err != nil {
+		return nil, nil
+	}
+	return messages, nil
+}
+
+// Save replaces the flash cookie on the response. Saving no messages deletes
+// the cookie.
+func (s CookieStore) Save(w http.ResponseWriter, r *http.Request, messages []Message) error {
+	s.clearPending(w)
+	if len(messages) == 0 {
+		if _, err := r.Cookie(s.name()); err == nil {
+			s.signer().DeleteCookie(w, s.name())
+		}
+		return nil
+	}
+
+	limit := s.Messages
+	if limit <= 0 {
+		limit = MaxMessages
+	}
+	if len(messages) > limit {
+		messages = messages[len(messages)-limit:]
+	}
+	value, err := json.Marshal(messages)
+	if err != nil {
+		return err
+	}
+	maxAge := s.MaxAge
+	if maxAge == 0 {
+		maxAge = CookieMaxAge
+	}
+	s.signer().SetCookie(w, s.name(), string(value), maxAge)
+	return nil
+}
+
+// pending returns the value of the flash cookie already set on w
+func (s CookieStore) pending(w http.ResponseWriter) (string, bool) {
+	values := w.Header().Values("Set-Cookie")
+	for i := len(values) - 1; i >= 0; i-- {
+		cookie, err := http.ParseSetCookie(values[i])
+		if err != nil || cookie.Name != s.name() {
+			continue
+		}
+		if cookie.MaxAge < 0 {
+			return "", true
+		}
+		return s.signer().Verify(s.name(), cookie.Value)
+	}
+	return "", false
+}
+
+// clearPending removes flash cookies already set on w so that only one is sent
+func (s CookieStore) clearPending(w http.ResponseWriter) {
+	values := w.Header().Values("Set-Cookie")
+	kept := values[:0:0]
+	for _, v := range values {
+		if cookie, err := http.ParseSetCookie(v); err == nil && cookie.Name == s.name() {
+			continue
+		}
+		kept = append(kept, v)
+	}
+	if len(kept) == len(values) {
+		return
+	}
+	w.Header().Del("Set-Cookie")
+	for _, v := range kept {
+		w.Header().Add("Set-Cookie", v)
+	}
+}
+
+func (s CookieStore) name() string {
+	if s.Name == "" {
+		return CookieName
+	}
+	return s.Name
+}
+
+func (s CookieStore) signer() *lib.CookieSigner {
+	if s.Signer == nil {
+		return lib.DefaultCookieSigner
+	}
+	return s.Signer
+}
@@ -0,0 +1,111 @@
+package flash
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+)
+
+// next returns a request carrying the cookies set on rec
+func next(rec *httptest.ResponseRecorder) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range rec.Result().Cookies() {
+		if c.MaxAge >= 0 {
+			r.AddCookie(c)
+		}
+	}
+	return r
+}
+
+func TestAddDrain(t *testing.T) {
+	store := CookieStore{Signer: lib.NewCookieSigner([]byte("secret"))}
+
+	// Several messages added before a redirect share one cookie
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/login", nil)
+	if err := AddMessage(store, rec, r, Message{Type: Success, Title: "Signed in"}); err != nil {
+		t.Fatalf("AddMessage() error = %v", err)
+	}
+	if err := AddMessage(store, rec, r, Message{Title: "Two new messages", Description: "From Grace"}); err != nil {
+		t.Fatalf("AddMessage() error = %v", err)
+	}
+	if n := len(rec.Header().Values("Set-Cookie")); n != 1 {
+		t.Fatalf("Set-Cookie headers = %d, want 1", n)
+	}
+
+	// The next page render drains them
+	rec2 := httptest.NewRecorder()
+	messages, err := DrainStore(store, rec2, next(rec))
+	if err != nil {
+		t.Fatalf("DrainStore() error = %v", err)
+	}
+	want := []Message{
+		{Type: Success, Title: "Signed in"},
+		{Type: Default, Title: "Two new messages", Description: "From Grace"},
+	}
+	if len(messages) != len(want) {
+		t.Fatalf("DrainStore() = %v, want %v", messages, want)
+	}
+	for i := range want {
+		if messages[i] != want[i] {
+			t.Errorf("message %d = %v, want %v", i, messages[i], want[i])
+		}
+	}
+	cookies := rec2.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].MaxAge >= 0 {
+		t.Errorf("DrainStore() cookies = %v, want the flash cookie deleted", cookies)
+	}
+
+	// Nothing is left afterwards
+	if messages, _ := DrainStore(store, httptest.NewRecorder(), next(rec2)); len(messages) != 0 {
+		t.Errorf("second DrainStore() = %v, want none", messages)
+	}
+}
+
+func TestDrainSameResponse(t *testing.T) {
+	store := CookieStore{Signer: lib.NewCookieSigner([]byte("secret"))}
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+
+	// A form re-rendered without a redirect shows its messages immediately
+	_ = AddMessage(store, rec, r, Message{Type: Error, Title: "Invalid email"})
+	messages, _ := DrainStore(store, rec, r)
+	if len(messages) != 1 || messages[0].Title != "Invalid email" {
+		t.Errorf("DrainStore() = %v, want the message added on this response", messages)
+	}
+	if n := len(rec.Header().Values("Set-Cookie")); n != 0 {
+		t.Errorf("Set-Cookie headers = %d, want 0", n)
+	}
+}
+
+func TestCookieStoreRejectsTampering(t *testing.T) {
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	_ = AddMessage(CookieStore{Signer: lib.NewCookieSigner([]byte("other"))}, rec, r, Message{Title: "Forged"})
+
+	store := CookieStore{Signer: lib.NewCookieSigner([]byte("secret"))}
+	if messages, err := DrainStore(store, httptest.NewRecorder(), next(rec)); err != nil || len(messages) != 0 {
+		t.Errorf("DrainStore() = %v, %v, want no messages", messages, err)
+	}
+}
+
+func TestCookieStoreLimit(t *testing.T) {
+	store := CookieStore{Signer: lib.NewCookieSigner([]byte("secret")), Messages: 2}
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, title := range []string{"one", "two", "three"} {
+		_ = AddMessage(store, rec, r, Message{Title: title})
+	}
+
+	messages, _ := DrainStore(store, httptest.NewRecorder(), next(rec))
+	var titles []string
+	for _, m := range messages {
+		titles = append(titles, m.Title)
+	}
+	if got := strings.Join(titles, ","); got != "two,three" {
+		t.Errorf("DrainStore() titles = %s, want two,three", got)
+	}
+}
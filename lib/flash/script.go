@@ -0,0 +1,39 @@
+package flash
+
+import (
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// Item returns the attribute that marks a toast rendered from a flash message
+func Item() g.Node {
+	return g.Attr("data-flash", "")
+}
+
+// Script dismisses the flash toasts inside the script's parent element. Each
+// toast is removed after its data-duration in milliseconds, unless the pointer
+// is over it, or when a [data-toast-close] button inside it is clicked.
+func Script() g.Node {
+	return html.Script(g.Raw(script))
+}
+
+const script = `
+(function() {
+	const root = document.currentScript && document.currentScript.parentElement;
+	if (!root) return;
+	root.querySelectorAll('[data-flash]').forEach(function(toast) {
+		function dismiss() {
+			toast.setAttribute('data-state', 'closed');
+			setTimeout(function() { toast.remove(); }, 300);
+		}
+		toast.addEventListener('click', function(e) {
+			if (e.target.closest('[data-toast-close]')) dismiss();
+		});
+		const duration = parseInt(toast.getAttribute('data-duration'), 10);
+		if (!(duration > 0)) return;
+		let timer = setTimeout(dismiss, duration);
+		toast.addEventListener('mouseenter', function() { clearTimeout(timer); });
+		toast.addEventListener('mouseleave', function() { timer = setTimeout(dismiss, duration); });
+	});
+})();
+`
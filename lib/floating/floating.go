@@ -0,0 +1,219 @@
+// Package floating positions popovers, tooltips, hover cards and menus
+// against their anchor while keeping them inside the viewport.
+//
+// Components mark their floating element with Attrs, which renders the
+// preferred placement as data-side/data-align plus offset and collision
+// settings. Script, rendered once per page, measures the anchor, flips to the
+// opposite side when the preferred side overflows, shifts along the alignment
+// axis to stay on screen, positions an optional Arrow, and writes the final
+// placement back to data-side/data-align so the data-[side=...] animation
+// classes follow the side that was actually used. Without JavaScript the
+// component's own Tailwind position classes still apply.
+package floating
+
+import (
+	"strconv"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+const (
+	SideTop    = "top"
+	SideRight  = "right"
+	SideBottom = "bottom"
+	SideLeft   = "left"
+
+	AlignStart  = "start"
+	AlignCenter = "center"
+	AlignEnd    = "end"
+)
+
+// Collision controls how a floating element reacts to the viewport edges
+type Collision struct {
+	Padding      int  // Minimum distance from the viewport edges in pixels
+	DisableFlip  bool // Keep the preferred side even when it overflows
+	DisableShift bool // Allow overflow along the alignment axis
+}
+
+// Props describes where a floating element is placed relative to its anchor
+type Props struct {
+	Side        string    // "top" | "right" | "bottom" | "left"
+	Align       string    // "start" | "center" | "end"
+	SideOffset  int       // Distance from the anchor in pixels
+	AlignOffset int       // Shift along the alignment axis in pixels
+	Collision   Collision // Viewport collision handling
+	Anchor      string    // CSS selector of the anchor (defaults to the previous sibling)
+}
+
+// Point anchors a floating element to page coordinates instead of an element,
+// as used by context menus opened at the pointer
+type Point struct {
+	X int
+	Y int
+}
+
+// Attrs returns the attributes that hand an element to the positioning script
+func Attrs(props Props) g.Node {
+	if props.Side == "" {
+		props.Side = SideBottom
+	}
+	if props.Align == "" {
+		props.Align = AlignCenter
+	}
+
+	return g.Group([]g.Node{
+		g.Attr("data-floating", ""),
+		g.Attr("data-side", props.Side),
+		g.Attr("data-align", props.Align),
+		g.If(props.SideOffset != 0, g.Attr("data-side-offset", strconv.Itoa(props.SideOffset))),
+		g.If(props.AlignOffset != 0, g.Attr("data-align-offset", strconv.Itoa(props.AlignOffset))),
+		g.If(props.Collision.Padding != 0, g.Attr("data-collision-padding", strconv.Itoa(props.Collision.Padding))),
+		g.If(props.Collision.DisableFlip, g.Attr("data-flip", "false")),
+		g.If(props.Collision.DisableShift, g.Attr("data-shift", "false")),
+		g.If(props.Anchor != "", g.Attr("data-floating-anchor", props.Anchor)),
+	})
+}
+
+// AtPoint returns Attrs anchored to page coordinates
+func AtPoint(props Props, point Point) g.Node {
+	return g.Group([]g.Node{
+		Attrs(props),
+		g.Attr("data-floating-x", strconv.Itoa(point.X)),
+		g.Attr("data-floating-y", strconv.Itoa(point.Y)),
+	})
+}
+
+// Arrow marks the element that points at the anchor
+func Arrow() g.Node {
+	return g.Attr("data-floating-arrow", "")
+}
+
+// Script positions every floating element on the page. Render it once, for
+// example at the end of the body; it also handles content swapped in by HTMX.
+func Script() g.Node {
+	return html.Script(g.Raw(script))
+}
+
+const script = `
+(function() {
+	if (window.shadcnFloating) return;
+	const opposite = { top: 'bottom', bottom: 'top', left: 'right', right: 'left' };
+
+	function num(el, name, fallback) {
+		const value = parseFloat(el.dataset[name]);
+		return isNaN(value) ? fallback : value;
+	}
+	function anchorRect(el) {
+		if (el.dataset.floatingX !== undefined) {
+			const x = num(el, 'floatingX', 0) - window.scrollX, y = num(el, 'floatingY', 0) - window.scrollY;
+			return { top: y, bottom: y, left: x, right: x, width: 0, height: 0 };
+		}
+		let anchor = el.dataset.floatingAnchor ? document.querySelector(el.dataset.floatingAnchor) : null;
+		if (!anchor) {
+			anchor = el.previousElementSibling;
+			while (anchor && (anchor.tagName === 'SCRIPT' || anchor.tagName === 'STYLE')) anchor = anchor.previousElementSibling;
+		}
+		anchor = anchor || el.parentElement;
+		return anchor ? anchor.getBoundingClientRect() : null;
+	}
+	function place(a, w, h, side, align, sideOffset, alignOffset) {
+		const vertical = side === 'top' || side === 'bottom';
+		let x, y;
+		if (side === 'top') y = a.top - h - sideOffset;
+		if (side === 'bottom') y = a.bottom + sideOffset;
+		if (side === 'left') x = a.left - w - sideOffset;
+		if (side === 'right') x = a.right + sideOffset;
+		if (vertical) {
+			x = align === 'start' ? a.left : align === 'end' ? a.right - w : a.left + (a.width - w) / 2;
+			x += alignOffset;
+		} else {
+			y = align === 'start' ? a.top : align === 'end' ? a.bottom - h : a.top + (a.height - h) / 2;
+			y += alignOffset;
+		}
+		return { x: x, y: y };
+	}
+	function overflow(pos, w, h, side, pad) {
+		const vw = document.documentElement.clientWidth, vh = document.documentElement.clientHeight;
+		if (side === 'top') return pad - pos.y;
+		if (side === 'bottom') return pos.y + h - (vh - pad);
+		if (side === 'left') return pad - pos.x;
+		return pos.x + w - (vw - pad);
+	}
+
+	function position(el) {
+		if (el.getClientRects().length === 0) return;
+		const a = anchorRect(el);
+		if (!a) return;
+		const preferred = el.dataset.floatingSide || el.dataset.side || 'bottom';
+		const align = el.dataset.floatingAlign || el.dataset.align || 'center';
+		el.dataset.floatingSide = preferred;
+		el.dataset.floatingAlign = align;
+		const sideOffset = num(el, 'sideOffset', 0), alignOffset = num(el, 'alignOffset', 0);
+		const pad = num(el, 'collisionPadding', 0);
+
+		Object.assign(el.style, { position: 'fixed', top: '0px', left: '0px', right: 'auto', bottom: 'auto', margin: '0', transform: 'none', translate: 'none' });
+		const w = el.offsetWidth, h = el.offsetHeight;
+
+		let side = preferred;
+		let pos = place(a, w, h, side, align, sideOffset, alignOffset);
+		if (el.dataset.flip !== 'false' && overflow(pos, w, h, side, pad) > 0) {
+			const flipped = place(a, w, h, opposite[side], align, sideOffset, alignOffset);
+			if (overflow(flipped, w, h, opposite[side], pad) < overflow(pos, w, h, side, pad)) {
+				side = opposite[side];
+				pos = flipped;
+			}
+		}
+		if (el.dataset.shift !== 'false') {
+			const vw = document.documentElement.clientWidth, vh = document.documentElement.clientHeight;
+			if (side === 'top' || side === 'bottom') {
+				pos.x = Math.max(pad, Math.min(pos.x, vw - pad - w));
+			} else {
+				pos.y = Math.max(pad, Math.min(pos.y, vh - pad - h));
+			}
+		}
+
+		el.style.left = Math.round(pos.x) + 'px';
+		el.style.top = Math.round(pos.y) + 'px';
+		el.dataset.side = side;
+		el.dataset.align = align;
+
+		const arrow = el.querySelector('[data-floating-arrow]');
+		if (arrow) {
+			const size = arrow.offsetWidth || 8;
+			const vertical = side === 'top' || side === 'bottom';
+			const center = vertical ? a.left + a.width / 2 - pos.x : a.top + a.height / 2 - pos.y;
+			const max = (vertical ? w : h) - size - 4;
+			const offset = Math.max(4, Math.min(center - size / 2, max));
+			Object.assign(arrow.style, { top: '', bottom: '', left: '', right: '', transform: '', translate: 'none' });
+			arrow.style[vertical ? 'left' : 'top'] = offset + 'px';
+			arrow.style[opposite[side]] = (-size / 2) + 'px';
+		}
+	}
+
+	// Positioning writes styles and data attributes that the observer
+	// watches, so its own records are dropped to avoid a layout loop.
+	const observer = new MutationObserver(schedule);
+	function update() {
+		document.querySelectorAll('[data-floating]').forEach(position);
+		observer.takeRecords();
+	}
+	let frame = 0;
+	function schedule() {
+		if (frame) return;
+		frame = requestAnimationFrame(() => { frame = 0; update(); });
+	}
+
+	window.addEventListener('scroll', schedule, true);
+	window.addEventListener('resize', schedule);
+	document.addEventListener('pointerover', schedule, true);
+	document.addEventListener('focusin', schedule, true);
+	document.addEventListener('htmx:afterSettle', schedule);
+	observer.observe(document.documentElement, {
+		subtree: true, childList: true, attributes: true, attributeFilter: ['style', 'class', 'hidden', 'data-state']
+	});
+
+	window.shadcnFloating = { position: position, update: update };
+	schedule();
+})();
+`
@@ -0,0 +1,62 @@
+package floating
+
+import (
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	html "maragu.dev/gomponents/html"
+)
+
+func TestAttrs(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    Props
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "defaults",
+			props:    Props{},
+			contains: []string{`data-floating=""`, `data-side="bottom"`, `data-align="center"`},
+			excludes: []string{"data-side-offset", "data-collision-padding", "data-flip", "data-shift", "data-floating-anchor"},
+		},
+		{
+			name: "all options",
+			props: Props{
+				Side:        SideTop,
+				Align:       AlignEnd,
+				SideOffset:  8,
+				AlignOffset: -4,
+				Collision:   Collision{Padding: 12, DisableFlip: true, DisableShift: true},
+				Anchor:      "#trigger",
+			},
+			contains: []string{
+				`data-side="top"`,
+				`data-align="end"`,
+				`data-side-offset="8"`,
+				`data-align-offset="-4"`,
+				`data-collision-padding="12"`,
+				`data-flip="false"`,
+				`data-shift="false"`,
+				`data-floating-anchor="#trigger"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := a11ytest.Render(t, html.Div(Attrs(tt.props)))
+			a11ytest.Markup(t, result, tt.contains, tt.excludes)
+		})
+	}
+}
+
+func TestAtPoint(t *testing.T) {
+	result := a11ytest.Render(t, html.Div(AtPoint(Props{Side: SideRight}, Point{X: 120, Y: 48})))
+	a11ytest.Markup(t, result, []string{`data-side="right"`, `data-floating-x="120"`, `data-floating-y="48"`}, nil)
+}
+
+func TestScript(t *testing.T) {
+	result := a11ytest.Render(t, Script())
+	a11ytest.Markup(t, result, []string{"window.shadcnFloating", "[data-floating]", "[data-floating-arrow]", "dataset.side = side", "observer.takeRecords()"}, nil)
+}
@@ -0,0 +1,63 @@
+package icons
+
+import (
+	"slices"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// strokeAttrs are the default attributes of the outlined Lucide icons
+var strokeAttrs = []g.Node{
+	g.Attr("viewBox", "0 0 24 24"),
+	g.Attr("aria-hidden", "true"),
+	g.Attr("width", "24"),
+	g.Attr("height", "24"),
+	g.Attr("fill", "none"),
+	g.Attr("stroke", "currentColor"),
+	g.Attr("stroke-width", "2"),
+	g.Attr("stroke-linecap", "round"),
+	g.Attr("stroke-linejoin", "round"),
+}
+
+// fillAttrs are the default attributes of filled icons
+var fillAttrs = []g.Node{
+	g.Attr("viewBox", "0 0 24 24"),
+	g.Attr("aria-hidden", "true"),
+	g.Attr("width", "24"),
+	g.Attr("height", "24"),
+	g.Attr("fill", "currentColor"),
+	g.Attr("stroke", "none"),
+}
+
+// spinAttrs are the default attributes of spinning icons
+var spinAttrs = append(slices.Clip(strokeAttrs), html.Class("animate-spin"))
+
+// icon is an SVG icon prerendered once. Icons are repeated in every row of
+// tables and lists, so rendering one without extra attributes writes stored
+// markup, and extra attributes are written between the stored default
+// attributes and shapes.
+type icon struct {
+	attrs  g.Node // Default attributes
+	shapes g.Node // Child elements
+	static g.Node // The whole icon with its default attributes
+}
+
+// newIcon prerenders an icon with its default attributes and shapes
+func newIcon(attrs []g.Node, shapes ...g.Node) icon {
+	return icon{
+		attrs:  lib.Static(g.Group(attrs)),
+		shapes: lib.Static(g.Group(shapes)),
+		static: lib.Static(g.El("svg", slices.Concat(attrs, shapes)...)),
+	}
+}
+
+// render returns the icon with extra attributes, which follow the default
+// ones
+func (i icon) render(attrs []g.Node) g.Node {
+	if len(attrs) == 0 {
+		return i.static
+	}
+	return lib.El("svg", i.attrs, g.Group(attrs), i.shapes)
+}
@@ -0,0 +1,38 @@
+package icons
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+func TestPrerenderedIcons(t *testing.T) {
+	shapes := []g.Node{g.El("path", g.Attr("d", "m9 18 6-6-6-6"))}
+	extra := []g.Node{html.Class("h-4 w-4"), g.El("title", g.Text("Next")), g.Group{g.Attr("data-icon", "")}}
+
+	tests := []struct {
+		name  string
+		attrs []g.Node
+	}{
+		{"default attributes", nil},
+		{"extra attributes and children", extra},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want, got strings.Builder
+			_ = g.El("svg", slices.Concat(strokeAttrs, tt.attrs, shapes)...).Render(&want)
+			_ = ChevronRight(tt.attrs...).Render(&got)
+			if got.String() != want.String() {
+				t.Errorf("expected %s, got %s", want.String(), got.String())
+			}
+		})
+	}
+
+	if n := testing.AllocsPerRun(100, func() { _ = Check().Render(io.Discard) }); n != 0 {
+		t.Errorf("expected an icon without attributes to not allocate, got %v allocs", n)
+	}
+}
@@ -0,0 +1,229 @@
+// Package modal provides the behaviour shared by modal overlays such as
+// dialog, alertdialog, sheet and drawer: initial focus, a focus trap, inert
+// background content, scroll locking, Escape and overlay-click dismissal, and
+// focus restoration when the modal goes away.
+//
+// Components mark their parts with the attribute helpers in this package and
+// render Script inside the modal root. The script works for modals rendered
+// with the page as well as modals swapped in by HTMX. When the root carries a
+// close path, dismissal asks the server for the closed markup; otherwise the
+// root is hidden on the client and a cancelable "modal:close" event fires.
+package modal
+
+import (
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// Props configures the behaviour of a modal root
+type Props struct {
+	ClosePath           string // HTMX path fetched to dismiss the modal (swaps the root)
+	InitialFocus        string // CSS selector of the element focused on open
+	DisableEscape       bool   // Whether Escape is ignored
+	DisableOverlayClose bool   // Whether clicking the overlay is ignored
+}
+
+// ContentProps configures the ARIA wiring of the modal content
+type ContentProps struct {
+	Role        string // "dialog" (default) | "alertdialog"
+	LabelledBy  string // ID of the title; found automatically when empty
+	DescribedBy string // ID of the description; found automatically when empty
+}
+
+// Root returns the attributes for the element that wraps overlay and content
+func Root(props Props) g.Node {
+	return g.Group([]g.Node{
+		g.Attr("data-modal", ""),
+		g.If(props.ClosePath != "", g.Attr("data-modal-close-path", props.ClosePath)),
+		g.If(props.InitialFocus != "", g.Attr("data-modal-initial-focus", props.InitialFocus)),
+		g.If(props.DisableEscape, g.Attr("data-modal-escape", "false")),
+		g.If(props.DisableOverlayClose, g.Attr("data-modal-overlay-close", "false")),
+	})
+}
+
+// Content returns the attributes for the focus-trapped content element
+func Content(props ContentProps) g.Node {
+	role := props.Role
+	if role == "" {
+		role = "dialog"
+	}
+
+	return g.Group([]g.Node{
+		html.Role(role),
+		g.Attr("aria-modal", "true"),
+		g.If(props.LabelledBy != "", g.Attr("aria-labelledby", props.LabelledBy)),
+		g.If(props.DescribedBy != "", g.Attr("aria-describedby", props.DescribedBy)),
+		html.TabIndex("-1"),
+		g.Attr("data-modal-content", ""),
+	})
+}
+
+// Overlay marks the backdrop that dismisses the modal when clicked
+func Overlay() g.Node {
+	return g.Attr("data-modal-overlay", "")
+}
+
+// Title marks the element that labels the modal
+func Title() g.Node {
+	return g.Attr("data-modal-title", "")
+}
+
+// Description marks the element that describes the modal
+func Description() g.Node {
+	return g.Attr("data-modal-description", "")
+}
+
+// Close marks an element that dismisses the modal when clicked. Elements that
+// already issue their own HTMX request are left alone.
+func Close() g.Node {
+	return g.Attr("data-modal-close", "")
+}
+
+// Script activates the modal root it is rendered in. The behaviour is defined
+// once per page and shared by every modal.
+func Script() g.Node {
+	return html.Script(g.Raw(script))
+}
+
+const script = `
+(function() {
+	if (!window.shadcnModal) {
+		const stack = [];
+		const tabbable = 'a[href], area[href], button:not([disabled]), input:not([disabled]):not([type="hidden"]), select:not([disabled]), textarea:not([disabled]), iframe, [contenteditable="true"], [tabindex]:not([tabindex="-1"])';
+		let scrollLocks = 0;
+		let savedOverflow = '';
+		let savedPadding = '';
+
+		function content(root) {
+			return root.querySelector('[data-modal-content]') || root;
+		}
+		function focusables(root) {
+			return Array.from(content(root).querySelectorAll(tabbable))
+				.filter(el => !el.closest('[inert]') && el.getClientRects().length > 0);
+		}
+		function wireLabels(root) {
+			const el = content(root);
+			[['aria-labelledby', '[data-modal-title]', 'title'], ['aria-describedby', '[data-modal-description]', 'description']].forEach(([attr, selector, suffix]) => {
+				if (el.hasAttribute(attr)) return;
+				const target = el.querySelector(selector);
+				if (!target) return;
+				if (!target.id) target.id = (root.id || 'modal-' + Math.random().toString(36).slice(2)) + '-' + suffix;
+				el.setAttribute(attr, target.id);
+			});
+		}
+		function lockScroll() {
+			if (scrollLocks++ > 0) return;
+			const body = document.body;
+			const gap = window.innerWidth - document.documentElement.clientWidth;
+			savedOverflow = body.style.overflow;
+			savedPadding = body.style.paddingRight;
+			body.style.overflow = 'hidden';
+			if (gap > 0) body.style.paddingRight = gap + 'px';
+		}
+		function unlockScroll() {
+			if (--scrollLocks > 0) return;
+			document.body.style.overflow = savedOverflow;
+			document.body.style.paddingRight = savedPadding;
+		}
+		function makeInert(root) {
+			const changed = [];
+			for (let node = root; node && node !== document.body; node = node.parentElement) {
+				const parent = node.parentElement;
+				if (!parent) break;
+				Array.from(parent.children).forEach(sibling => {
+					if (sibling === node || sibling.inert || sibling.tagName === 'SCRIPT') return;
+					sibling.inert = true;
+					changed.push(sibling);
+				});
+			}
+			return changed;
+		}
+		function focusInitial(root) {
+			const selector = root.dataset.modalInitialFocus;
+			const target = (selector && root.querySelector(selector)) ||
+				root.querySelector('[autofocus]') ||
+				focusables(root)[0] ||
+				content(root);
+			target.focus({ preventScroll: true });
+		}
+
+		function activate(root) {
+			if (!root || root.hidden || stack.some(entry => entry.root === root)) return;
+			wireLabels(root);
+			stack.push({ root: root, restore: document.activeElement, inert: makeInert(root) });
+			lockScroll();
+			focusInitial(root);
+		}
+		function release(entry) {
+			stack.splice(stack.indexOf(entry), 1);
+			entry.inert.forEach(el => { el.inert = false; });
+			unlockScroll();
+			if (entry.restore && document.contains(entry.restore)) {
+				entry.restore.focus({ preventScroll: true });
+			}
+		}
+		function dismiss(root) {
+			const path = root.dataset.modalClosePath;
+			if (path && window.htmx) {
+				htmx.ajax('GET', path, { target: root, swap: 'outerHTML' });
+				return;
+			}
+			const event = new CustomEvent('modal:close', { bubbles: true, cancelable: true });
+			if (!root.dispatchEvent(event)) return;
+			root.querySelectorAll('[data-state]').forEach(el => { el.dataset.state = 'closed'; });
+			root.dataset.state = 'closed';
+			const entry = stack.find(e => e.root === root);
+			if (entry) release(entry);
+			setTimeout(() => { root.hidden = true; }, 200);
+		}
+		function top() {
+			return stack[stack.length - 1];
+		}
+
+		document.addEventListener('keydown', (e) => {
+			const entry = top();
+			if (!entry) return;
+			if (e.key === 'Escape' && entry.root.dataset.modalEscape !== 'false') {
+				e.preventDefault();
+				dismiss(entry.root);
+			} else if (e.key === 'Tab') {
+				const items = focusables(entry.root);
+				if (items.length === 0) {
+					e.preventDefault();
+					content(entry.root).focus();
+					return;
+				}
+				const first = items[0], last = items[items.length - 1];
+				if (e.shiftKey && (document.activeElement === first || !entry.root.contains(document.activeElement))) {
+					e.preventDefault();
+					last.focus();
+				} else if (!e.shiftKey && (document.activeElement === last || !entry.root.contains(document.activeElement))) {
+					e.preventDefault();
+					first.focus();
+				}
+			}
+		});
+
+		document.addEventListener('click', (e) => {
+			const entry = top();
+			if (!entry) return;
+			const overlay = e.target.matches('[data-modal-overlay]') ? e.target : null;
+			const close = e.target.closest('[data-modal-close]');
+			const el = overlay || close;
+			if (!el || !entry.root.contains(el) || el.hasAttribute('hx-get') || el.hasAttribute('hx-post')) return;
+			if (overlay && entry.root.dataset.modalOverlayClose === 'false') return;
+			dismiss(entry.root);
+		});
+
+		new MutationObserver(() => {
+			stack.slice().forEach(entry => {
+				if (!document.contains(entry.root) || entry.root.hidden) release(entry);
+			});
+		}).observe(document.documentElement, { childList: true, subtree: true, attributes: true, attributeFilter: ['hidden'] });
+
+		window.shadcnModal = { activate: activate, dismiss: dismiss };
+	}
+	const root = document.currentScript && document.currentScript.closest('[data-modal]');
+	if (root) window.shadcnModal.activate(root);
+})();
+`
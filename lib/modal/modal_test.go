@@ -0,0 +1,65 @@
+package modal
+
+import (
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	html "maragu.dev/gomponents/html"
+)
+
+func TestRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    Props
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "defaults",
+			props:    Props{},
+			contains: []string{`data-modal=""`},
+			excludes: []string{"data-modal-close-path", "data-modal-escape", "data-modal-overlay-close"},
+		},
+		{
+			name: "all options",
+			props: Props{
+				ClosePath:           "/close",
+				InitialFocus:        "#name",
+				DisableEscape:       true,
+				DisableOverlayClose: true,
+			},
+			contains: []string{
+				`data-modal-close-path="/close"`,
+				`data-modal-initial-focus="#name"`,
+				`data-modal-escape="false"`,
+				`data-modal-overlay-close="false"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := a11ytest.Render(t, html.Div(Root(tt.props)))
+			a11ytest.Markup(t, result, tt.contains, tt.excludes)
+		})
+	}
+}
+
+func TestContent(t *testing.T) {
+	result := a11ytest.Render(t, html.Div(Content(ContentProps{LabelledBy: "t", DescribedBy: "d"})))
+	a11ytest.Markup(t, result, []string{
+		`role="dialog"`,
+		`aria-modal="true"`,
+		`aria-labelledby="t"`,
+		`aria-describedby="d"`,
+		`tabindex="-1"`,
+	}, nil)
+
+	result = a11ytest.Render(t, html.Div(Content(ContentProps{Role: "alertdialog"})))
+	a11ytest.Markup(t, result, []string{`role="alertdialog"`}, []string{"aria-labelledby"})
+}
+
+func TestScript(t *testing.T) {
+	result := a11ytest.Render(t, Script())
+	a11ytest.Markup(t, result, []string{"window.shadcnModal", "inert", "'Escape'", "'Tab'", "modal:close", "e.target.matches('[data-modal-overlay]')"}, nil)
+}
@@ -0,0 +1,393 @@
+package otp
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// Errors returned when a code is rejected
+var (
+	ErrInvalidCode     = errors.New("otp: invalid code")
+	ErrExpired         = errors.New("otp: code expired")
+	ErrNoCode          = errors.New("otp: no code issued")
+	ErrTooManyAttempts = errors.New("otp: too many attempts")
+)
+
+// CooldownError is returned by Issuer.Issue when a code was sent too recently
+type CooldownError struct {
+	RetryAfter time.Duration
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("otp: resend available in %s", e.RetryAfter.Round(time.Second))
+}
+
+// Sender delivers a one-time code to an email address, phone number or other
+// destination
+type Sender interface {
+	Send(ctx context.Context, to, code string) error
+}
+
+// SenderFunc adapts a function to the Sender interface
+type SenderFunc func(ctx context.Context, to, code string) error
+
+// Send calls f(ctx, to, code)
+func (f SenderFunc) Send(ctx context.Context, to, code string) error {
+	return f(ctx, to, code)
+}
+
+// Challenge is the server-side state of an issued code. The code itself is
+// not stored, only its HMAC.
+type Challenge struct {
+	Hash     []byte
+	SentAt   time.Time
+	Expires  time.Time
+	Attempts int
+}
+
+// Equal reports whether c and o hold the same code, times and attempts
+func (c Challenge) Equal(o Challenge) bool {
+	return bytes.Equal(c.Hash, o.Hash) && c.SentAt.Equal(o.SentAt) && c.Expires.Equal(o.Expires) && c.Attempts == o.Attempts
+}
+
+// Store persists challenges by subject, such as a session or user ID
+type Store interface {
+	Get(ctx context.Context, subject string) (Challenge, bool, error)
+	Set(ctx context.Context, subject string, c Challenge) error
+	Delete(ctx context.Context, subject string) error
+	// Attempt increments the attempts of subject's challenge and returns the
+	// new count, or ErrNoCode if there is no challenge. It must be atomic, so
+	// that parallel guesses can't exceed the limit.
+	Attempt(ctx context.Context, subject string) (int, error)
+	// CompareAndSwap stores c for subject if the stored challenge equals old,
+	// or if there is none and old is nil, and reports whether it did. It must
+	// be atomic, so that parallel sends can't all pass the cooldown.
+	CompareAndSwap(ctx context.Context, subject string, old *Challenge, c Challenge) (bool, error)
+}
+
+// MemoryStore is a Store for a single process
+type MemoryStore struct {
+	mu         sync.Mutex
+	challenges map[string]Challenge
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{challenges: make(map[string]Challenge)}
+}
+
+// Get returns the challenge for subject
+func (s *MemoryStore) Get(ctx context.Context, subject string) (Challenge, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.challenges[subject]
+	return c, ok, nil
+}
+
+// Set stores the challenge for subject
+func (s *MemoryStore) Set(ctx context.Context, subject string, c Challenge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.challenges[subject] = c
+	return nil
+}
+
+// Delete removes the challenge for subject
+func (s *MemoryStore) Delete(ctx context.Context, subject string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.challenges, subject)
+	return nil
+}
+
+// Attempt increments the attempts of subject's challenge
+func (s *MemoryStore) Attempt(ctx context.Context, subject string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.challenges[subject]
+	if !ok {
+		return 0, ErrNoCode
+	}
+	c.Attempts++
+	s.challenges[subject] = c
+	return c.Attempts, nil
+}
+
+// CompareAndSwap stores c for subject if the stored challenge equals old
+func (s *MemoryStore) CompareAndSwap(ctx context.Context, subject string, old *Challenge, c Challenge) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.challenges[subject]
+	if ok != (old != nil) || ok && !current.Equal(*old) {
+		return false, nil
+	}
+	s.challenges[subject] = c
+	return true, nil
+}
+
+// Issuer sends random one-time codes and verifies them. Attempt limits and
+// resend cooldowns are enforced here rather than in the browser. Resending
+// keeps the attempt count of an unexpired code, so a resend cannot be used to
+// reset the limit.
+type Issuer struct {
+	Sender      Sender           // Delivers codes (required)
+	Store       Store            // Challenge storage (default: a MemoryStore)
+	Key         []byte           // HMAC key codes are hashed with (default: random per process)
+	Digits      int              // Code length (default: 6)
+	TTL         time.Duration    // How long a code is valid (default: 10m)
+	Cooldown    time.Duration    // Minimum time between sends (default: 30s)
+	MaxAttempts int              // Failed attempts before the code is locked (default: 5)
+	Now         func() time.Time // Clock (default: time.Now)
+
+	once sync.Once
+}
+
+func (i *Issuer) init() {
+	i.once.Do(func() {
+		if i.Store == nil {
+			i.Store = NewMemoryStore()
+		}
+		if i.Key == nil {
+			i.Key = make([]byte, 32)
+			if _, err := rand.Read(i.Key); err != nil {
+				panic("otp: unable to generate issuer key: " + err.Error())
+			}
+		}
+		if i.Digits == 0 {
+			i.Digits = 6
+		}
+		if i.TTL == 0 {
+			i.TTL = 10 * time.Minute
+		}
+		if i.Cooldown == 0 {
+			i.Cooldown = 30 * time.Second
+		}
+		if i.MaxAttempts == 0 {
+			i.MaxAttempts = 5
+		}
+		if i.Now == nil {
+			i.Now = time.Now
+		}
+	})
+}
+
+// Issue sends a new code for subject to the destination to. It returns a
+// *CooldownError if the previous code was sent less than Cooldown ago. If
+// the code can't be sent, the previous code is restored with the attempts
+// made so far.
+func (i *Issuer) Issue(ctx context.Context, subject, to string) error {
+	i.init()
+	code, err := randomCode(i.Digits)
+	if err != nil {
+		return err
+	}
+
+	// The challenge is only replaced if it didn't change since it was read,
+	// so parallel sends can't both pass the cooldown or drop attempts
+	var prev *Challenge
+	var c Challenge
+	for {
+		now := i.Now()
+		p, ok, err := i.Store.Get(ctx, subject)
+		if err != nil {
+			return err
+		}
+		prev = nil
+		if ok {
+			if wait := p.SentAt.Add(i.Cooldown).Sub(now); wait > 0 {
+				return &CooldownError{RetryAfter: wait}
+			}
+			prev = &p
+		}
+		c = Challenge{
+			Hash:    i.hash(subject, code),
+			SentAt:  now,
+			Expires: now.Add(i.TTL),
+		}
+		if ok && now.Before(p.Expires) {
+			c.Attempts = p.Attempts
+		}
+		swapped, err := i.Store.CompareAndSwap(ctx, subject, prev, c)
+		if err != nil {
+			return err
+		}
+		if swapped {
+			break
+		}
+	}
+
+	if err := i.Sender.Send(ctx, to, code); err != nil {
+		i.restore(ctx, subject, prev, c)
+		return fmt.Errorf("otp: sending code: %w", err)
+	}
+	return nil
+}
+
+// restore replaces c, which couldn't be sent, with prev. Attempts made
+// against c are kept. Without a previous code c stays stored, without its
+// cooldown.
+func (i *Issuer) restore(ctx context.Context, subject string, prev *Challenge, c Challenge) {
+	for {
+		current, ok, err := i.Store.Get(ctx, subject)
+		if err != nil || !ok || !bytes.Equal(current.Hash, c.Hash) {
+			return
+		}
+		next := current
+		next.SentAt = time.Time{}
+		if prev != nil {
+			next = *prev
+			next.Attempts = max(prev.Attempts, current.Attempts)
+		}
+		if swapped, err := i.Store.CompareAndSwap(ctx, subject, &current, next); err != nil || swapped {
+			return
+		}
+	}
+}
+
+// Verify checks code against the last code issued for subject. A code can
+// only be used once. Each call counts as an attempt before the code is
+// compared, so parallel guesses can't exceed MaxAttempts.
+func (i *Issuer) Verify(ctx context.Context, subject, code string) error {
+	i.init()
+
+	c, ok, err := i.Store.Get(ctx, subject)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNoCode
+	}
+	if !i.Now().Before(c.Expires) {
+		_ = i.Store.Delete(ctx, subject)
+		return ErrExpired
+	}
+	if c.Attempts >= i.MaxAttempts {
+		return ErrTooManyAttempts
+	}
+	attempts, err := i.Store.Attempt(ctx, subject)
+	if err != nil {
+		return err
+	}
+	if attempts > i.MaxAttempts {
+		return ErrTooManyAttempts
+	}
+	if !hmac.Equal(c.Hash, i.hash(subject, code)) {
+		if attempts == i.MaxAttempts {
+			return ErrTooManyAttempts
+		}
+		return ErrInvalidCode
+	}
+	return i.Store.Delete(ctx, subject)
+}
+
+// RetryAfter returns how long until a new code can be sent to subject
+func (i *Issuer) RetryAfter(ctx context.Context, subject string) (time.Duration, error) {
+	i.init()
+	c, ok, err := i.Store.Get(ctx, subject)
+	if err != nil || !ok {
+		return 0, err
+	}
+	return max(c.SentAt.Add(i.Cooldown).Sub(i.Now()), 0), nil
+}
+
+func (i *Issuer) hash(subject, code string) []byte {
+	mac := hmac.New(sha256.New, i.Key)
+	mac.Write([]byte(subject))
+	mac.Write([]byte{0})
+	mac.Write([]byte(code))
+	return mac.Sum(nil)
+}
+
+func randomCode(digits int) (string, error) {
+	limit := big.NewInt(10)
+	limit.Exp(limit, big.NewInt(int64(digits)), nil)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", fmt.Errorf("otp: generating code: %w", err)
+	}
+	return fmt.Sprintf("%0*d", digits, n), nil
+}
+
+// Limiter counts failed attempts per subject, for example TOTP codes checked
+// with Key.Validate. A subject is locked once Max failures happen within
+// Window of the first one.
+type Limiter struct {
+	Max    int              // Failures before locking (default: 5)
+	Window time.Duration    // Period failures are counted over (default: 15m)
+	Now    func() time.Time // Clock (default: time.Now)
+
+	mu       sync.Mutex
+	failures map[string]failures
+}
+
+type failures struct {
+	count int
+	since time.Time
+}
+
+func (l *Limiter) now() time.Time {
+	if l.Now != nil {
+		return l.Now()
+	}
+	return time.Now()
+}
+
+func (l *Limiter) current(subject string) failures {
+	window := l.Window
+	if window == 0 {
+		window = 15 * time.Minute
+	}
+	f := l.failures[subject]
+	if !f.since.IsZero() && l.now().Sub(f.since) >= window {
+		delete(l.failures, subject)
+		return failures{}
+	}
+	return f
+}
+
+func (l *Limiter) max() int {
+	if l.Max == 0 {
+		return 5
+	}
+	return l.Max
+}
+
+// Allow returns ErrTooManyAttempts if subject is locked
+func (l *Limiter) Allow(subject string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.current(subject).count >= l.max() {
+		return ErrTooManyAttempts
+	}
+	return nil
+}
+
+// Fail records a failed attempt for subject
+func (l *Limiter) Fail(subject string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f := l.current(subject)
+	if f.count == 0 {
+		f.since = l.now()
+	}
+	f.count++
+	if l.failures == nil {
+		l.failures = make(map[string]failures)
+	}
+	l.failures[subject] = f
+}
+
+// Reset clears the failures of subject, typically after a successful attempt
+func (l *Limiter) Reset(subject string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, subject)
+}
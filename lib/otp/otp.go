@@ -0,0 +1,232 @@
+// Package otp issues and verifies one-time passwords for the inputotp
+// component.
+//
+// HOTP (RFC 4226) and TOTP (RFC 6238) codes are derived from a shared secret
+// the user enrolls in an authenticator app; Key.URI and QRCode render that
+// secret for enrollment. Issuer sends short-lived random codes by email or SMS
+// through a pluggable Sender, and enforces attempt limits and resend cooldowns
+// on the server:
+//
+//	key := otp.Key{Issuer: "Acme", Account: "ada@example.com", Secret: secret}
+//	qr := otp.QRCode(key.URI(), otp.QRProps{Class: "size-48"})
+//	...
+//	if counter, ok := key.Validate(code, time.Now()); ok && counter > user.LastCounter {
+//		user.LastCounter = counter // reject replays of the same code
+//	}
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Algorithm is the HMAC hash codes are derived with
+type Algorithm string
+
+// Algorithms supported by authenticator apps
+const (
+	SHA1   Algorithm = "SHA1"
+	SHA256 Algorithm = "SHA256"
+	SHA512 Algorithm = "SHA512"
+)
+
+func (a Algorithm) hash() func() hash.Hash {
+	switch a {
+	case SHA256:
+		return sha256.New
+	case SHA512:
+		return sha512.New
+	default:
+		return sha1.New
+	}
+}
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random 160-bit secret, the length RFC 4226
+// recommends
+func GenerateSecret() ([]byte, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("otp: generating secret: %w", err)
+	}
+	return secret, nil
+}
+
+// EncodeSecret returns the unpadded base32 form of secret used in otpauth URIs
+// and for manual entry
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// DecodeSecret parses a base32 secret, ignoring case, spaces and padding
+func DecodeSecret(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "=", "").Replace(s))
+	secret, err := encoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("otp: decoding secret: %w", err)
+	}
+	return secret, nil
+}
+
+// HOTP returns the RFC 4226 code for counter
+func HOTP(secret []byte, counter uint64, digits int, alg Algorithm) string {
+	if digits == 0 {
+		digits = 6
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(alg.hash(), secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// Key describes a secret enrolled in an authenticator app
+type Key struct {
+	Secret    []byte        // Shared secret
+	Issuer    string        // Service name shown in the app
+	Account   string        // Account name shown in the app, e.g. an email address
+	Digits    int           // Code length (default: 6)
+	Algorithm Algorithm     // HMAC hash (default: SHA1)
+	Period    time.Duration // TOTP time step, rounded to whole seconds (default: 30s, minimum: 1s)
+	Skew      int           // Time steps accepted either side of now (default: 1, -1 for none)
+	Counter   uint64        // Initial HOTP counter; only used by HOTPURI
+}
+
+func (k Key) digits() int {
+	if k.Digits == 0 {
+		return 6
+	}
+	return k.Digits
+}
+
+// period returns the time step in whole seconds, the only periods otpauth
+// URIs can express
+func (k Key) period() time.Duration {
+	if k.Period <= 0 {
+		return 30 * time.Second
+	}
+	return max(k.Period.Round(time.Second), time.Second)
+}
+
+func (k Key) algorithm() Algorithm {
+	if k.Algorithm == "" {
+		return SHA1
+	}
+	return k.Algorithm
+}
+
+// Step returns the TOTP counter for t
+func (k Key) Step(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(k.period()/time.Second))
+}
+
+// TOTP returns the RFC 6238 code for t
+func (k Key) TOTP(t time.Time) string {
+	return HOTP(k.Secret, k.Step(t), k.digits(), k.algorithm())
+}
+
+// Validate checks a TOTP code against the steps within Skew of t. It returns
+// the matched counter; callers should store it and reject codes whose counter
+// is not greater than the last one accepted, so a code cannot be replayed.
+func (k Key) Validate(code string, t time.Time) (uint64, bool) {
+	skew := k.Skew
+	if skew == 0 {
+		skew = 1
+	}
+	if skew < 0 {
+		skew = 0
+	}
+	step := k.Step(t)
+	for i := -skew; i <= skew; i++ {
+		counter := step + uint64(i)
+		if i < 0 && uint64(-i) > step {
+			continue
+		}
+		if equal(code, HOTP(k.Secret, counter, k.digits(), k.algorithm())) {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// ValidateHOTP checks an HOTP code against counter and the following lookahead
+// counters. It returns the counter to store for the next check, one past the
+// matched counter.
+func (k Key) ValidateHOTP(code string, counter uint64, lookahead int) (uint64, bool) {
+	for i := 0; i <= lookahead; i++ {
+		if equal(code, HOTP(k.Secret, counter+uint64(i), k.digits(), k.algorithm())) {
+			return counter + uint64(i) + 1, true
+		}
+	}
+	return counter, false
+}
+
+// URI returns the otpauth:// URI that enrolls the key as TOTP
+func (k Key) URI() string {
+	params := k.params()
+	if period := k.period(); period != 30*time.Second {
+		params.Set("period", strconv.Itoa(int(period/time.Second)))
+	}
+	return k.uri("totp", params)
+}
+
+// HOTPURI returns the otpauth:// URI that enrolls the key as HOTP, starting
+// at Counter
+func (k Key) HOTPURI() string {
+	params := k.params()
+	params.Set("counter", strconv.FormatUint(k.Counter, 10))
+	return k.uri("hotp", params)
+}
+
+func (k Key) params() url.Values {
+	params := url.Values{}
+	params.Set("secret", EncodeSecret(k.Secret))
+	if k.Issuer != "" {
+		params.Set("issuer", k.Issuer)
+	}
+	params.Set("algorithm", string(k.algorithm()))
+	params.Set("digits", strconv.Itoa(k.digits()))
+	return params
+}
+
+func (k Key) uri(kind string, params url.Values) string {
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     kind,
+		Path:     "/" + label,
+		RawQuery: strings.ReplaceAll(params.Encode(), "+", "%20"),
+	}
+	return u.String()
+}
+
+// equal compares codes in constant time
+func equal(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
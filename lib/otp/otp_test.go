@@ -0,0 +1,399 @@
+package otp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+)
+
+func TestHOTP(t *testing.T) {
+	// RFC 4226 appendix D
+	secret := []byte("12345678901234567890")
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for counter, code := range want {
+		if got := HOTP(secret, uint64(counter), 6, SHA1); got != code {
+			t.Errorf("HOTP(%d) = %s, want %s", counter, got, code)
+		}
+	}
+}
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238 appendix B
+	secrets := map[Algorithm][]byte{
+		SHA1:   []byte("12345678901234567890"),
+		SHA256: []byte("12345678901234567890123456789012"),
+		SHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+	tests := []struct {
+		unix int64
+		alg  Algorithm
+		want string
+	}{
+		{59, SHA1, "94287082"},
+		{59, SHA256, "46119246"},
+		{59, SHA512, "90693936"},
+		{1111111109, SHA1, "07081804"},
+		{1111111109, SHA256, "68084774"},
+		{1111111109, SHA512, "25091201"},
+		{1234567890, SHA1, "89005924"},
+		{2000000000, SHA256, "90698825"},
+		{20000000000, SHA512, "47863826"},
+	}
+
+	for _, tt := range tests {
+		key := Key{Secret: secrets[tt.alg], Digits: 8, Algorithm: tt.alg}
+		if got := key.TOTP(time.Unix(tt.unix, 0)); got != tt.want {
+			t.Errorf("TOTP(%d, %s) = %s, want %s", tt.unix, tt.alg, got, tt.want)
+		}
+	}
+}
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		period time.Duration
+		at     time.Time
+		want   uint64
+	}{
+		{0, time.Unix(59, 0), 1},
+		{time.Minute, time.Unix(119, 0), 1},
+		// Periods are rounded to whole seconds, at least one
+		{500 * time.Millisecond, time.Unix(1, 600_000_000), 1},
+		{1500 * time.Millisecond, time.Unix(3, 0), 1},
+		{time.Millisecond, time.Unix(5, 0), 5},
+	}
+	for _, tt := range tests {
+		key := Key{Period: tt.period}
+		if got := key.Step(tt.at); got != tt.want {
+			t.Errorf("Step(%v) with period %s = %d, want %d", tt.at, tt.period, got, tt.want)
+		}
+	}
+
+	for period, want := range map[time.Duration]string{
+		500 * time.Millisecond:  "period=1",
+		1500 * time.Millisecond: "period=2",
+		time.Minute:             "period=60",
+	} {
+		if uri := (Key{Period: period}).URI(); !strings.Contains(uri, want) {
+			t.Errorf("URI() with period %s = %q, want %s", period, uri, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	key := Key{Secret: []byte("12345678901234567890")}
+	now := time.Unix(1111111109, 0)
+
+	tests := []struct {
+		name string
+		skew int
+		at   time.Time
+		ok   bool
+	}{
+		{name: "current step", at: now, ok: true},
+		{name: "previous step", at: now.Add(-30 * time.Second), ok: true},
+		{name: "next step", at: now.Add(30 * time.Second), ok: true},
+		{name: "outside window", at: now.Add(-60 * time.Second)},
+		{name: "wider window", skew: 2, at: now.Add(-60 * time.Second), ok: true},
+		{name: "no skew", skew: -1, at: now.Add(-30 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := key
+			key.Skew = tt.skew
+			counter, ok := key.Validate(key.TOTP(tt.at), now)
+			if ok != tt.ok {
+				t.Fatalf("Validate() ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && counter != key.Step(tt.at) {
+				t.Errorf("Validate() counter = %d, want %d", counter, key.Step(tt.at))
+			}
+		})
+	}
+}
+
+func TestValidateHOTP(t *testing.T) {
+	key := Key{Secret: []byte("12345678901234567890")}
+
+	next, ok := key.ValidateHOTP("969429", 1, 3)
+	if !ok || next != 4 {
+		t.Errorf("ValidateHOTP() = %d, %v, want 4, true", next, ok)
+	}
+	if _, ok := key.ValidateHOTP("969429", 4, 3); ok {
+		t.Error("ValidateHOTP() accepted a code below the counter")
+	}
+}
+
+func TestSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	if len(secret) != 20 {
+		t.Errorf("GenerateSecret() length = %d, want 20", len(secret))
+	}
+
+	encoded := EncodeSecret([]byte("12345678901234567890"))
+	if encoded != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Errorf("EncodeSecret() = %s", encoded)
+	}
+	decoded, err := DecodeSecret("gezd gnbv gy3t qojq gezd gnbv gy3t qojq")
+	if err != nil || string(decoded) != "12345678901234567890" {
+		t.Errorf("DecodeSecret() = %q, %v", decoded, err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	key := Key{Secret: []byte("12345678901234567890"), Issuer: "Acme Co", Account: "ada@example.com"}
+
+	want := "otpauth://totp/Acme%20Co:ada@example.com?algorithm=SHA1&digits=6&issuer=Acme%20Co&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	if got := key.URI(); got != want {
+		t.Errorf("URI() = %s, want %s", got, want)
+	}
+
+	key.Period = time.Minute
+	key.Counter = 7
+	if got := key.URI(); !strings.Contains(got, "period=60") {
+		t.Errorf("URI() = %s, want period=60", got)
+	}
+	if got := key.HOTPURI(); !strings.HasPrefix(got, "otpauth://hotp/") || !strings.Contains(got, "counter=7") {
+		t.Errorf("HOTPURI() = %s", got)
+	}
+}
+
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func TestIssuer(t *testing.T) {
+	ctx := context.Background()
+	c := &clock{now: time.Unix(1700000000, 0)}
+	var sent []string
+	issuer := &Issuer{
+		Sender: SenderFunc(func(ctx context.Context, to, code string) error {
+			sent = append(sent, code)
+			return nil
+		}),
+		MaxAttempts: 3,
+		Now:         c.Now,
+	}
+
+	if err := issuer.Verify(ctx, "s", "000000"); !errors.Is(err, ErrNoCode) {
+		t.Errorf("Verify() before Issue error = %v, want %v", err, ErrNoCode)
+	}
+	if err := issuer.Issue(ctx, "s", "ada@example.com"); err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if len(sent) != 1 || len(sent[0]) != 6 {
+		t.Fatalf("sent %v, want one 6-digit code", sent)
+	}
+
+	// Cooldown is enforced on the server
+	c.now = c.now.Add(10 * time.Second)
+	var cooldown *CooldownError
+	if err := issuer.Issue(ctx, "s", "ada@example.com"); !errors.As(err, &cooldown) || cooldown.RetryAfter != 20*time.Second {
+		t.Errorf("Issue() during cooldown error = %v, want 20s cooldown", err)
+	}
+	if wait, _ := issuer.RetryAfter(ctx, "s"); wait != 20*time.Second {
+		t.Errorf("RetryAfter() = %s, want 20s", wait)
+	}
+
+	if err := issuer.Verify(ctx, "s", "wrong"); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("Verify() wrong code error = %v, want %v", err, ErrInvalidCode)
+	}
+	if err := issuer.Verify(ctx, "other", sent[0]); !errors.Is(err, ErrNoCode) {
+		t.Errorf("Verify() other subject error = %v, want %v", err, ErrNoCode)
+	}
+	if err := issuer.Verify(ctx, "s", sent[0]); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+	if err := issuer.Verify(ctx, "s", sent[0]); !errors.Is(err, ErrNoCode) {
+		t.Errorf("Verify() reused code error = %v, want %v", err, ErrNoCode)
+	}
+
+	// Attempts survive a resend and lock the code
+	c.now = c.now.Add(time.Minute)
+	_ = issuer.Issue(ctx, "s", "ada@example.com")
+	_ = issuer.Verify(ctx, "s", "wrong")
+	_ = issuer.Verify(ctx, "s", "wrong")
+	c.now = c.now.Add(time.Minute)
+	_ = issuer.Issue(ctx, "s", "ada@example.com")
+	if err := issuer.Verify(ctx, "s", "wrong"); !errors.Is(err, ErrTooManyAttempts) {
+		t.Errorf("Verify() third failure error = %v, want %v", err, ErrTooManyAttempts)
+	}
+	if err := issuer.Verify(ctx, "s", sent[len(sent)-1]); !errors.Is(err, ErrTooManyAttempts) {
+		t.Errorf("Verify() after lock error = %v, want %v", err, ErrTooManyAttempts)
+	}
+
+	// Codes expire
+	c.now = c.now.Add(time.Hour)
+	_ = issuer.Issue(ctx, "s", "ada@example.com")
+	c.now = c.now.Add(11 * time.Minute)
+	if err := issuer.Verify(ctx, "s", sent[len(sent)-1]); !errors.Is(err, ErrExpired) {
+		t.Errorf("Verify() expired code error = %v, want %v", err, ErrExpired)
+	}
+}
+
+func TestIssuerParallelAttempts(t *testing.T) {
+	ctx := context.Background()
+	issuer := &Issuer{
+		Sender:      SenderFunc(func(ctx context.Context, to, code string) error { return nil }),
+		MaxAttempts: 3,
+	}
+	if err := issuer.Issue(ctx, "s", "ada@example.com"); err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		invalid int
+	)
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if errors.Is(issuer.Verify(ctx, "s", "wrong"), ErrInvalidCode) {
+				mu.Lock()
+				invalid++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The last allowed attempt locks the code instead
+	if invalid != issuer.MaxAttempts-1 {
+		t.Errorf("%d guesses were checked, want %d", invalid+1, issuer.MaxAttempts)
+	}
+}
+
+func TestIssuerSendError(t *testing.T) {
+	ctx := context.Background()
+	issuer := &Issuer{Sender: SenderFunc(func(ctx context.Context, to, code string) error {
+		return errors.New("smtp down")
+	})}
+
+	if err := issuer.Issue(ctx, "s", "ada@example.com"); err == nil || !strings.Contains(err.Error(), "smtp down") {
+		t.Errorf("Issue() error = %v, want send error", err)
+	}
+	if wait, _ := issuer.RetryAfter(ctx, "s"); wait != 0 {
+		t.Errorf("RetryAfter() after failed send = %s, want 0", wait)
+	}
+}
+
+func TestIssuerSendErrorRestoresCode(t *testing.T) {
+	ctx := context.Background()
+	c := &clock{now: time.Unix(1700000000, 0)}
+	var sent []string
+	fail := false
+	issuer := &Issuer{
+		Sender: SenderFunc(func(ctx context.Context, to, code string) error {
+			if fail {
+				return errors.New("smtp down")
+			}
+			sent = append(sent, code)
+			return nil
+		}),
+		MaxAttempts: 3,
+		Now:         c.Now,
+	}
+	if err := issuer.Issue(ctx, "s", "ada@example.com"); err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	_ = issuer.Verify(ctx, "s", "wrong")
+	_ = issuer.Verify(ctx, "s", "wrong")
+
+	// A failed resend can't reset the attempts, and the sent code still works
+	c.now = c.now.Add(time.Minute)
+	fail = true
+	if err := issuer.Issue(ctx, "s", "ada@example.com"); err == nil {
+		t.Fatal("Issue() error = nil, want send error")
+	}
+	stored, _, _ := issuer.Store.Get(ctx, "s")
+	if stored.Attempts != 2 || !bytes.Equal(stored.Hash, issuer.hash("s", sent[0])) {
+		t.Errorf("stored challenge after failed send = %+v, want the previous code with 2 attempts", stored)
+	}
+	if err := issuer.Verify(ctx, "s", sent[0]); err != nil {
+		t.Errorf("Verify() previous code error = %v", err)
+	}
+}
+
+func TestIssuerParallelIssue(t *testing.T) {
+	ctx := context.Background()
+	var sends atomic.Int32
+	issuer := &Issuer{Sender: SenderFunc(func(ctx context.Context, to, code string) error {
+		sends.Add(1)
+		return nil
+	})}
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = issuer.Issue(ctx, "s", "ada@example.com")
+		}()
+	}
+	wg.Wait()
+
+	if n := sends.Load(); n != 1 {
+		t.Errorf("%d codes were sent, want 1", n)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	c := &clock{now: time.Unix(1700000000, 0)}
+	l := &Limiter{Max: 2, Window: time.Minute, Now: c.Now}
+
+	l.Fail("s")
+	if err := l.Allow("s"); err != nil {
+		t.Errorf("Allow() after one failure = %v", err)
+	}
+	l.Fail("s")
+	if err := l.Allow("s"); !errors.Is(err, ErrTooManyAttempts) {
+		t.Errorf("Allow() after two failures = %v, want %v", err, ErrTooManyAttempts)
+	}
+	if err := l.Allow("other"); err != nil {
+		t.Errorf("Allow() other subject = %v", err)
+	}
+
+	c.now = c.now.Add(time.Minute)
+	if err := l.Allow("s"); err != nil {
+		t.Errorf("Allow() after window = %v", err)
+	}
+
+	l.Fail("s")
+	l.Reset("s")
+	l.Fail("s")
+	if err := l.Allow("s"); err != nil {
+		t.Errorf("Allow() after reset = %v", err)
+	}
+}
+
+func TestQRCode(t *testing.T) {
+	key := Key{Secret: []byte("12345678901234567890"), Issuer: "Acme", Account: "ada@example.com"}
+	node := QRCode(key.URI(), QRProps{Class: "size-48"})
+
+	var b strings.Builder
+	_ = node.Render(&b)
+	got := b.String()
+	for _, want := range []string{`role="img"`, `aria-label="QR code"`, `class="size-48"`, `<path d="M4 4h7v1h-7z`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("QRCode() = %s, want it to contain %q", got, want)
+		}
+	}
+	a11ytest.Assert(t, node, a11y.Options{})
+
+	if QRCode(strings.Repeat("x", 5000), QRProps{}) != nil {
+		t.Error("QRCode() of oversized text should render nothing")
+	}
+}
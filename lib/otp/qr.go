@@ -0,0 +1,61 @@
+package otp
+
+import (
+	"fmt"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+	"rsc.io/qr"
+)
+
+// QRProps defines properties for QRCode
+type QRProps struct {
+	Label string // Accessible name (default: "QR code")
+	Class string // Additional custom classes; size the code with these
+}
+
+// quietZone is the blank border scanners need around a code, in modules
+const quietZone = 4
+
+// QRCode renders text, typically a Key.URI, as an SVG QR code. Modules are
+// drawn black on white whatever the theme, since scanners need the contrast.
+// Text too long to encode renders nothing.
+func QRCode(text string, props QRProps) g.Node {
+	code, err := qr.Encode(text, qr.M)
+	if err != nil {
+		return nil
+	}
+	if props.Label == "" {
+		props.Label = "QR code"
+	}
+
+	// One subpath per horizontal run of dark modules
+	var d strings.Builder
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if !code.Black(x, y) {
+				continue
+			}
+			run := 1
+			for code.Black(x+run, y) {
+				run++
+			}
+			fmt.Fprintf(&d, "M%d %dh%dv1h-%dz", x+quietZone, y+quietZone, run, run)
+			x += run
+		}
+	}
+
+	size := code.Size + 2*quietZone
+	viewBox := fmt.Sprintf("0 0 %d %d", size, size)
+	return g.El("svg",
+		g.Attr("xmlns", "http://www.w3.org/2000/svg"),
+		g.Attr("viewBox", viewBox),
+		g.Attr("role", "img"),
+		g.Attr("aria-label", props.Label),
+		g.Attr("shape-rendering", "crispEdges"),
+		g.If(props.Class != "", html.Class(props.Class)),
+		g.El("rect", g.Attr("width", fmt.Sprint(size)), g.Attr("height", fmt.Sprint(size)), g.Attr("fill", "#fff")),
+		g.El("path", g.Attr("d", d.String()), g.Attr("fill", "#000")),
+	)
+}
@@ -0,0 +1,250 @@
+package lib
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	g "maragu.dev/gomponents"
+)
+
+// Static renders node once and returns a node that writes the stored HTML.
+// Use it for fragments repeated many times with the same content, such as
+// icons and fixed attributes, so that rendering them doesn't allocate. node
+// keeps its type: a static attribute still renders in its element's start
+// tag, and a group of attributes becomes a single static attribute. A node
+// that fails to render is returned unchanged.
+func Static(node g.Node) g.Node {
+	var b strings.Builder
+	typ := nodeType(node)
+	if group, ok := node.(g.Group); ok && isAttrs(group) {
+		// Groups render only their elements, so write the attributes here
+		sw := stickyWriter{w: &b}
+		sw.nodes(group, g.AttributeType)
+		if sw.err != nil {
+			return node
+		}
+		return &static{html: b.String(), typ: g.AttributeType}
+	}
+	if err := node.Render(&b); err != nil {
+		return node
+	}
+	return &static{html: b.String(), typ: typ}
+}
+
+// isAttrs reports whether nodes holds attributes only
+func isAttrs(nodes []g.Node) bool {
+	found := false
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case nil:
+		case g.Group:
+			if !isAttrs(n) {
+				return false
+			}
+			found = true
+		default:
+			if nodeType(n) != g.AttributeType {
+				return false
+			}
+			found = true
+		}
+	}
+	return found
+}
+
+// static is prerendered HTML
+type static struct {
+	html string
+	typ  g.NodeType
+}
+
+func (s *static) Render(w io.Writer) error {
+	_, err := io.WriteString(w, s.html)
+	return err
+}
+
+func (s *static) Type() g.NodeType {
+	return s.typ
+}
+
+// Attr creates an attribute like g.Attr. Its name and value are written
+// piece by piece, so it renders without allocating unless the value needs
+// escaping.
+func Attr(name string, value ...string) g.Node {
+	switch len(value) {
+	case 0:
+		return &attr{name: name}
+	case 1:
+		return &attr{name: name, value: value[0], hasValue: true}
+	default:
+		panic("attribute must be just name or name and value pair")
+	}
+}
+
+// attr is an attribute written without concatenating its parts
+type attr struct {
+	name     string
+	value    string
+	hasValue bool
+}
+
+func (a *attr) Render(w io.Writer) error {
+	sw := stickyWriter{w: w}
+	sw.write(" ")
+	sw.write(a.name)
+	if a.hasValue {
+		sw.write(`="`)
+		sw.escape(a.value)
+		sw.write(`"`)
+	}
+	return sw.err
+}
+
+func (a *attr) Type() g.NodeType {
+	return g.AttributeType
+}
+
+// El creates an element like g.El whose start and end tags are written
+// without allocating, for components rendered many times per page such as
+// table cells and buttons. The output is the same as g.El's.
+func El(name string, children ...g.Node) g.Node {
+	return &element{name: name, children: children}
+}
+
+// Tag creates an element like El from a component's own nodes, the
+// children passed to the component and the caller's extra attributes, merged
+// as by MergeAttrs. Nil nodes in own are dropped, so components can leave
+// slots for optional attributes. Up to eight nodes are kept in the element
+// itself, so a component without extra attributes is built in a single
+// allocation.
+func Tag(name string, own, children, extra []g.Node) g.Node {
+	if len(extra) > 0 {
+		return El(name, MergeAttrs(slices.Concat(own, children), extra)...)
+	}
+	t := &tag{element: element{name: name}}
+	n := len(children)
+	for _, node := range own {
+		if node != nil {
+			n++
+		}
+	}
+	if n <= len(t.inline) {
+		t.children = t.inline[:0]
+	} else {
+		t.children = make([]g.Node, 0, n)
+	}
+	for _, node := range own {
+		if node != nil {
+			t.children = append(t.children, node)
+		}
+	}
+	t.children = append(t.children, children...)
+	return &t.element
+}
+
+// element is an element created by El or Tag
+type element struct {
+	name     string
+	children []g.Node
+}
+
+// tag is an element with room for its children, see Tag
+type tag struct {
+	element
+	inline [8]g.Node
+}
+
+func (e *element) Render(w io.Writer) error {
+	sw := stickyWriter{w: w}
+	sw.write("<")
+	sw.write(e.name)
+	sw.nodes(e.children, g.AttributeType)
+	sw.write(">")
+	if isVoid(e.name) {
+		return sw.err
+	}
+	sw.nodes(e.children, g.ElementType)
+	sw.write("</")
+	sw.write(e.name)
+	sw.write(">")
+	return sw.err
+}
+
+func (e *element) Type() g.NodeType {
+	return g.ElementType
+}
+
+// stickyWriter writes strings until the first error, which it keeps
+type stickyWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (sw *stickyWriter) write(s string) {
+	if sw.err == nil {
+		_, sw.err = io.WriteString(sw.w, s)
+	}
+}
+
+// nodes renders the nodes of type t, flattening groups, the way g.El
+// renders its children
+func (sw *stickyWriter) nodes(nodes []g.Node, t g.NodeType) {
+	for _, n := range nodes {
+		if sw.err != nil {
+			return
+		}
+		switch n := n.(type) {
+		case nil:
+		case g.Group:
+			sw.nodes(n, t)
+		default:
+			if nodeType(n) == t {
+				sw.err = n.Render(sw.w)
+			}
+		}
+	}
+}
+
+// nodeType returns the type of n; nodes that don't describe themselves are
+// elements
+func nodeType(n g.Node) g.NodeType {
+	if t, ok := n.(interface{ Type() g.NodeType }); ok {
+		return t.Type()
+	}
+	return g.ElementType
+}
+
+// escape writes s escaped like template.HTMLEscapeString, a run of
+// characters at a time so it doesn't allocate
+func (sw *stickyWriter) escape(s string) {
+	for sw.err == nil {
+		i := strings.IndexAny(s, "'\"&<>\000")
+		if i < 0 {
+			sw.write(s)
+			return
+		}
+		sw.write(s[:i])
+		sw.write(escapes[s[i]])
+		s = s[i+1:]
+	}
+}
+
+// escapes are the replacements template.HTMLEscapeString makes
+var escapes = [256]string{
+	0:    "\uFFFD",
+	'"':  "&#34;",
+	'\'': "&#39;",
+	'&':  "&amp;",
+	'<':  "&lt;",
+	'>':  "&gt;",
+}
+
+// isVoid reports whether name is a void element, which has no end tag
+func isVoid(name string) bool {
+	switch name {
+	case "area", "base", "br", "col", "command", "embed", "hr", "img", "input", "keygen", "link", "meta", "param", "source", "track", "wbr":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package lib
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+func render(t *testing.T, n g.Node) string {
+	t.Helper()
+	var b strings.Builder
+	if err := n.Render(&b); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestElMatchesGomponents(t *testing.T) {
+	children := func() []g.Node {
+		return []g.Node{
+			html.Class("a b"),
+			g.Text("x < y"),
+			g.Group{g.Attr("data-x", `"quoted" & 'single'`), html.Span(g.Text("child"))},
+			nil,
+			Attr("disabled"),
+			Static(html.ID("static")),
+		}
+	}
+	tests := []struct {
+		name string
+		tag  string
+	}{
+		{"element", "button"},
+		{"void element", "input"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := render(t, g.El(tt.tag, children()...))
+			if got := render(t, El(tt.tag, children()...)); got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestStatic(t *testing.T) {
+	tests := []struct {
+		name string
+		node g.Node
+		want string
+	}{
+		{"element", html.Span(html.Class("icon"), g.Text("★")), `<div><span class="icon">★</span></div>`},
+		{"attribute", html.Class("icon"), `<div class="icon"></div>`},
+		{"group of attributes", g.Group{g.Attr("width", "24"), g.Group{g.Attr("height", "24")}}, `<div width="24" height="24"></div>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := render(t, html.Div(Static(tt.node))); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTag(t *testing.T) {
+	own := []g.Node{html.Class("a"), Attr("data-slot", "cell")}
+	many := []g.Node{g.Text("1"), g.Text("2"), g.Text("3"), g.Text("4"), g.Text("5"), g.Text("6"), g.Text("7")}
+	tests := []struct {
+		name     string
+		children []g.Node
+		extra    []g.Node
+		want     string
+	}{
+		{"inline", []g.Node{g.Text("x")}, nil, `<td class="a" data-slot="cell">x</td>`},
+		{"more than fit inline", many, nil, `<td class="a" data-slot="cell">1234567</td>`},
+		{"extra attributes", []g.Node{g.Text("x")}, []g.Node{html.Class("b"), html.ID("c")}, `<td class="a b" data-slot="cell" id="c">x</td>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := render(t, Tag("td", own, tt.children, tt.extra)); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAttrEscaping(t *testing.T) {
+	value := "\000a \"b\" & 'c' <d>"
+	if got, want := render(t, html.Div(Attr("title", value))), render(t, html.Div(g.Attr("title", value))); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestRenderAllocations(t *testing.T) {
+	icon := Static(html.Span(html.Class("icon")))
+	class := Static(html.Class("p-2 [&>svg]:size-4"))
+	attr := Attr("data-id", "row-1")
+	escaped := Attr("class", "[&:has([role=checkbox])]:pr-0")
+	el := El("td", class, attr, escaped, icon)
+
+	if n := testing.AllocsPerRun(100, func() { _ = el.Render(io.Discard) }); n != 0 {
+		t.Errorf("expected rendering to not allocate, got %v allocs", n)
+	}
+
+	own, text := []g.Node{class, attr}, g.Text("x")
+	n := testing.AllocsPerRun(100, func() {
+		_ = Tag("td", own, []g.Node{text, icon}, nil)
+	})
+	if n != 1 {
+		t.Errorf("expected building a tag to allocate once, got %v allocs", n)
+	}
+}
@@ -0,0 +1,147 @@
+// Package router mounts component HTMX endpoints on any router.
+//
+// Component packages describe their endpoints as a Registrar, usually a
+// Handlers struct holding the props and dependencies the endpoints render
+// with. Register adds the routes to a Router; adapters exist for
+// http.ServeMux, using Go 1.22 method patterns, and for chi. WithPrefix mounts
+// a registrar under a base path and With wraps its routes in middleware such
+// as authentication or CSRF protection:
+//
+//	r := router.WithPrefix(router.Chi(mux), "/ui")
+//	router.Mount(router.With(r, csrf), dialog.Handlers{}, table.Handlers{HTMX: tableProps})
+//
+// Handler builds a standalone http.Handler from registrars, for mounting with
+// http.StripPrefix or a framework's own sub-router.
+//
+// Paths in a registrar's props are relative to the Router. When an endpoint
+// renders links back to its own routes it resolves them with Router.Path, so
+// they follow the prefix the routes were mounted under.
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Router registers handlers for a method and path pattern
+type Router interface {
+	// Handle registers h for requests with method to pattern. An empty
+	// method matches every method.
+	Handle(method, pattern string, h http.Handler)
+	// Path returns the URL path requests to pattern are served at
+	Path(pattern string) string
+}
+
+// Registrar adds a set of routes to a Router
+type Registrar interface {
+	Register(r Router)
+}
+
+// Mount registers every registrar on r
+func Mount(r Router, registrars ...Registrar) {
+	for _, registrar := range registrars {
+		registrar.Register(r)
+	}
+}
+
+// Handler returns an http.Handler serving the registrars' routes
+func Handler(registrars ...Registrar) http.Handler {
+	mux := http.NewServeMux()
+	Mount(ServeMux(mux), registrars...)
+	return mux
+}
+
+// ServeMux adapts an http.ServeMux
+func ServeMux(mux *http.ServeMux) Router {
+	return serveMux{mux: mux}
+}
+
+type serveMux struct {
+	mux *http.ServeMux
+}
+
+func (s serveMux) Handle(method, pattern string, h http.Handler) {
+	if method != "" {
+		pattern = method + " " + pattern
+	}
+	s.mux.Handle(pattern, h)
+}
+
+func (s serveMux) Path(pattern string) string {
+	return pattern
+}
+
+// Chi adapts a chi.Router. Patterns ending in "/" match every path below
+// them, as they do on an http.ServeMux.
+func Chi(r chi.Router) Router {
+	return chiRouter{r: r}
+}
+
+type chiRouter struct {
+	r chi.Router
+}
+
+func (c chiRouter) Handle(method, pattern string, h http.Handler) {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "*"
+	}
+	if method == "" {
+		c.r.Handle(pattern, h)
+		return
+	}
+	c.r.Method(method, pattern, h)
+}
+
+func (c chiRouter) Path(pattern string) string {
+	return pattern
+}
+
+// WithPrefix returns a Router that registers every pattern under prefix
+func WithPrefix(r Router, prefix string) Router {
+	return prefixed{r: r, prefix: strings.TrimSuffix(prefix, "/")}
+}
+
+type prefixed struct {
+	r      Router
+	prefix string
+}
+
+func (p prefixed) Handle(method, pattern string, h http.Handler) {
+	p.r.Handle(method, p.prefix+pattern, h)
+}
+
+func (p prefixed) Path(pattern string) string {
+	return p.r.Path(p.prefix + pattern)
+}
+
+// With returns a Router that wraps every handler in middlewares, the first
+// one outermost
+func With(r Router, middlewares ...func(http.Handler) http.Handler) Router {
+	return wrapped{r: r, middlewares: middlewares}
+}
+
+type wrapped struct {
+	r           Router
+	middlewares []func(http.Handler) http.Handler
+}
+
+func (w wrapped) Handle(method, pattern string, h http.Handler) {
+	for i := len(w.middlewares) - 1; i >= 0; i-- {
+		h = w.middlewares[i](h)
+	}
+	w.r.Handle(method, pattern, h)
+}
+
+func (w wrapped) Path(pattern string) string {
+	return w.r.Path(pattern)
+}
+
+// Func adapts a function to the Registrar interface
+type Func func(r Router)
+
+// Register calls f(r)
+func (f Func) Register(r Router) {
+	f(r)
+}
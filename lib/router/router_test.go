@@ -0,0 +1,122 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// echo serves the endpoints of a small component: a GET that renders a link
+// to its own POST route, and a POST that echoes a path value
+type echo struct{}
+
+func (echo) Register(r Router) {
+	r.Handle(http.MethodGet, "/echo", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(w, r.Path("/echo/item"))
+	}))
+	r.Handle(http.MethodPost, "/echo/{name}", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(w, "posted "+req.PathValue("name"))
+	}))
+	r.Handle("", "/echo/any/", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(w, req.Method+" "+req.URL.Path)
+	}))
+}
+
+func serve(h http.Handler, method, path string) (int, string) {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w.Code, strings.TrimSpace(w.Body.String())
+}
+
+func TestRouters(t *testing.T) {
+	tests := []struct {
+		name  string
+		mount func(r Registrar) http.Handler
+		base  string
+	}{
+		{
+			name:  "servemux",
+			mount: func(r Registrar) http.Handler { return Handler(r) },
+		},
+		{
+			name: "servemux with prefix",
+			mount: func(r Registrar) http.Handler {
+				mux := http.NewServeMux()
+				Mount(WithPrefix(ServeMux(mux), "/ui/"), r)
+				return mux
+			},
+			base: "/ui",
+		},
+		{
+			name: "chi",
+			mount: func(r Registrar) http.Handler {
+				mux := chi.NewRouter()
+				Mount(Chi(mux), r)
+				return mux
+			},
+		},
+		{
+			name: "chi with prefix",
+			mount: func(r Registrar) http.Handler {
+				mux := chi.NewRouter()
+				Mount(WithPrefix(Chi(mux), "/ui"), r)
+				return mux
+			},
+			base: "/ui",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := tt.mount(echo{})
+
+			if code, body := serve(h, http.MethodGet, tt.base+"/echo"); code != http.StatusOK || body != tt.base+"/echo/item" {
+				t.Errorf("GET /echo = %d %q, want 200 %q", code, body, tt.base+"/echo/item")
+			}
+			if code, body := serve(h, http.MethodPost, tt.base+"/echo/item"); code != http.StatusOK || body != "posted item" {
+				t.Errorf("POST /echo/item = %d %q, want 200 %q", code, body, "posted item")
+			}
+			if code, _ := serve(h, http.MethodGet, tt.base+"/echo/item"); code != http.StatusMethodNotAllowed {
+				t.Errorf("GET /echo/item = %d, want %d", code, http.StatusMethodNotAllowed)
+			}
+			if code, body := serve(h, http.MethodDelete, tt.base+"/echo/any/x/y"); code != http.StatusOK || body != "DELETE "+tt.base+"/echo/any/x/y" {
+				t.Errorf("DELETE /echo/any/x/y = %d %q", code, body)
+			}
+			if code, _ := serve(h, http.MethodGet, "/echo/missing/deep"); code != http.StatusNotFound {
+				t.Errorf("GET /echo/missing/deep = %d, want %d", code, http.StatusNotFound)
+			}
+		})
+	}
+}
+
+func TestWith(t *testing.T) {
+	var order []string
+	mw := func(name string) func(http.Handler) http.Handler {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	mux := http.NewServeMux()
+	r := With(WithPrefix(ServeMux(mux), "/ui"), mw("outer"), mw("inner"))
+	Mount(r, Func(func(r Router) {
+		r.Handle(http.MethodGet, "/x", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			order = append(order, "handler")
+		}))
+	}))
+
+	if got := r.Path("/x"); got != "/ui/x" {
+		t.Errorf("Path() = %q, want %q", got, "/ui/x")
+	}
+	serve(mux, http.MethodGet, "/ui/x")
+	if got := strings.Join(order, ","); got != "outer,inner,handler" {
+		t.Errorf("call order = %q, want %q", got, "outer,inner,handler")
+	}
+}
@@ -0,0 +1,375 @@
+// Package roving implements the WAI-ARIA keyboard model shared by menus,
+// menubars, listboxes, tab lists and toolbars: a roving tabindex moved with the
+// arrow keys, Home/End, typeahead, Enter/Space activation, and opening and
+// closing of submenus from the keyboard.
+//
+// Components mark the element that owns the items with Group, each item with
+// Item (or VirtualItem for listboxes driven from a text input), and render
+// Script inside the group. Only one item of a group is in the tab order at a
+// time; the server renders the initial tab stop and the script moves it.
+//
+// Items with aria-haspopup open the [data-roving] popup they control
+// (aria-controls, or the next sibling group) with ArrowRight in vertical menus,
+// ArrowDown in horizontal menubars, and ArrowDown/Enter/Space on standalone
+// menu buttons. ArrowLeft and Escape close a submenu and return focus to its
+// trigger.
+package roving
+
+import (
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+const (
+	OrientationVertical   = "vertical"
+	OrientationHorizontal = "horizontal"
+	OrientationBoth       = "both"
+)
+
+// Props configures a roving focus group
+type Props struct {
+	Orientation      string // "vertical" (default) | "horizontal" | "both"
+	DisableLoop      bool   // Stop at the first and last item instead of wrapping
+	DisableTypeahead bool   // Ignore printable keys
+	ActivateOnFocus  bool   // Click items as they receive focus (automatic tab activation)
+	Virtual          bool   // Keep focus in the input and track the active item with aria-activedescendant
+	AutoFocus        bool   // Focus the first item when the group is rendered, as for menus swapped in by HTMX
+}
+
+// Group returns the attributes for the element that owns the items
+func Group(props Props) g.Node {
+	orientation := props.Orientation
+	if orientation == "" {
+		orientation = OrientationVertical
+	}
+
+	return g.Group([]g.Node{
+		g.Attr("data-roving", ""),
+		g.Attr("data-roving-orientation", orientation),
+		g.If(props.DisableLoop, g.Attr("data-roving-loop", "false")),
+		g.If(props.DisableTypeahead, g.Attr("data-roving-typeahead", "false")),
+		g.If(props.ActivateOnFocus, g.Attr("data-roving-activate", "")),
+		g.If(props.Virtual, g.Attr("data-roving-virtual", "")),
+		g.If(props.AutoFocus, g.Attr("data-roving-autofocus", "")),
+	})
+}
+
+// Item returns the attributes for a focusable item. The active item is the
+// group's tab stop; the script keeps exactly one tab stop per group, falling
+// back to the first enabled item.
+func Item(active bool) g.Node {
+	tabIndex := "-1"
+	if active {
+		tabIndex = "0"
+	}
+
+	return g.Group([]g.Node{
+		html.TabIndex(tabIndex),
+		g.Attr("data-roving-item", ""),
+	})
+}
+
+// VirtualItem returns the attributes for an item of a Virtual group. Virtual
+// items are never focused; the active one carries data-highlighted and
+// aria-selected="true".
+func VirtualItem() g.Node {
+	return g.Attr("data-roving-item", "")
+}
+
+// Label overrides the text used to match an item during typeahead
+func Label(text string) g.Node {
+	return g.Attr("data-roving-label", text)
+}
+
+// Script initialises the group it is rendered in, once the group's items have
+// been parsed. The keyboard handling is defined once per page and shared by
+// every group.
+func Script() g.Node {
+	return html.Script(g.Raw(script))
+}
+
+const script = `
+(function() {
+	if (!window.shadcnRoving) {
+		const itemSelector = '[data-roving-item]';
+		const typeahead = new WeakMap();
+
+		function groupOf(el) {
+			return el.parentElement ? el.parentElement.closest('[data-roving]') : null;
+		}
+		function isVirtual(group) {
+			return 'rovingVirtual' in group.dataset;
+		}
+		function orientation(group) {
+			return group.dataset.rovingOrientation || 'vertical';
+		}
+		function disabled(el) {
+			return el.disabled || el.getAttribute('aria-disabled') === 'true' ||
+				(el.hasAttribute('data-disabled') && el.dataset.disabled !== 'false');
+		}
+		function ownItems(group) {
+			return Array.from(group.querySelectorAll(itemSelector)).filter(el => groupOf(el) === group);
+		}
+		function items(group) {
+			return ownItems(group).filter(el => !disabled(el) && el.getClientRects().length > 0);
+		}
+		function active(group) {
+			if (isVirtual(group)) return ownItems(group).find(el => 'highlighted' in el.dataset) || null;
+			const el = document.activeElement;
+			return el && el.matches(itemSelector) && groupOf(el) === group ? el : null;
+		}
+		function label(el) {
+			return (el.dataset.rovingLabel || el.textContent).trim().toLowerCase();
+		}
+		function isTextInput(el) {
+			return el.isContentEditable || (el.tagName === 'INPUT' && !['button', 'checkbox', 'radio'].includes(el.type)) || el.tagName === 'TEXTAREA';
+		}
+		function hasRequest(el) {
+			return el.hasAttribute('hx-get') || el.hasAttribute('hx-post');
+		}
+
+		function setTabStop(group, item) {
+			ownItems(group).forEach(el => el.setAttribute('tabindex', el === item ? '0' : '-1'));
+		}
+		function highlight(group, item) {
+			ownItems(group).forEach(el => {
+				if (el === item) return;
+				delete el.dataset.highlighted;
+				el.setAttribute('aria-selected', 'false');
+			});
+			const owner = group.contains(document.activeElement) ? document.activeElement : group.querySelector('[role="combobox"]');
+			if (!item) {
+				if (owner) owner.removeAttribute('aria-activedescendant');
+				return;
+			}
+			if (!item.id) item.id = 'roving-' + Math.random().toString(36).slice(2);
+			item.dataset.highlighted = '';
+			item.setAttribute('aria-selected', 'true');
+			if (owner) owner.setAttribute('aria-activedescendant', item.id);
+			item.scrollIntoView({ block: 'nearest' });
+		}
+		function focusItem(group, item) {
+			if (!item) return;
+			if (isVirtual(group)) {
+				highlight(group, item);
+				return;
+			}
+			setTabStop(group, item);
+			item.focus();
+			if ('rovingActivate' in group.dataset) item.click();
+		}
+
+		function popupOf(trigger) {
+			const id = trigger.getAttribute('aria-controls');
+			const controlled = id && document.getElementById(id);
+			if (controlled) return 'roving' in controlled.dataset ? controlled : controlled.querySelector('[data-roving]');
+			let el = trigger.nextElementSibling;
+			while (el && !('roving' in el.dataset)) el = el.nextElementSibling;
+			return el;
+		}
+		function triggerOf(popup) {
+			for (const el of [popup, popup.parentElement]) {
+				const controller = el && el.id && document.querySelector('[aria-controls="' + el.id + '"]');
+				if (controller) return controller;
+			}
+			let el = popup.previousElementSibling;
+			while (el && !el.hasAttribute('aria-haspopup')) el = el.previousElementSibling;
+			return el;
+		}
+		function setOpen(trigger, popup, open) {
+			trigger.setAttribute('aria-expanded', String(open));
+			trigger.dataset.state = open ? 'open' : 'closed';
+			popup.dataset.state = open ? 'open' : 'closed';
+			if (open && (popup.hidden || popup.style.display === 'none')) {
+				popup.hidden = false;
+				popup.style.display = '';
+				popup.dataset.rovingShown = '';
+			} else if (!open && 'rovingShown' in popup.dataset) {
+				popup.style.display = 'none';
+				delete popup.dataset.rovingShown;
+			}
+		}
+		function open(trigger, last) {
+			const popup = popupOf(trigger);
+			if (!popup || !popup.isConnected) {
+				if (!hasRequest(trigger)) return false;
+				trigger.click();
+				return true;
+			}
+			setOpen(trigger, popup, true);
+			const list = items(popup);
+			focusItem(popup, last ? list[list.length - 1] : list[0]);
+			return true;
+		}
+		function close(popup) {
+			const trigger = triggerOf(popup);
+			if (!trigger) return false;
+			if (hasRequest(trigger) && trigger.getAttribute('aria-expanded') === 'true') {
+				trigger.click();
+			} else {
+				setOpen(trigger, popup, false);
+			}
+			trigger.focus();
+			return true;
+		}
+		function openKeys(trigger) {
+			const group = groupOf(trigger);
+			if (!group || !trigger.matches(itemSelector)) return { ArrowDown: false, ArrowUp: true, Enter: false, ' ': false };
+			if (orientation(group) === 'horizontal') return { ArrowDown: false, ArrowUp: true };
+			return { ArrowRight: false, Enter: false, ' ': false };
+		}
+
+		function step(group, key) {
+			const o = orientation(group);
+			if (key === 'Home') return 'first';
+			if (key === 'End') return 'last';
+			if ((key === 'ArrowDown' && o !== 'horizontal') || (key === 'ArrowRight' && o !== 'vertical')) return 1;
+			if ((key === 'ArrowUp' && o !== 'horizontal') || (key === 'ArrowLeft' && o !== 'vertical')) return -1;
+			return 0;
+		}
+		function move(group, action) {
+			const list = items(group);
+			if (list.length === 0) return null;
+			const current = list.indexOf(active(group));
+			let index;
+			if (action === 'first') index = 0;
+			else if (action === 'last') index = list.length - 1;
+			else if (current === -1) index = action > 0 ? 0 : list.length - 1;
+			else if (group.dataset.rovingLoop === 'false') index = Math.min(list.length - 1, Math.max(0, current + action));
+			else index = (current + action + list.length) % list.length;
+			focusItem(group, list[index]);
+			return list[index];
+		}
+		function search(group, key) {
+			const state = typeahead.get(group) || { buffer: '', timer: 0 };
+			clearTimeout(state.timer);
+			state.buffer += key.toLowerCase();
+			state.timer = setTimeout(() => { state.buffer = ''; }, 500);
+			typeahead.set(group, state);
+
+			const list = items(group);
+			const repeated = state.buffer.split('').every(c => c === state.buffer[0]);
+			const query = repeated ? state.buffer[0] : state.buffer;
+			const start = Math.max(0, list.indexOf(active(group))) + (repeated ? 1 : 0);
+			const ordered = list.slice(start).concat(list.slice(0, start));
+			return ordered.find(el => label(el).startsWith(query));
+		}
+
+		document.addEventListener('keydown', (e) => {
+			if (e.defaultPrevented || e.altKey || e.ctrlKey || e.metaKey) return;
+			const target = e.target;
+
+			if (target.hasAttribute('aria-haspopup') && target.getAttribute('aria-haspopup') !== 'false') {
+				const keys = openKeys(target);
+				if (e.key in keys && open(target, keys[e.key])) {
+					e.preventDefault();
+					return;
+				}
+			}
+
+			const group = target.closest('[data-roving]');
+			if (!group) return;
+			const virtual = isVirtual(group);
+			const isMenu = group.getAttribute('role') === 'menu';
+
+			if (isMenu && (e.key === 'ArrowLeft' || e.key === 'ArrowRight' || e.key === 'Escape')) {
+				const trigger = triggerOf(group);
+				const parent = trigger && groupOf(trigger);
+				if (parent && orientation(parent) === 'horizontal' && e.key !== 'Escape') {
+					e.preventDefault();
+					close(group);
+					const next = move(parent, e.key === 'ArrowRight' ? 1 : -1);
+					if (next && next.hasAttribute('aria-haspopup')) open(next, false);
+					return;
+				}
+				if (trigger && (e.key === 'Escape' || (e.key === 'ArrowLeft' && parent))) {
+					e.preventDefault();
+					close(group);
+					return;
+				}
+			}
+
+			const action = step(group, e.key);
+			if (action) {
+				if (virtual && (e.key === 'Home' || e.key === 'End') && isTextInput(target)) return;
+				if (virtual && (e.key === 'ArrowLeft' || e.key === 'ArrowRight') && isTextInput(target)) return;
+				e.preventDefault();
+				move(group, action);
+				return;
+			}
+
+			if (e.key === 'Enter' || e.key === ' ') {
+				const item = active(group);
+				if (!item) return;
+				if (virtual) {
+					if (e.key === 'Enter') {
+						e.preventDefault();
+						item.click();
+					}
+					return;
+				}
+				if (item === target && !['BUTTON', 'A', 'INPUT', 'SUMMARY'].includes(item.tagName)) {
+					e.preventDefault();
+					item.click();
+				}
+				return;
+			}
+
+			if (e.key.length === 1 && !virtual && group.dataset.rovingTypeahead !== 'false' && !isTextInput(target)) {
+				const match = search(group, e.key);
+				if (match) {
+					e.preventDefault();
+					focusItem(group, match);
+				}
+			}
+		});
+
+		document.addEventListener('focusin', (e) => {
+			const item = e.target;
+			if (!item.matches || !item.matches(itemSelector)) return;
+			const group = groupOf(item);
+			if (group && !isVirtual(group)) setTabStop(group, item);
+		});
+
+		document.addEventListener('pointermove', (e) => {
+			const item = e.target.closest && e.target.closest(itemSelector);
+			const group = item && groupOf(item);
+			if (group && isVirtual(group) && !disabled(item) && !('highlighted' in item.dataset)) highlight(group, item);
+		});
+
+		document.addEventListener('htmx:afterSettle', (e) => {
+			const group = e.target.closest && e.target.closest('[data-roving-virtual]');
+			if (group) highlight(group, items(group)[0] || null);
+		});
+
+		document.addEventListener('input', (e) => {
+			const group = e.target.closest && e.target.closest('[data-roving-virtual]');
+			if (!group) return;
+			setTimeout(() => highlight(group, items(group)[0] || null));
+		});
+
+		function init(group) {
+			if (isVirtual(group)) {
+				highlight(group, active(group) || items(group)[0] || null);
+				return;
+			}
+			const own = ownItems(group).filter(el => !disabled(el));
+			const stop = own.find(el => el.getAttribute('tabindex') === '0') || own[0];
+			if (stop) setTabStop(group, stop);
+			if ('rovingAutofocus' in group.dataset) {
+				const list = items(group);
+				if (list.length > 0) focusItem(group, list[0]);
+			}
+		}
+
+		window.shadcnRoving = { init: init, open: open, close: close };
+	}
+	const group = document.currentScript && document.currentScript.parentElement;
+	if (!group || !('roving' in group.dataset)) return;
+	if (document.readyState === 'loading') {
+		document.addEventListener('DOMContentLoaded', () => window.shadcnRoving.init(group));
+	} else {
+		window.shadcnRoving.init(group);
+	}
+})();
+`
@@ -0,0 +1,83 @@
+package roving
+
+import (
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+func TestGroup(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    Props
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "defaults",
+			props:    Props{},
+			contains: []string{`data-roving=""`, `data-roving-orientation="vertical"`},
+			excludes: []string{"data-roving-loop", "data-roving-typeahead", "data-roving-activate", "data-roving-virtual", "data-roving-autofocus"},
+		},
+		{
+			name: "all options",
+			props: Props{
+				Orientation:      OrientationHorizontal,
+				DisableLoop:      true,
+				DisableTypeahead: true,
+				ActivateOnFocus:  true,
+				Virtual:          true,
+				AutoFocus:        true,
+			},
+			contains: []string{
+				`data-roving-orientation="horizontal"`,
+				`data-roving-loop="false"`,
+				`data-roving-typeahead="false"`,
+				`data-roving-activate=""`,
+				`data-roving-virtual=""`,
+				`data-roving-autofocus=""`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := a11ytest.Render(t, html.Div(Group(tt.props)))
+			a11ytest.Markup(t, result, tt.contains, tt.excludes)
+		})
+	}
+}
+
+func TestItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		node     g.Node
+		expected string
+	}{
+		{name: "inactive", node: Item(false), expected: `<div tabindex="-1" data-roving-item=""></div>`},
+		{name: "active", node: Item(true), expected: `<div tabindex="0" data-roving-item=""></div>`},
+		{name: "virtual", node: VirtualItem(), expected: `<div data-roving-item=""></div>`},
+		{name: "label", node: Label("Copy"), expected: `<div data-roving-label="Copy"></div>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := a11ytest.Render(t, html.Div(tt.node)); result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestScript(t *testing.T) {
+	result := a11ytest.Render(t, Script())
+	a11ytest.Markup(t, result, []string{
+		"<script>",
+		"window.shadcnRoving",
+		"aria-activedescendant",
+		"aria-haspopup",
+		"DOMContentLoaded",
+	}, nil)
+}
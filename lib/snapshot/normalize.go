@@ -0,0 +1,133 @@
+package snapshot
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// indent is the indentation of each level of nesting
+const indent = "  "
+
+// generated matches the timestamps components embed in generated IDs,
+// e.g. the UnixNano in "toast-1760000000000000000"
+var generated = regexp.MustCompile(`\d{13,}`)
+
+// void elements have no end tag
+var void = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// preformatted elements keep their text as is, script and style unescaped
+var preformatted = map[string]bool{"pre": true, "textarea": true, "script": true, "style": true}
+
+// Normalize pretty-prints HTML for comparing: a tag or text per line,
+// indented by nesting, with whitespace collapsed outside preformatted
+// elements, classes sorted and generated IDs numbered in order of
+// appearance, so the same markup rendered twice normalizes the same.
+func Normalize(s string) (string, error) {
+	ids := map[string]string{}
+	stable := func(s string) string {
+		return generated.ReplaceAllStringFunc(s, func(n string) string {
+			id, ok := ids[n]
+			if !ok {
+				id = "{{" + strconv.Itoa(len(ids)+1) + "}}"
+				ids[n] = id
+			}
+			return id
+		})
+	}
+
+	var b strings.Builder
+	depth := 0
+	line := func(s string) {
+		b.WriteString(strings.Repeat(indent, depth))
+		b.WriteString(s)
+		b.WriteByte('\n')
+	}
+	raw := ""
+
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if errors.Is(z.Err(), io.EOF) {
+				return b.String(), nil
+			}
+			return "", z.Err()
+
+		case html.DoctypeToken:
+			line("<!DOCTYPE " + string(z.Text()) + ">")
+
+		case html.CommentToken:
+			line("<!--" + stable(string(z.Text())) + "-->")
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			line(startTag(tok, stable))
+			if tt == html.StartTagToken && !void[tok.Data] {
+				depth++
+				if raw == "" && preformatted[tok.Data] {
+					raw = tok.Data
+				}
+			}
+
+		case html.EndTagToken:
+			tok := z.Token()
+			depth = max(depth-1, 0)
+			line("</" + tok.Data + ">")
+			if tok.Data == raw {
+				raw = ""
+			}
+
+		case html.TextToken:
+			text := stable(string(z.Text()))
+			if raw == "" {
+				if text = strings.Join(strings.Fields(text), " "); text != "" {
+					line(html.EscapeString(text))
+				}
+				continue
+			}
+			if raw != "script" && raw != "style" {
+				text = html.EscapeString(text)
+			}
+			for _, l := range strings.Split(strings.Trim(text, "\n"), "\n") {
+				if l = strings.TrimRight(l, " \t"); l != "" {
+					line(l)
+				}
+			}
+		}
+	}
+}
+
+// startTag prints the start tag of tok with its classes sorted
+func startTag(tok html.Token, stable func(string) string) string {
+	var b strings.Builder
+	b.WriteString("<" + tok.Data)
+	for _, a := range tok.Attr {
+		b.WriteByte(' ')
+		if a.Namespace != "" {
+			b.WriteString(a.Namespace + ":")
+		}
+		b.WriteString(a.Key)
+		if a.Val == "" {
+			continue
+		}
+		val := stable(a.Val)
+		if a.Key == "class" {
+			classes := strings.Fields(val)
+			slices.Sort(classes)
+			val = strings.Join(classes, " ")
+		}
+		b.WriteString(`="` + html.EscapeString(val) + `"`)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
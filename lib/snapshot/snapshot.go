@@ -0,0 +1,99 @@
+// Package snapshot compares rendered components against golden files, so
+// markup changes show up as a diff in review instead of going unnoticed.
+//
+// Output is normalized before it's compared: HTML is pretty-printed with a
+// tag per line, classes are sorted and generated IDs, which embed
+// timestamps, are numbered in order of appearance. Golden files live in the
+// testdata directory of the package under test:
+//
+//	func TestSnapshots(t *testing.T) {
+//		snapshot.Examples(t, map[string]func() g.Node{
+//			"Example":     Example,
+//			"ExampleHTMX": ExampleHTMX,
+//		})
+//	}
+//
+// Run the tests with -update to write the golden files after an intended
+// change, or make snapshots to update every package's:
+//
+//	go test ./pkg/button -update
+package snapshot
+
+import (
+	"errors"
+	"flag"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/diff"
+	g "maragu.dev/gomponents"
+)
+
+// Dir is the directory golden files are read from and written to, relative
+// to the package under test
+const Dir = "testdata"
+
+var update = flag.Bool("update", false, "write golden files instead of comparing against them")
+
+// Match renders n and compares its normalized HTML with the golden file
+// testdata/<name>.golden, or writes the file when the tests run with -update
+func Match(t testing.TB, name string, n g.Node) {
+	t.Helper()
+
+	var b strings.Builder
+	if err := n.Render(&b); err != nil {
+		t.Fatalf("rendering %s: %v", name, err)
+	}
+	got, err := Normalize(b.String())
+	if err != nil {
+		t.Fatalf("normalizing %s: %v", name, err)
+	}
+
+	path := filepath.Join(Dir, name+".golden")
+	if *update {
+		if err := os.MkdirAll(Dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("%s doesn't exist, run the tests with -update to create it", path)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s doesn't match %s, run the tests with -update if the change is intended:\n%s", name, path, diff.Unified(path, name, string(want), got))
+	}
+}
+
+// Examples matches each example against the golden file of its name, in a
+// subtest per example
+func Examples(t *testing.T, examples map[string]func() g.Node) {
+	t.Helper()
+	for _, name := range slices.Sorted(maps.Keys(examples)) {
+		t.Run(name, func(t *testing.T) {
+			Match(t, name, examples[name]())
+		})
+	}
+}
+
+// FixTime makes lib.Now return at until the test ends, for examples that
+// render dates relative to today. Tests that call it can't run in parallel.
+func FixTime(t testing.TB, at time.Time) {
+	now := lib.Now
+	lib.Now = func() time.Time { return at }
+	t.Cleanup(func() { lib.Now = now })
+}
@@ -0,0 +1,64 @@
+package snapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			"nesting",
+			`<div class="p-4 flex"><span>a
+			 b</span><input type="text" disabled><br/></div>`,
+			"<div class=\"flex p-4\">\n  <span>\n    a b\n  </span>\n  <input type=\"text\" disabled>\n  <br>\n</div>\n",
+		},
+		{
+			"generated IDs",
+			`<div id="toast-1760000000000000001"><button hx-target="#toast-1760000000000000001" aria-controls="menu-1760000000000000000">x</button></div>`,
+			"<div id=\"toast-{{1}}\">\n  <button hx-target=\"#toast-{{1}}\" aria-controls=\"menu-{{2}}\">\n    x\n  </button>\n</div>\n",
+		},
+		{
+			"preformatted",
+			"<pre><code>if a &lt; b {\n\treturn\n}</code></pre><script>if (a < b) {\n  go()\n}</script>",
+			"<pre>\n  <code>\n    if a &lt; b {\n    \treturn\n    }\n  </code>\n</pre>\n<script>\n  if (a < b) {\n    go()\n  }\n</script>\n",
+		},
+		{
+			"escaping",
+			`<!DOCTYPE html><p title="&quot;x&quot;">a &amp; b</p><!-- note -->`,
+			"<!DOCTYPE html>\n<p title=\"&#34;x&#34;\">\n  a &amp; b\n</p>\n<!-- note -->\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	FixTime(t, time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC))
+
+	Examples(t, map[string]func() g.Node{
+		"Match": func() g.Node {
+			return html.Div(
+				html.Class("rounded-md border"),
+				html.Time(g.Text(lib.Now().Format(time.DateOnly))),
+			)
+		},
+	})
+}
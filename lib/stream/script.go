@@ -0,0 +1,23 @@
+package stream
+
+// script defines shadcnStream(id), called after each streamed template to
+// move its content in place of the placeholder with the same ID. The content
+// is imported rather than moved so that scripts inside it run, and HTMX
+// attributes inside it are processed.
+const script = `
+(function() {
+	if (window.shadcnStream) return;
+	window.shadcnStream = function(id) {
+		const template = document.querySelector('template[data-stream-fragment="' + CSS.escape(id) + '"]');
+		if (!template) return;
+		const placeholder = document.getElementById(id);
+		if (placeholder) {
+			const content = document.importNode(template.content, true);
+			const loaded = content.firstElementChild;
+			placeholder.replaceWith(content);
+			if (loaded && window.htmx) window.htmx.process(loaded);
+		}
+		template.remove();
+	};
+})();
+`
@@ -0,0 +1,290 @@
+// Package stream renders the slow parts of a page after the rest of it, so
+// that a page composed of slow widgets doesn't wait for its slowest query.
+//
+// A handler defers each slow subtree with a loader. The page is flushed at
+// once with a placeholder in place of each deferred subtree, the loaders run
+// concurrently, and each result is streamed as soon as it is ready and swapped
+// into its placeholder:
+//
+//	s := stream.New()
+//	s.Timeout = 3 * time.Second
+//	page := layout(
+//		header(),
+//		s.Defer(stream.Props{Placeholder: skeleton.Card()}, func(ctx context.Context) (g.Node, error) {
+//			sales, err := db.Sales(ctx)
+//			if err != nil {
+//				return nil, err
+//			}
+//			return salesChart(sales), nil
+//		}),
+//	)
+//	s.Render(w, r, page)
+//
+// Over plain HTTP each result is sent as a chunk holding a template and a
+// small script that moves it into place. HTMX requests receive the results
+// as out-of-band swaps instead; HTMX applies them when the response ends, so
+// the content appears together, after the slowest loader rather than after
+// the sum of them.
+package stream
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// Loader loads a deferred subtree. ctx is cancelled when the loader times
+// out or the request ends.
+type Loader func(ctx context.Context) (g.Node, error)
+
+// Props defines a deferred subtree
+type Props struct {
+	ID          string                 // ID of the subtree's container (default: generated)
+	Placeholder g.Node                 // Shown until the subtree loads (default: a pulsing block)
+	Timeout     time.Duration          // Time the loader may take (default: the Stream's Timeout)
+	Fallback    func(err error) g.Node // Rendered when the loader fails or times out (default: the Stream's Fallback)
+	Class       string                 // Additional custom classes of the container
+	Attrs       []g.Node               // Additional attributes of the container
+}
+
+// Stream collects the deferred subtrees of one response. Create a Stream per
+// request with New.
+type Stream struct {
+	Timeout  time.Duration          // Time each loader may take (default: no limit)
+	Limit    int                    // Loaders run at once (default: no limit)
+	Fallback func(err error) g.Node // Rendered when a loader fails or times out (default: a short error message)
+	Prefix   string                 // Prefix of generated subtree IDs (default: random per Stream)
+
+	mu       sync.Mutex
+	deferred []deferred
+}
+
+// deferred is a subtree waiting for its loader
+type deferred struct {
+	id    string
+	props Props
+	load  Loader
+}
+
+// result is the rendered content of a deferred subtree
+type result struct {
+	deferred
+	content []byte
+}
+
+// New creates a Stream
+func New() *Stream {
+	return &Stream{}
+}
+
+// Defer registers load and returns the placeholder that its result replaces.
+// Defer must be called before or while the page is rendered by Render.
+func (s *Stream) Defer(props Props, load Loader) g.Node {
+	s.mu.Lock()
+	id := props.ID
+	if id == "" {
+		// Generated IDs must not clash with those of other Streams on the
+		// page, including ones swapped in later by HTMX
+		if s.Prefix == "" {
+			s.Prefix = "stream-" + randomHex(4)
+		}
+		id = s.Prefix + "-" + strconv.Itoa(len(s.deferred)+1)
+	}
+	s.deferred = append(s.deferred, deferred{id: id, props: props, load: load})
+	s.mu.Unlock()
+
+	placeholder := props.Placeholder
+	if placeholder == nil {
+		placeholder = html.Div(
+			html.Class("h-24 w-full animate-pulse rounded-md bg-muted"),
+			g.Attr("aria-hidden", "true"),
+		)
+	}
+	return container(id, props, "pending",
+		g.Attr("aria-busy", "true"),
+		placeholder,
+	)
+}
+
+// randomHex returns n random bytes in hex
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Render writes page and flushes it, then runs the loaders of the deferred
+// subtrees concurrently and writes each result as soon as it is ready.
+// Loader errors and timeouts render the fallback rather than failing the
+// response; the returned error is from writing the response.
+func (s *Stream) Render(w http.ResponseWriter, r *http.Request, page g.Node) error {
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	}
+	// Ask proxies such as nginx not to buffer the chunks
+	w.Header().Set("X-Accel-Buffering", "no")
+	htmx := r.Header.Get("HX-Request") == "true"
+	rc := http.NewResponseController(w)
+
+	if err := page.Render(w); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	pending := s.deferred
+	s.deferred = nil
+	s.mu.Unlock()
+
+	if len(pending) == 0 {
+		return flush(rc)
+	}
+	if !htmx {
+		if err := html.Script(g.Raw(script)).Render(w); err != nil {
+			return err
+		}
+	}
+	if err := flush(rc); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Buffered, so that loaders finishing after a failed write don't block
+	results := make(chan result, len(pending))
+	go func() {
+		group, ctx := errgroup.WithContext(ctx)
+		if s.Limit > 0 {
+			group.SetLimit(s.Limit)
+		}
+		for _, d := range pending {
+			group.Go(func() error {
+				results <- result{deferred: d, content: s.run(ctx, d, htmx)}
+				return nil
+			})
+		}
+		group.Wait()
+		close(results)
+	}()
+
+	for res := range results {
+		if err := writeResult(w, res, htmx); err != nil {
+			return err
+		}
+		if err := flush(rc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run loads d and renders its content, or its fallback on failure. For HTMX
+// the container is marked as an out-of-band swap of the placeholder.
+func (s *Stream) run(ctx context.Context, d deferred, htmx bool) []byte {
+	timeout := d.props.Timeout
+	if timeout == 0 {
+		timeout = s.Timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	oob := g.If(htmx, g.Attr("hx-swap-oob", "outerHTML"))
+	var buf bytes.Buffer
+	node, err := load(ctx, d.load)
+	if err == nil {
+		err = container(d.id, d.props, "loaded", oob, node).Render(&buf)
+	}
+	if err != nil {
+		buf.Reset()
+		container(d.id, d.props, "failed", oob, s.fallback(d.props, err)).Render(&buf)
+	}
+	return buf.Bytes()
+}
+
+// load runs loader, returning when it does or when ctx is done, so a loader
+// that ignores its context still times out
+func load(ctx context.Context, loader Loader) (g.Node, error) {
+	type outcome struct {
+		node g.Node
+		err  error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if v := recover(); v != nil {
+				done <- outcome{err: fmt.Errorf("stream: loader panicked: %v", v)}
+			}
+		}()
+		node, err := loader(ctx)
+		done <- outcome{node, err}
+	}()
+
+	select {
+	case o := <-done:
+		return o.node, o.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fallback returns the node rendered when a loader fails
+func (s *Stream) fallback(props Props, err error) g.Node {
+	switch {
+	case props.Fallback != nil:
+		return props.Fallback(err)
+	case s.Fallback != nil:
+		return s.Fallback(err)
+	}
+	return html.Div(
+		html.Class("rounded-md border border-destructive/50 px-4 py-3 text-sm text-destructive"),
+		g.Attr("role", "alert"),
+		g.Text("This section couldn't be loaded."),
+	)
+}
+
+// container renders the element that holds a deferred subtree. The
+// placeholder and the loaded content share it, so the swap keeps its layout.
+func container(id string, props Props, state string, children ...g.Node) g.Node {
+	return html.Div(
+		html.ID(id),
+		g.If(props.Class != "", html.Class(props.Class)),
+		g.Attr("data-stream", state),
+		g.Group(props.Attrs),
+		g.Group(children),
+	)
+}
+
+// writeResult writes the content of res in the form the client applies
+func writeResult(w http.ResponseWriter, res result, htmx bool) error {
+	if htmx {
+		_, err := w.Write(res.content)
+		return err
+	}
+	id, _ := json.Marshal(res.id)
+	return g.Group{
+		html.Template(g.Attr("data-stream-fragment", res.id), g.Raw(string(res.content))),
+		html.Script(g.Raw("shadcnStream(" + string(id) + ")")),
+	}.Render(w)
+}
+
+// flush sends the buffered response, if the writer supports it
+func flush(rc *http.ResponseController) error {
+	if err := rc.Flush(); err != nil && err != http.ErrNotSupported {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// flushRecorder records the response and calls onFlush on each flush
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	onFlush func(body string)
+}
+
+func (f *flushRecorder) Flush() {
+	f.ResponseRecorder.Flush()
+	if f.onFlush != nil {
+		f.onFlush(f.Body.String())
+	}
+}
+
+func TestRenderStreamsOutOfOrder(t *testing.T) {
+	s := New()
+	first := make(chan struct{})
+	flushed := make(chan string, 1)
+
+	page := html.Main(
+		s.Defer(Props{ID: "slow"}, func(ctx context.Context) (g.Node, error) {
+			<-first
+			return g.Text("slow content"), nil
+		}),
+		s.Defer(Props{ID: "fast", Placeholder: html.P(g.Text("loading"))}, func(ctx context.Context) (g.Node, error) {
+			// The page must reach the client before any loader finishes
+			body := <-flushed
+			defer close(first)
+			if !strings.Contains(body, `<div id="fast" data-stream="pending" aria-busy="true"><p>loading</p></div>`) {
+				return nil, errors.New("page not flushed with placeholders")
+			}
+			return g.Text("fast content"), nil
+		}),
+	)
+
+	w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+	w.onFlush = func(body string) {
+		select {
+		case flushed <- body:
+		default:
+		}
+	}
+	if err := s.Render(w, httptest.NewRequest("GET", "/", nil), page); err != nil {
+		t.Fatal(err)
+	}
+	body := w.Body.String()
+
+	fast := strings.Index(body, `<template data-stream-fragment="fast"><div id="fast" data-stream="loaded">fast content</div></template><script>shadcnStream("fast")</script>`)
+	slow := strings.Index(body, `<template data-stream-fragment="slow"><div id="slow" data-stream="loaded">slow content</div></template><script>shadcnStream("slow")</script>`)
+	if fast == -1 || slow == -1 {
+		t.Fatalf("expected both fragments, got %s", body)
+	}
+	if fast > slow {
+		t.Error("expected fragments in the order their loaders finished")
+	}
+	if !strings.Contains(body, "window.shadcnStream") {
+		t.Error("expected the swap script")
+	}
+	if got := w.Header().Get("Content-Type"); got != "text/html; charset=utf-8" {
+		t.Errorf("expected an HTML content type, got %q", got)
+	}
+}
+
+func TestRenderFallbacks(t *testing.T) {
+	s := New()
+	s.Prefix = "stream"
+	s.Timeout = 20 * time.Millisecond
+	block := make(chan struct{})
+	defer close(block)
+
+	page := g.Group{
+		s.Defer(Props{}, func(ctx context.Context) (g.Node, error) {
+			return nil, errors.New("database down")
+		}),
+		s.Defer(Props{Fallback: func(err error) g.Node {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return g.Text("timed out")
+			}
+			return g.Text(err.Error())
+		}}, func(ctx context.Context) (g.Node, error) {
+			// Ignores ctx, so only the stream can time it out
+			<-block
+			return g.Text("too late"), nil
+		}),
+		s.Defer(Props{}, func(ctx context.Context) (g.Node, error) {
+			panic("boom")
+		}),
+	}
+
+	w := httptest.NewRecorder()
+	if err := s.Render(w, httptest.NewRequest("GET", "/", nil), page); err != nil {
+		t.Fatal(err)
+	}
+	body := w.Body.String()
+
+	for _, want := range []string{
+		`<div id="stream-1" data-stream="failed"><div class="rounded-md border border-destructive/50 px-4 py-3 text-sm text-destructive" role="alert">This section couldn&#39;t be loaded.</div></div>`,
+		`<div id="stream-2" data-stream="failed">timed out</div>`,
+		`<div id="stream-3" data-stream="failed"><div class="rounded-md`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected output to contain %q, got %s", want, body)
+		}
+	}
+	if strings.Contains(body, "too late") || strings.Contains(body, "database down") {
+		t.Error("expected neither late content nor error details in the response")
+	}
+}
+
+func TestRenderStreamIDs(t *testing.T) {
+	a, b := New(), New()
+	load := func(text string) Loader {
+		return func(ctx context.Context) (g.Node, error) { return g.Text(text), nil }
+	}
+	page := html.Main(a.Defer(Props{}, load("from a")), b.Defer(Props{}, load("from b")))
+
+	w := httptest.NewRecorder()
+	if err := a.Render(w, httptest.NewRequest("GET", "/", nil), page); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Render(w, httptest.NewRequest("GET", "/", nil), g.Group(nil)); err != nil {
+		t.Fatal(err)
+	}
+	body := w.Body.String()
+
+	idA, idB := a.Prefix+"-1", b.Prefix+"-1"
+	if a.Prefix == "" || idA == idB {
+		t.Fatalf("expected distinct generated IDs, got %q and %q", idA, idB)
+	}
+	for _, want := range []string{
+		`<div id="` + idA + `" data-stream="pending"`,
+		`<div id="` + idB + `" data-stream="pending"`,
+		`<div id="` + idA + `" data-stream="loaded">from a</div>`,
+		`<div id="` + idB + `" data-stream="loaded">from b</div>`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected output to contain %q, got %s", want, body)
+		}
+	}
+}
+
+func TestRenderHTMX(t *testing.T) {
+	s := New()
+	page := html.Section(
+		s.Defer(Props{ID: "chart", Class: "col-span-2"}, func(ctx context.Context) (g.Node, error) {
+			return g.Text("chart"), nil
+		}),
+	)
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("HX-Request", "true")
+	w := httptest.NewRecorder()
+	if err := s.Render(w, r, page); err != nil {
+		t.Fatal(err)
+	}
+	body := w.Body.String()
+
+	want := `<section><div id="chart" class="col-span-2" data-stream="pending" aria-busy="true">` +
+		`<div class="h-24 w-full animate-pulse rounded-md bg-muted" aria-hidden="true"></div></div></section>` +
+		`<div id="chart" class="col-span-2" data-stream="loaded" hx-swap-oob="outerHTML">chart</div>`
+	if body != want {
+		t.Errorf("expected %s, got %s", want, body)
+	}
+}
+
+func TestRenderLimit(t *testing.T) {
+	s := New()
+	s.Limit = 1
+	running := make(chan struct{}, 1)
+
+	var nodes g.Group
+	for range 3 {
+		nodes = append(nodes, s.Defer(Props{}, func(ctx context.Context) (g.Node, error) {
+			select {
+			case running <- struct{}{}:
+			default:
+				return nil, errors.New("loaders ran concurrently")
+			}
+			time.Sleep(time.Millisecond)
+			<-running
+			return g.Text("ok"), nil
+		}))
+	}
+
+	w := httptest.NewRecorder()
+	if err := s.Render(w, httptest.NewRequest("GET", "/", nil), nodes); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(w.Body.String(), `data-stream="loaded"`); n != 3 {
+		t.Errorf("expected 3 loaded subtrees, got %d", n)
+	}
+}
@@ -1,5 +1,12 @@
 package lib
 
+import (
+	"sync"
+
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
 // VariantProps represents the common properties for component variants
 type VariantProps struct {
 	Variant string
@@ -7,41 +14,106 @@ type VariantProps struct {
 	Class   string // Additional custom classes
 }
 
-// VariantConfig defines the structure for component variant configurations
+// VariantConfig defines the structure for component variant configurations.
+// The classes of every variant and size combination are compiled on first
+// use, so a config must not be changed after it is first used.
 type VariantConfig struct {
 	Base     string                       // Base classes always applied
 	Variants map[string]map[string]string // variant type -> variant name -> classes
 	Defaults map[string]string            // Default variant selections
+
+	once     sync.Once
+	compiled map[variantKey]compiledVariant
+}
+
+// variantKey is a variant and size combination, after defaults
+type variantKey struct {
+	variant, size string
+}
+
+// compiledVariant holds the classes of a combination and their prerendered
+// class attribute
+type compiledVariant struct {
+	classes string
+	attr    g.Node
 }
 
 // GetClasses returns the combined classes for the given variant configuration
 func (vc *VariantConfig) GetClasses(props VariantProps) string {
-	classes := []string{vc.Base}
+	classes, _ := vc.lookup(props)
+	if props.Class == "" {
+		return classes
+	}
+	return MergeClasses(classes, props.Class)
+}
 
-	// Apply variant classes
-	if props.Variant == "" && vc.Defaults["variant"] != "" {
-		props.Variant = vc.Defaults["variant"]
+// ClassAttr returns the class attribute for the given variant configuration.
+// Without custom classes the attribute is prerendered, so components rendered
+// many times per page don't rebuild it.
+func (vc *VariantConfig) ClassAttr(props VariantProps) g.Node {
+	classes, attr := vc.lookup(props)
+	if props.Class != "" {
+		return html.Class(MergeClasses(classes, props.Class))
 	}
-	if variantClasses, ok := vc.Variants["variant"]; ok {
-		if class, ok := variantClasses[props.Variant]; ok {
-			classes = append(classes, class)
-		}
+	if attr == nil {
+		return html.Class(classes)
 	}
+	return attr
+}
 
-	// Apply size classes
-	if props.Size == "" && vc.Defaults["size"] != "" {
+// lookup returns the compiled classes of the props' variant and size. Names
+// missing from the config are combined on each call.
+func (vc *VariantConfig) lookup(props VariantProps) (string, g.Node) {
+	vc.once.Do(vc.compile)
+	key := vc.key(props)
+	if c, ok := vc.compiled[key]; ok {
+		return c.classes, c.attr
+	}
+	return vc.combine(key), nil
+}
+
+// key applies the default variant and size
+func (vc *VariantConfig) key(props VariantProps) variantKey {
+	if props.Variant == "" {
+		props.Variant = vc.Defaults["variant"]
+	}
+	if props.Size == "" {
 		props.Size = vc.Defaults["size"]
 	}
-	if sizeClasses, ok := vc.Variants["size"]; ok {
-		if class, ok := sizeClasses[props.Size]; ok {
-			classes = append(classes, class)
+	return variantKey{props.Variant, props.Size}
+}
+
+// compile combines the classes of every known variant and size
+func (vc *VariantConfig) compile() {
+	variants := append([]string{""}, keys(vc.Variants["variant"])...)
+	sizes := append([]string{""}, keys(vc.Variants["size"])...)
+
+	vc.compiled = make(map[variantKey]compiledVariant, len(variants)*len(sizes))
+	for _, variant := range variants {
+		for _, size := range sizes {
+			key := variantKey{variant, size}
+			classes := vc.combine(key)
+			vc.compiled[key] = compiledVariant{classes: classes, attr: Static(html.Class(classes))}
 		}
 	}
+}
 
-	// Add custom classes
-	if props.Class != "" {
-		classes = append(classes, props.Class)
+// combine returns the base, variant and size classes of key
+func (vc *VariantConfig) combine(key variantKey) string {
+	classes := []string{vc.Base}
+	if class, ok := vc.Variants["variant"][key.variant]; ok {
+		classes = append(classes, class)
+	}
+	if class, ok := vc.Variants["size"][key.size]; ok {
+		classes = append(classes, class)
 	}
-
 	return MergeClasses(classes...)
-}
\ No newline at end of file
+}
+
+func keys(m map[string]string) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package lib
+
+import (
+	"io"
+	"testing"
+
+	html "maragu.dev/gomponents/html"
+)
+
+func testVariants() *VariantConfig {
+	return &VariantConfig{
+		Base: "inline-flex rounded-md",
+		Variants: map[string]map[string]string{
+			"variant": {
+				"default": "bg-primary",
+				"ghost":   "hover:bg-accent",
+			},
+			"size": {
+				"default": "h-9 px-4",
+				"sm":      "h-8 px-3",
+			},
+		},
+		Defaults: map[string]string{
+			"variant": "default",
+			"size":    "default",
+		},
+	}
+}
+
+func TestGetClasses(t *testing.T) {
+	tests := []struct {
+		name  string
+		props VariantProps
+		want  string
+	}{
+		{"defaults", VariantProps{}, "inline-flex rounded-md bg-primary h-9 px-4"},
+		{"variant and size", VariantProps{Variant: "ghost", Size: "sm"}, "inline-flex rounded-md hover:bg-accent h-8 px-3"},
+		{"custom classes", VariantProps{Class: "w-full rounded-md"}, "inline-flex rounded-md bg-primary h-9 px-4 w-full"},
+		{"unknown variant", VariantProps{Variant: "missing"}, "inline-flex rounded-md h-9 px-4"},
+	}
+
+	vc := testVariants()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vc.GetClasses(tt.props); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+			want := render(t, html.Div(html.Class(tt.want)))
+			if got := render(t, html.Div(vc.ClassAttr(tt.props))); got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestVariantAllocations(t *testing.T) {
+	vc := testVariants()
+	props := VariantProps{Variant: "ghost", Size: "sm"}
+	n := testing.AllocsPerRun(100, func() {
+		vc.GetClasses(props)
+		_ = vc.ClassAttr(props).Render(io.Discard)
+	})
+	if n != 0 {
+		t.Errorf("expected compiled variants to not allocate, got %v allocs", n)
+	}
+}
@@ -16,23 +16,27 @@ type Props struct {
 	DefaultOpen  []string // Default open items for multiple mode
 	Class        string   // Additional custom classes
 	ID           string   // Unique identifier for the accordion
+	Attrs        []g.Node // Additional attributes to pass through
 }
 
 // ItemProps defines the properties for an AccordionItem
 type ItemProps struct {
-	Value string // Unique value for the item
-	Class string // Additional custom classes
+	Value string   // Unique value for the item
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines the properties for an AccordionTrigger
 type TriggerProps struct {
-	Class string // Additional custom classes
-	Icon  g.Node // Custom icon (optional)
+	Class string   // Additional custom classes
+	Icon  g.Node   // Custom icon (optional)
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines the properties for AccordionContent
 type ContentProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Accordion component
@@ -59,7 +63,7 @@ func New(props Props, children ...g.Node) g.Node {
 	
 	// Add JavaScript for interactivity
 	return g.Group([]g.Node{
-		html.Div(append(attrs, children...)...),
+		html.Div(lib.MergeAttrs(append(attrs, children...), props.Attrs)...),
 		accordionScript(props.ID),
 	})
 }
@@ -68,12 +72,12 @@ func New(props Props, children ...g.Node) g.Node {
 func Item(props ItemProps, children ...g.Node) g.Node {
 	classes := lib.CN("border-b last:border-b-0", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		dataAttr("slot", "accordion-item"),
 		dataAttr("accordion-value", props.Value),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates an AccordionTrigger component
@@ -91,14 +95,14 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	
 	return html.Div(
 		html.Class("flex"),
-		html.Button(
+		html.Button(lib.MergeAttrs([]g.Node{
 			html.Type("button"),
 			html.Class(triggerClasses),
 			dataAttr("slot", "accordion-trigger"),
 			AriaAttr("expanded", "false"),
 			g.Group(children),
 			icon,
-		),
+		}, props.Attrs)...),
 	)
 }
 
@@ -107,7 +111,7 @@ func ItemContent(props ContentProps, children ...g.Node) g.Node {
 	contentClasses := lib.CN("overflow-hidden text-sm transition-all duration-200", props.Class)
 	innerClasses := lib.CN("pt-0 pb-4", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(contentClasses),
 		dataAttr("slot", "accordion-content"),
 		dataAttr("state", "closed"),
@@ -116,7 +120,7 @@ func ItemContent(props ContentProps, children ...g.Node) g.Node {
 			html.Class(innerClasses),
 			g.Group(children),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // Helper functions
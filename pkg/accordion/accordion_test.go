@@ -7,6 +7,7 @@ import (
 	
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 // renderToString renders a node to a string for testing
@@ -221,6 +222,15 @@ func TestAccordionTrigger(t *testing.T) {
 				"custom-trigger",
 			},
 		},
+		{
+			name:     "trigger with attributes",
+			props:    TriggerProps{Attrs: []g.Node{g.Attr("data-testid", "faq"), g.Attr("aria-controls", "panel")}},
+			children: []g.Node{g.Text("Question")},
+			contains: []string{
+				`<div class="flex"><button type="button"`,
+				`data-testid="faq" aria-controls="panel">Question`,
+			},
+		},
 	}
 	
 	for _, tt := range tests {
@@ -328,4 +338,10 @@ func TestHelperFunctions(t *testing.T) {
 }
 
 // TestDataAndAriaAttributes is removed since dataAttr is now unexported
-// The functionality is tested through the component tests above
\ No newline at end of file
+// The functionality is tested through the component tests above
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
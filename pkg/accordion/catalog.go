@@ -0,0 +1,22 @@
+package accordion
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Accordion",
+		Category:    "Display",
+		Description: "Collapsible content panels",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+	})
+}
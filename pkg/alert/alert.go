@@ -8,8 +8,9 @@ import (
 
 // Props defines the properties for the Alert component
 type Props struct {
-	Variant string // "default" | "destructive"
-	Class   string // Additional custom classes
+	Variant string   // "default" | "destructive"
+	Class   string   // Additional custom classes
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // alertVariants defines the variant configuration for alerts
@@ -41,7 +42,7 @@ func New(props Props, children ...g.Node) g.Node {
 	}
 
 	// Combine attributes and children
-	return html.Div(append(attrs, children...)...)
+	return html.Div(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Default creates an alert with default variant
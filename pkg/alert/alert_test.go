@@ -6,6 +6,7 @@ import (
 	"testing"
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/alert"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestAlert(t *testing.T) {
@@ -76,4 +77,10 @@ func TestAlert(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": alert.Example,
+	})
+}
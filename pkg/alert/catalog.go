@@ -0,0 +1,34 @@
+package alert
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Alert",
+		Category:    "Feedback",
+		Description: "Alert messages",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Variant", Type: catalog.Enum, Default: "default", Options: []string{"default", "destructive"}},
+			{Name: "Title", Default: "Heads up!", Description: "Title of the alert"},
+			{Name: "Description", Default: "You can add components to your app using the CLI.", Description: "Text of the alert"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return New(Props{Variant: v.String("Variant")},
+				Title(g.Text(v.String("Title"))),
+				Description(g.Text(v.String("Description"))),
+			)
+		},
+	})
+}
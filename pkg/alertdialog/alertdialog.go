@@ -4,48 +4,62 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
 )
 
 // Props defines the properties for the AlertDialog component
 type Props struct {
-	Open  bool   // Whether the dialog is open (for server-side rendering)
-	Class string // Additional custom classes
+	Open  bool        // Whether the dialog is open (for server-side rendering)
+	Class string      // Additional custom classes
+	Modal modal.Props // Focus and Escape behaviour
+	Attrs []g.Node    // Additional attributes to pass through
 }
 
 // ContentProps defines the properties for the AlertDialogContent
 type ContentProps struct {
-	Class string
+	Class       string
+	LabelledBy  string   // ID of the title (found automatically when empty)
+	DescribedBy string   // ID of the description (found automatically when empty)
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // HeaderProps defines the properties for the AlertDialogHeader
 type HeaderProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // FooterProps defines the properties for the AlertDialogFooter
 type FooterProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TitleProps defines the properties for the AlertDialogTitle
 type TitleProps struct {
+	ID    string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // DescriptionProps defines the properties for the AlertDialogDescription
 type DescriptionProps struct {
+	ID    string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ActionProps defines the properties for the AlertDialogAction
 type ActionProps struct {
 	Class string
-	Href  string // Optional href to make it a link
+	Href  string   // Optional href to make it a link
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // CancelProps defines the properties for the AlertDialogCancel
 type CancelProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new AlertDialog component
@@ -62,9 +76,22 @@ func New(props Props, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
-		append([]g.Node{html.Class(classes)}, children...)...,
-	)
+	return html.Div(lib.MergeAttrs([]g.Node{
+		html.Class(classes),
+		modal.Root(modalProps(props.Modal)),
+		g.Group(children),
+		modal.Script(),
+	}, props.Attrs)...)
+}
+
+// modalProps applies the alert dialog defaults: focus starts on the cancel
+// button and clicking the overlay does not dismiss the dialog
+func modalProps(props modal.Props) modal.Props {
+	if props.InitialFocus == "" {
+		props.InitialFocus = "[data-alert-dialog-cancel]"
+	}
+	props.DisableOverlayClose = true
+	return props
 }
 
 // DialogOverlay creates the AlertDialog overlay
@@ -74,7 +101,7 @@ func DialogOverlay(class ...string) g.Node {
 		lib.CN(class...),
 	)
 
-	return html.Div(html.Class(classes))
+	return html.Div(html.Class(classes), modal.Overlay())
 }
 
 // DialogContent creates the AlertDialog content container
@@ -84,10 +111,11 @@ func DialogContent(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
+		modal.Content(modal.ContentProps{Role: "alertdialog", LabelledBy: props.LabelledBy, DescribedBy: props.DescribedBy}),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogHeader creates the AlertDialog header
@@ -97,10 +125,10 @@ func DialogHeader(props HeaderProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogFooter creates the AlertDialog footer
@@ -110,10 +138,10 @@ func DialogFooter(props FooterProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogTitle creates the AlertDialog title
@@ -123,10 +151,12 @@ func DialogTitle(props TitleProps, text string) g.Node {
 		props.Class,
 	)
 
-	return html.H3(
+	return html.H3(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Title(),
 		g.Text(text),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogDescription creates the AlertDialog description
@@ -136,10 +166,12 @@ func DialogDescription(props DescriptionProps, text string) g.Node {
 		props.Class,
 	)
 
-	return html.P(
+	return html.P(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Description(),
 		g.Text(text),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogAction creates the AlertDialog action button
@@ -153,18 +185,18 @@ func DialogAction(props ActionProps, children ...g.Node) g.Node {
 	)
 
 	if props.Href != "" {
-		return html.A(
+		return html.A(lib.MergeAttrs([]g.Node{
 			html.Href(props.Href),
 			html.Class(classes),
 			g.Group(children),
-		)
+		}, props.Attrs)...)
 	}
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogCancel creates the AlertDialog cancel button
@@ -178,11 +210,13 @@ func DialogCancel(props CancelProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(classes),
+		g.Attr("data-alert-dialog-cancel", ""),
+		modal.Close(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Example creates a complete alert dialog example
@@ -5,6 +5,7 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
 )
 
 // HTMXProps defines HTMX-specific properties for the AlertDialog
@@ -16,35 +17,42 @@ type HTMXProps struct {
 
 // TriggerProps defines properties for trigger button
 type TriggerProps struct {
-	Class string // Additional CSS classes
+	Class string   // Additional CSS classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // NewHTMX creates an HTMX-enhanced AlertDialog component
 func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	if props.Open {
 		classes := lib.CN("fixed inset-0 z-50", props.Class)
-		return html.Div(
+		modalProps := modalProps(props.Modal)
+		if modalProps.ClosePath == "" {
+			modalProps.ClosePath = htmxProps.ClosePath
+		}
+		return html.Div(lib.MergeAttrs([]g.Node{
 			html.ID(htmxProps.ID),
 			html.Class(classes),
+			modal.Root(modalProps),
 			g.Group(children),
-		)
+			modal.Script(),
+		}, props.Attrs)...)
 	}
 	// Return empty div that can be replaced by HTMX
-	return html.Div(html.ID(htmxProps.ID))
+	return html.Div(lib.MergeAttrs([]g.Node{html.ID(htmxProps.ID)}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger button
 func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		hx.Get(htmxProps.TriggerPath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogOverlayHTMX creates an HTMX-enhanced overlay with close functionality
@@ -58,8 +66,9 @@ func DialogOverlayHTMX(htmxProps HTMXProps, class ...string) g.Node {
 
 	return html.Div(
 		html.Class(classes),
+		modal.Overlay(),
 		hx.Get(htmxProps.ClosePath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		hx.Trigger("click"),
 	)
@@ -76,14 +85,15 @@ func CancelHTMX(props ActionProps, htmxProps HTMXProps, children ...g.Node) g.No
 		props.Class,
 	)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(classes),
+		g.Attr("data-alert-dialog-cancel", ""),
 		hx.Get(htmxProps.ClosePath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ActionHTMX creates an HTMX-enhanced action button
@@ -97,14 +107,14 @@ func ActionHTMX(props ActionProps, htmxProps HTMXProps, actionPath string, child
 		props.Class,
 	)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(classes),
 		hx.Post(actionPath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ExampleHTMX creates an HTMX-enhanced alert dialog example
@@ -211,7 +221,7 @@ func RenderDeleteAccountDialog(htmxProps HTMXProps) g.Node {
 						html.ID("delete-confirmation"),
 						hx.Post("/api/alert-dialog/delete-account/validate"),
 						hx.Trigger("keyup changed delay:500ms"),
-						html.Target("#delete-button"),
+						hx.Target("#delete-button"),
 						hx.Swap("outerHTML"),
 					),
 				),
@@ -7,6 +7,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/alertdialog"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestAlertDialog(t *testing.T) {
@@ -255,4 +256,15 @@ func TestAlertDialog(t *testing.T) {
 			t.Error("expected example to contain action button")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":              alertdialog.Example,
+		"ExampleBasic":         alertdialog.ExampleBasic,
+		"ExampleCustomStyling": alertdialog.ExampleCustomStyling,
+		"ExampleDestructive":   alertdialog.ExampleDestructive,
+		"ExampleHTMX":          alertdialog.ExampleHTMX,
+		"ExampleWithLink":      alertdialog.ExampleWithLink,
+	})
+}
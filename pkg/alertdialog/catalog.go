@@ -0,0 +1,22 @@
+package alertdialog
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed alertdialog.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Alert Dialog",
+		Category:    "Feedback",
+		Description: "Modal alert dialogs",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+// Package all registers every component in the catalog. Import it for its
+// side effects to serve the full gallery:
+//
+//	import _ "github.com/rizome-dev/shadcn-gomponents/pkg/all"
+package all
+
+import (
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/accordion"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/alert"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/alertdialog"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/aspectratio"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/avatar"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/badge"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/breadcrumb"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/button"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/calendar"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/card"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/carousel"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/chart"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/checkbox"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/collapsible"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/combobox"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/command"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/contextmenu"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/datatable"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/datepicker"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/dialog"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/drawer"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/dropdownmenu"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/form"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/hovercard"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/input"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/inputotp"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/label"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/menubar"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/navigationmenu"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/pagination"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/popover"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/progress"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/radio"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/resizable"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/scrollarea"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/selector"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/separator"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/sheet"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/sidebar"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/skeleton"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/slider"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/sonner"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/switch"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/table"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/tabs"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/textarea"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/toast"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/toggle"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/togglegroup"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/tooltip"
+	_ "github.com/rizome-dev/shadcn-gomponents/pkg/typography"
+)
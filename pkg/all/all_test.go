@@ -0,0 +1,52 @@
+package all
+
+import (
+	"io"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+func TestCatalog(t *testing.T) {
+	components := catalog.Components()
+	if len(components) < 50 {
+		t.Fatalf("expected every component to be registered, got %d", len(components))
+	}
+	for _, c := range components {
+		t.Run(c.Slug, func(t *testing.T) {
+			if c.Category == "" || c.Description == "" || len(c.Examples) == 0 {
+				t.Errorf("expected a category, a description and examples, got %+v", c)
+			}
+			for _, ex := range c.Examples {
+				if _, ok := catalog.DefaultRegistry.ExampleSource(c.Slug, ex); !ok {
+					t.Errorf("no source for example %q (%s)", ex.Name, ex.Func)
+				}
+				if err := ex.Render().Render(io.Discard); err != nil {
+					t.Errorf("example %q: %v", ex.Name, err)
+				}
+			}
+			if c.Playground != nil {
+				if err := c.Playground(catalog.Defaults(c.Props)).Render(io.Discard); err != nil {
+					t.Errorf("playground: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkExamples renders every example in the catalog, one sub-benchmark
+// per component, e.g. go test ./pkg/all -bench 'Examples/data-table'
+func BenchmarkExamples(b *testing.B) {
+	for _, c := range catalog.Components() {
+		b.Run(c.Slug, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				for _, ex := range c.Examples {
+					if err := ex.Render().Render(io.Discard); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
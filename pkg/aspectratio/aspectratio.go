@@ -10,8 +10,9 @@ import (
 
 // Props defines the properties for the AspectRatio component
 type Props struct {
-	Ratio float64 // The aspect ratio (e.g., 16/9, 4/3, 1/1)
-	Class string  // Additional custom classes
+	Ratio float64  // The aspect ratio (e.g., 16/9, 4/3, 1/1)
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new AspectRatio component
@@ -29,7 +30,7 @@ func New(props Props, children ...g.Node) g.Node {
 	
 	classes := lib.CN("relative overflow-hidden", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		dataAttr("slot", "aspect-ratio"),
 		dataAttr("aspect-ratio", fmt.Sprintf("%.2f", ratio)),
@@ -40,7 +41,7 @@ func New(props Props, children ...g.Node) g.Node {
 			html.Class("absolute inset-0"),
 			g.Group(children),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // Common aspect ratio helpers
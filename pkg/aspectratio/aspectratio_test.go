@@ -8,6 +8,7 @@ import (
 	
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 // renderToString renders a node to a string for testing
@@ -226,4 +227,10 @@ func TestPaddingCalculation(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
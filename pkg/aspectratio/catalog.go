@@ -0,0 +1,22 @@
+package aspectratio
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Aspect Ratio",
+		Category:    "Layout",
+		Description: "Displays content within a desired ratio",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+	})
+}
@@ -9,8 +9,9 @@ import (
 
 // Props defines the properties for the Avatar component
 type Props struct {
-	Size  string // "sm" | "default" | "lg" or custom size class
-	Class string // Additional custom classes
+	Size  string   // "sm" | "default" | "lg" or custom size class
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ImageProps defines properties for the AvatarImage
@@ -18,6 +19,7 @@ type ImageProps struct {
 	Src   string
 	Alt   string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Avatar container
@@ -44,10 +46,10 @@ func New(props Props, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Default creates an avatar with default size
@@ -81,7 +83,7 @@ func Image(props ImageProps) g.Node {
 		attrs = append(attrs, html.Alt(props.Alt))
 	}
 
-	return html.Img(attrs...)
+	return html.Img(lib.MergeAttrs(attrs, props.Attrs)...)
 }
 
 // Fallback creates an AvatarFallback component
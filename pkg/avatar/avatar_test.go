@@ -7,6 +7,7 @@ import (
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/avatar"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestAvatar(t *testing.T) {
@@ -138,4 +139,10 @@ func TestAvatar(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": avatar.Example,
+	})
+}
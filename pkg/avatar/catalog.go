@@ -0,0 +1,22 @@
+package avatar
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Avatar",
+		Category:    "Data Display",
+		Description: "User avatar display",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+	})
+}
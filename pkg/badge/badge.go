@@ -8,8 +8,9 @@ import (
 
 // Props defines the properties for the Badge component
 type Props struct {
-	Variant string // "default" | "secondary" | "destructive" | "outline"
-	Class   string // Additional custom classes
+	Variant string   // "default" | "secondary" | "destructive" | "outline"
+	Class   string   // Additional custom classes
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // badgeVariants defines the variant configuration for badges
@@ -31,18 +32,13 @@ var badgeVariants = lib.VariantConfig{
 // New creates a new Badge component
 func New(props Props, children ...g.Node) g.Node {
 	// Get variant classes
-	classes := badgeVariants.GetClasses(lib.VariantProps{
+	classes := badgeVariants.ClassAttr(lib.VariantProps{
 		Variant: props.Variant,
 		Class:   props.Class,
 	})
 
-	// Build attributes
-	attrs := []g.Node{
-		html.Class(classes),
-	}
-
 	// Combine attributes and children
-	return html.Div(append(attrs, children...)...)
+	return lib.Tag("div", []g.Node{classes}, children, props.Attrs)
 }
 
 // Default creates a badge with default variant
@@ -94,9 +90,9 @@ func LinkComponent(href string, props Props, children ...g.Node) g.Node {
 	// Add hover effect for links
 	classes = lib.CN(classes, "hover:underline")
 
-	return html.A(
+	return html.A(lib.MergeAttrs([]g.Node{
 		html.Href(href),
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
\ No newline at end of file
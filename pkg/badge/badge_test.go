@@ -6,6 +6,7 @@ import (
 	"testing"
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/badge"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestBadge(t *testing.T) {
@@ -122,4 +123,10 @@ func TestBadge(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": badge.Example,
+	})
+}
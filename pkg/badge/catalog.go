@@ -0,0 +1,30 @@
+package badge
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Badge",
+		Category:    "Data Display",
+		Description: "Small count and labeling",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Variant", Type: catalog.Enum, Default: "default", Options: []string{"default", "secondary", "destructive", "outline"}},
+			{Name: "Label", Default: "Badge", Description: "Text of the badge"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return New(Props{Variant: v.String("Variant")}, g.Text(v.String("Label")))
+		},
+	})
+}
@@ -9,38 +9,45 @@ import (
 
 // Props defines the properties for the Breadcrumb component
 type Props struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ListProps defines the properties for the BreadcrumbList
 type ListProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ItemProps defines the properties for the BreadcrumbItem
 type ItemProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // LinkProps defines the properties for the BreadcrumbLink
 type LinkProps struct {
 	Href  string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // PageProps defines the properties for the BreadcrumbPage
 type PageProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SeparatorProps defines the properties for the BreadcrumbSeparator
 type SeparatorProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // EllipsisProps defines the properties for the BreadcrumbEllipsis
 type EllipsisProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Breadcrumb navigation component
@@ -50,10 +57,10 @@ func New(props Props, children ...g.Node) g.Node {
 	)
 
 	return html.Nav(
-		append([]g.Node{
+		lib.MergeAttrs(append([]g.Node{
 			g.Attr("aria-label", "breadcrumb"),
 			g.If(classes != "", html.Class(classes)),
-		}, children...)...,
+		}, children...), props.Attrs)...,
 	)
 }
 
@@ -65,7 +72,7 @@ func BreadcrumbList(props ListProps, children ...g.Node) g.Node {
 	)
 
 	return html.Ol(
-		append([]g.Node{html.Class(classes)}, children...)...,
+		lib.MergeAttrs(append([]g.Node{html.Class(classes)}, children...), props.Attrs)...,
 	)
 }
 
@@ -77,7 +84,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	)
 
 	return html.Li(
-		append([]g.Node{html.Class(classes)}, children...)...,
+		lib.MergeAttrs(append([]g.Node{html.Class(classes)}, children...), props.Attrs)...,
 	)
 }
 
@@ -89,10 +96,10 @@ func BreadcrumbLink(props LinkProps, children ...g.Node) g.Node {
 	)
 
 	return html.A(
-		append([]g.Node{
+		lib.MergeAttrs(append([]g.Node{
 			html.Href(props.Href),
 			html.Class(classes),
-		}, children...)...,
+		}, children...), props.Attrs)...,
 	)
 }
 
@@ -104,12 +111,12 @@ func Page(props PageProps, children ...g.Node) g.Node {
 	)
 
 	return html.Span(
-		append([]g.Node{
+		lib.MergeAttrs(append([]g.Node{
 			html.Role("link"),
 			g.Attr("aria-disabled", "true"),
 			g.Attr("aria-current", "page"),
 			html.Class(classes),
-		}, children...)...,
+		}, children...), props.Attrs)...,
 	)
 }
 
@@ -129,11 +136,11 @@ func Separator(props SeparatorProps, children ...g.Node) g.Node {
 	}
 
 	return html.Li(
-		append([]g.Node{
+		lib.MergeAttrs(append([]g.Node{
 			html.Role("presentation"),
 			g.Attr("aria-hidden", "true"),
 			html.Class(classes),
-		}, content...)...,
+		}, content...), props.Attrs)...,
 	)
 }
 
@@ -144,13 +151,13 @@ func Ellipsis(props EllipsisProps) g.Node {
 		props.Class,
 	)
 
-	return html.Span(
+	return html.Span(lib.MergeAttrs([]g.Node{
 		html.Role("presentation"),
 		g.Attr("aria-hidden", "true"),
 		html.Class(classes),
 		icons.MoreHorizontal(html.Class("h-4 w-4")),
 		html.Span(html.Class("sr-only"), g.Text("More")),
-	)
+	}, props.Attrs)...)
 }
 
 // Example creates a basic breadcrumb example
@@ -7,6 +7,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/breadcrumb"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestBreadcrumb(t *testing.T) {
@@ -332,4 +333,12 @@ func TestBreadcrumbExampleCustomSeparator(t *testing.T) {
 			t.Error("should not have svg icons with custom separator")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":                breadcrumb.Example,
+		"ExampleCustomSeparator": breadcrumb.ExampleCustomSeparator,
+		"ExampleWithDropdown":    breadcrumb.ExampleWithDropdown,
+	})
+}
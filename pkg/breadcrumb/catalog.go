@@ -0,0 +1,29 @@
+package breadcrumb
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Breadcrumb",
+		Category:    "Navigation",
+		Description: "Navigation breadcrumbs",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Basic", Func: "DemoBasic", Render: DemoBasic},
+			{Name: "With Dropdown", Func: "DemoWithDropdown", Render: DemoWithDropdown},
+			{Name: "Custom Separator", Func: "DemoCustomSeparator", Render: DemoCustomSeparator},
+			{Name: "Responsive", Func: "DemoResponsive", Render: DemoResponsive},
+			{Name: "With Icons", Func: "DemoWithIcons", Render: DemoWithIcons},
+			{Name: "Long Path", Func: "DemoLongPath", Render: DemoLongPath},
+			{Name: "Styled Variants", Func: "DemoStyledVariants", Render: DemoStyledVariants},
+			{Name: "Route Tree", Func: "DemoRoutes", Render: DemoRoutes},
+		},
+	})
+}
@@ -1,6 +1,9 @@
 package breadcrumb
 
 import (
+	"net/http"
+	"net/url"
+
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 )
@@ -194,4 +197,37 @@ func DemoStyledVariants() g.Node {
 			),
 		),
 	)
-}
\ No newline at end of file
+}
+
+// DemoRoutes shows trails generated from a route tree, collapsing the middle
+// of the long one into a menu
+func DemoRoutes() g.Node {
+	routes := NewRoutes().
+		Add("/", "Home").
+		Add("/projects", "Projects").
+		AddFunc("/projects/{id}", func(r *http.Request, params map[string]string) (string, error) {
+			return map[string]string{"1": "Acme Website", "2": "Mobile App"}[params["id"]], nil
+		}).
+		Add("/projects/{id}/settings", "Settings").
+		Add("/projects/{id}/settings/members", "Members").
+		Add("/projects/{id}/settings/members/{member}", "")
+
+	trail := func(path string) g.Node {
+		crumbs, err := routes.Crumbs(&http.Request{URL: &url.URL{Path: path}})
+		if err != nil {
+			return g.Text(err.Error())
+		}
+		return html.Div(
+			html.Class("space-y-1"),
+			html.P(html.Class("font-mono text-xs text-muted-foreground"), g.Text(path)),
+			Trail(TrailProps{Crumbs: crumbs, JSONLD: true}),
+		)
+	}
+
+	return html.Div(
+		html.Class("space-y-4"),
+		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Generated from Routes")),
+		trail("/projects/2"),
+		trail("/projects/1/settings/members/ann"),
+	)
+}
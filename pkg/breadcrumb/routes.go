@@ -0,0 +1,341 @@
+package breadcrumb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/dropdownmenu"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// Crumb is an entry of a breadcrumb trail
+type Crumb struct {
+	Title string
+	Href  string
+}
+
+// Resolver returns the title of a route with dynamic segments, such as the
+// name of the project for /projects/{id}. params holds the value of each
+// {name} segment in the route's pattern.
+type Resolver func(r *http.Request, params map[string]string) (string, error)
+
+// Routes is a tree of URL patterns and their breadcrumb titles. Patterns
+// are paths whose segments are literals or {name} wildcards; a literal
+// segment wins over a wildcard at the same position.
+type Routes struct {
+	root routeNode
+}
+
+// routeNode is a segment of the route tree
+type routeNode struct {
+	segment    string // Literal segment, or "{name}" for a wildcard
+	title      string
+	resolve    Resolver
+	registered bool
+	children   []*routeNode
+}
+
+// NewRoutes creates an empty route tree
+func NewRoutes() *Routes {
+	return &Routes{}
+}
+
+// Add registers pattern with a static title. An empty title shows the
+// segment itself.
+func (rs *Routes) Add(pattern, title string) *Routes {
+	node := rs.node(pattern)
+	node.title = title
+	node.resolve = nil
+	return rs
+}
+
+// AddFunc registers pattern with a title resolved for each request
+func (rs *Routes) AddFunc(pattern string, resolve Resolver) *Routes {
+	node := rs.node(pattern)
+	node.title = ""
+	node.resolve = resolve
+	return rs
+}
+
+// node returns the node of pattern, creating it and its ancestors
+func (rs *Routes) node(pattern string) *routeNode {
+	node := &rs.root
+	for _, segment := range splitPath(pattern) {
+		var child *routeNode
+		for _, c := range node.children {
+			if c.segment == segment {
+				child = c
+				break
+			}
+		}
+		if child == nil {
+			child = &routeNode{segment: segment}
+			node.children = append(node.children, child)
+		}
+		node = child
+	}
+	node.registered = true
+	return node
+}
+
+// match returns the child of n matching segment, preferring literals
+func (n *routeNode) match(segment string) *routeNode {
+	var wildcard *routeNode
+	for _, c := range n.children {
+		if c.segment == segment {
+			return c
+		}
+		if wildcard == nil && isWildcard(c.segment) {
+			wildcard = c
+		}
+	}
+	return wildcard
+}
+
+// Crumbs returns the trail of r's path: a crumb for each prefix of the path
+// that matches a registered pattern. Prefixes without a pattern are skipped,
+// and matching stops at the first segment the tree has no route for.
+func (rs *Routes) Crumbs(r *http.Request) ([]Crumb, error) {
+	var crumbs []Crumb
+	params := map[string]string{}
+
+	// Routes registered without a title use fallback, the segment's value
+	add := func(node *routeNode, href, fallback string) error {
+		if !node.registered {
+			return nil
+		}
+		title := node.title
+		if node.resolve != nil {
+			resolved, err := node.resolve(r, params)
+			if err != nil {
+				return fmt.Errorf("breadcrumb: resolving %s: %w", href, err)
+			}
+			title = resolved
+		}
+		if title == "" {
+			title = fallback
+		}
+		crumbs = append(crumbs, Crumb{Title: title, Href: href})
+		return nil
+	}
+
+	if err := add(&rs.root, "/", "Home"); err != nil {
+		return nil, err
+	}
+
+	node := &rs.root
+	href := ""
+	for _, segment := range splitPath(r.URL.EscapedPath()) {
+		node = node.match(segment)
+		if node == nil {
+			break
+		}
+		href += "/" + segment
+
+		value, err := url.PathUnescape(segment)
+		if err != nil {
+			value = segment
+		}
+		if isWildcard(node.segment) {
+			params[strings.Trim(node.segment, "{}")] = value
+		}
+		if err := add(node, href, value); err != nil {
+			return nil, err
+		}
+	}
+	return crumbs, nil
+}
+
+// splitPath returns the non-empty segments of path
+func splitPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// isWildcard reports whether segment is a {name} wildcard
+func isWildcard(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// TrailProps defines the properties of a generated breadcrumb trail
+type TrailProps struct {
+	Crumbs    []Crumb  // Trail from the root to the current page
+	MaxItems  int      // Items shown before the middle of the trail collapses into a menu (default: 4; negative never collapses)
+	Separator g.Node   // Separator between items (default: a chevron)
+	JSONLD    bool     // Also emit BreadcrumbList structured data
+	BaseURL   string   // Scheme and host prefixed to the structured data URLs, e.g. "https://example.com"
+	Class     string   // Additional custom classes
+	Attrs     []g.Node // Additional attributes to pass through
+}
+
+// Trail renders a breadcrumb for crumbs, with the last crumb as the current
+// page. When there are more than MaxItems crumbs, the ones between the first
+// and the last few are collapsed into an ellipsis that opens a menu of them.
+func Trail(props TrailProps) g.Node {
+	if props.MaxItems == 0 {
+		props.MaxItems = 4
+	}
+	crumbs := props.Crumbs
+
+	// Keep the first crumb and the last MaxItems-2, leaving room for the ellipsis
+	var collapsed []Crumb
+	head, tail := crumbs, []Crumb(nil)
+	if props.MaxItems > 0 && len(crumbs) > max(props.MaxItems, 3) {
+		keep := max(props.MaxItems-2, 1)
+		head = crumbs[:1]
+		collapsed = crumbs[1 : len(crumbs)-keep]
+		tail = crumbs[len(crumbs)-keep:]
+	}
+
+	separator := func() g.Node {
+		if props.Separator != nil {
+			return Separator(SeparatorProps{}, props.Separator)
+		}
+		return Separator(SeparatorProps{})
+	}
+
+	var items []g.Node
+	last := len(crumbs) - 1
+	position := 0
+	appendCrumbs := func(list []Crumb) {
+		for _, crumb := range list {
+			if len(items) > 0 {
+				items = append(items, separator())
+			}
+			if position == last {
+				items = append(items, Item(ItemProps{}, Page(PageProps{}, g.Text(crumb.Title))))
+			} else {
+				items = append(items, Item(ItemProps{}, BreadcrumbLink(LinkProps{Href: crumb.Href}, g.Text(crumb.Title))))
+			}
+			position++
+		}
+	}
+
+	appendCrumbs(head)
+	if len(collapsed) > 0 {
+		items = append(items, separator(), Item(ItemProps{}, collapsedMenu(collapsed)))
+		position += len(collapsed)
+	}
+	appendCrumbs(tail)
+
+	return g.Group{
+		New(Props{Class: props.Class, Attrs: props.Attrs},
+			BreadcrumbList(ListProps{}, g.Group(items)),
+		),
+		g.If(len(collapsed) > 0, html.Script(g.Raw(breadcrumbScript))),
+		g.Iff(props.JSONLD, func() g.Node { return JSONLD(crumbs, props.BaseURL) }),
+	}
+}
+
+// collapsedMenu renders the ellipsis that opens a menu of the collapsed crumbs
+func collapsedMenu(crumbs []Crumb) g.Node {
+	return dropdownmenu.New(
+		dropdownmenu.Props{},
+		html.Button(
+			html.Type("button"),
+			html.Class("flex items-center gap-1 rounded-sm transition-colors hover:text-foreground focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring"),
+			g.Attr("aria-haspopup", "menu"),
+			g.Attr("aria-expanded", "false"),
+			g.Attr("data-breadcrumb-menu-trigger", ""),
+			Ellipsis(EllipsisProps{Class: "h-4 w-4"}),
+			html.Span(html.Class("sr-only"), g.Text("Toggle menu")),
+		),
+		dropdownmenu.DropdownContent(
+			dropdownmenu.ContentProps{
+				Class: "absolute left-0 mt-2",
+				Align: "start",
+				Attrs: []g.Node{
+					g.Attr("hidden"),
+					g.Attr("data-breadcrumb-menu", ""),
+				},
+			},
+			g.Group(g.Map(crumbs, func(crumb Crumb) g.Node {
+				return html.A(
+					html.Class(lib.CN(
+						"relative flex cursor-default select-none items-center gap-2 rounded-sm px-2 py-1.5 text-sm outline-none transition-colors",
+						"hover:bg-accent focus:bg-accent focus:text-accent-foreground",
+					)),
+					g.Attr("role", "menuitem"),
+					roving.Item(false),
+					html.Href(crumb.Href),
+					g.Text(crumb.Title),
+				)
+			})),
+		),
+	)
+}
+
+// JSONLD renders crumbs as schema.org BreadcrumbList structured data.
+// baseURL is prefixed to each crumb's Href to make it absolute.
+func JSONLD(crumbs []Crumb, baseURL string) g.Node {
+	type listItem struct {
+		Type     string `json:"@type"`
+		Position int    `json:"position"`
+		Name     string `json:"name"`
+		Item     string `json:"item,omitempty"`
+	}
+	items := make([]listItem, len(crumbs))
+	for i, crumb := range crumbs {
+		items[i] = listItem{
+			Type:     "ListItem",
+			Position: i + 1,
+			Name:     crumb.Title,
+			Item:     strings.TrimSuffix(baseURL, "/") + crumb.Href,
+		}
+	}
+
+	// json.Marshal escapes <, > and &, so the data can't close the script
+	data, _ := json.Marshal(struct {
+		Context string     `json:"@context"`
+		Type    string     `json:"@type"`
+		Items   []listItem `json:"itemListElement"`
+	}{"https://schema.org", "BreadcrumbList", items})
+
+	return html.Script(html.Type("application/ld+json"), g.Raw(string(data)))
+}
+
+// breadcrumbScript opens and closes the menus of collapsed crumbs. Arrow key
+// navigation of the menu items comes from the roving script.
+const breadcrumbScript = `
+(function() {
+	if (window.shadcnBreadcrumb) return;
+	window.shadcnBreadcrumb = true;
+
+	function setOpen(trigger, open) {
+		const menu = trigger.nextElementSibling;
+		menu.hidden = !open;
+		trigger.setAttribute('aria-expanded', String(open));
+		if (open) {
+			const first = menu.querySelector('[role="menuitem"]');
+			if (first) first.focus();
+		}
+	}
+
+	document.addEventListener('click', (e) => {
+		const trigger = e.target.closest('[data-breadcrumb-menu-trigger]');
+		document.querySelectorAll('[data-breadcrumb-menu-trigger][aria-expanded="true"]').forEach(open => {
+			if (open !== trigger && !open.nextElementSibling.contains(e.target)) setOpen(open, false);
+		});
+		if (trigger) setOpen(trigger, trigger.getAttribute('aria-expanded') !== 'true');
+	});
+	document.addEventListener('keydown', (e) => {
+		if (e.key !== 'Escape' || !e.target.closest) return;
+		const menu = e.target.closest('[data-breadcrumb-menu]');
+		if (!menu) return;
+		const trigger = menu.previousElementSibling;
+		setOpen(trigger, false);
+		trigger.focus();
+	});
+})();
+`
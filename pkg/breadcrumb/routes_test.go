@@ -0,0 +1,168 @@
+package breadcrumb_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/pkg/breadcrumb"
+)
+
+func testRoutes() *breadcrumb.Routes {
+	return breadcrumb.NewRoutes().
+		Add("/", "Home").
+		Add("/projects", "Projects").
+		AddFunc("/projects/{id}", func(r *http.Request, params map[string]string) (string, error) {
+			if params["id"] == "missing" {
+				return "", errors.New("not found")
+			}
+			return "Project " + params["id"], nil
+		}).
+		Add("/projects/new", "New project").
+		Add("/projects/{id}/settings", "Settings").
+		Add("/projects/{id}/settings/members/{member}", "")
+}
+
+func TestRoutesCrumbs(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want []breadcrumb.Crumb
+	}{
+		{
+			name: "root",
+			path: "/",
+			want: []breadcrumb.Crumb{{Title: "Home", Href: "/"}},
+		},
+		{
+			name: "dynamic segment",
+			path: "/projects/42",
+			want: []breadcrumb.Crumb{
+				{Title: "Home", Href: "/"},
+				{Title: "Projects", Href: "/projects"},
+				{Title: "Project 42", Href: "/projects/42"},
+			},
+		},
+		{
+			name: "literal wins over wildcard",
+			path: "/projects/new",
+			want: []breadcrumb.Crumb{
+				{Title: "Home", Href: "/"},
+				{Title: "Projects", Href: "/projects"},
+				{Title: "New project", Href: "/projects/new"},
+			},
+		},
+		{
+			name: "unregistered prefix is skipped",
+			path: "/projects/42/settings/members/ann%20lee",
+			want: []breadcrumb.Crumb{
+				{Title: "Home", Href: "/"},
+				{Title: "Projects", Href: "/projects"},
+				{Title: "Project 42", Href: "/projects/42"},
+				{Title: "Settings", Href: "/projects/42/settings"},
+				{Title: "ann lee", Href: "/projects/42/settings/members/ann%20lee"},
+			},
+		},
+		{
+			name: "unknown path stops matching",
+			path: "/projects/42/unknown/settings",
+			want: []breadcrumb.Crumb{
+				{Title: "Home", Href: "/"},
+				{Title: "Projects", Href: "/projects"},
+				{Title: "Project 42", Href: "/projects/42"},
+			},
+		},
+	}
+
+	routes := testRoutes()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := routes.Crumbs(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := routes.Crumbs(httptest.NewRequest("GET", "/projects/missing", nil)); err == nil {
+		t.Error("expected the resolver error to be returned")
+	}
+}
+
+func TestTrail(t *testing.T) {
+	crumbs := []breadcrumb.Crumb{
+		{Title: "Home", Href: "/"},
+		{Title: "Projects", Href: "/projects"},
+		{Title: "Acme", Href: "/projects/1"},
+		{Title: "Settings", Href: "/projects/1/settings"},
+		{Title: "Members", Href: "/projects/1/settings/members"},
+		{Title: "Ann", Href: "/projects/1/settings/members/ann"},
+	}
+
+	t.Run("collapses the middle", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := breadcrumb.Trail(breadcrumb.TrailProps{Crumbs: crumbs}).Render(&buf); err != nil {
+			t.Fatal(err)
+		}
+		result := buf.String()
+
+		for _, want := range []string{
+			`<a href="/" class="transition-colors hover:text-foreground">Home</a>`,
+			`data-breadcrumb-menu-trigger=""`,
+			`aria-haspopup="menu" aria-expanded="false"`,
+			`role="menu"`,
+			`data-breadcrumb-menu=""`,
+			`href="/projects">Projects</a>`,
+			`href="/projects/1/settings">Settings</a>`,
+			`<a href="/projects/1/settings/members" class="transition-colors hover:text-foreground">Members</a>`,
+			`aria-current="page" class="font-normal text-foreground">Ann</span>`,
+			`window.shadcnBreadcrumb`,
+		} {
+			if !strings.Contains(result, want) {
+				t.Errorf("expected output to contain %q, got %s", want, result)
+			}
+		}
+		if strings.Count(result, `role="menuitem" `) != 3 {
+			t.Errorf("expected 3 collapsed crumbs in the menu")
+		}
+		if strings.Contains(result, "application/ld+json") {
+			t.Error("expected no structured data unless requested")
+		}
+	})
+
+	t.Run("never collapses", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := breadcrumb.Trail(breadcrumb.TrailProps{Crumbs: crumbs, MaxItems: -1}).Render(&buf); err != nil {
+			t.Fatal(err)
+		}
+		result := buf.String()
+		if strings.Contains(result, "data-breadcrumb-menu") || strings.Count(result, `role="presentation"`) != 5 {
+			t.Errorf("expected all crumbs with separators and no menu, got %s", result)
+		}
+	})
+
+	t.Run("structured data", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := breadcrumb.Trail(breadcrumb.TrailProps{
+			Crumbs:  crumbs[:2],
+			JSONLD:  true,
+			BaseURL: "https://example.com/",
+		}).Render(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `<script type="application/ld+json">{"@context":"https://schema.org","@type":"BreadcrumbList","itemListElement":[` +
+			`{"@type":"ListItem","position":1,"name":"Home","item":"https://example.com/"},` +
+			`{"@type":"ListItem","position":2,"name":"Projects","item":"https://example.com/projects"}]}</script>`
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected structured data %s, got %s", want, buf.String())
+		}
+	})
+}
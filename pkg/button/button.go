@@ -11,8 +11,9 @@ type Props struct {
 	Variant  string // "default" | "destructive" | "outline" | "secondary" | "ghost" | "link"
 	Size     string // "default" | "sm" | "lg" | "icon"
 	Disabled bool
-	Type     string // "button" | "submit" | "reset"
-	Class    string // Additional custom classes
+	Type     string   // "button" | "submit" | "reset"
+	Class    string   // Additional custom classes
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // buttonVariants defines the variant configuration for buttons
@@ -48,26 +49,37 @@ func New(props Props, children ...g.Node) g.Node {
 	}
 
 	// Get variant classes
-	classes := buttonVariants.GetClasses(lib.VariantProps{
+	classes := buttonVariants.ClassAttr(lib.VariantProps{
 		Variant: props.Variant,
 		Size:    props.Size,
 		Class:   props.Class,
 	})
 
-	// Build attributes
-	attrs := []g.Node{
-		html.Class(classes),
-		html.Type(props.Type),
+	typ, ok := typeAttrs[props.Type]
+	if !ok {
+		typ = lib.Attr("type", props.Type)
 	}
 
+	// Build attributes
+	nodes := []g.Node{classes, typ, nil}
+
 	if props.Disabled {
-		attrs = append(attrs, html.Disabled())
+		nodes[2] = disabledAttr
 	}
 
 	// Combine attributes and children
-	return html.Button(append(attrs, children...)...)
+	return lib.Tag("button", nodes, children, props.Attrs)
 }
 
+// typeAttrs are the prerendered type attributes of buttons
+var typeAttrs = map[string]g.Node{
+	"button": lib.Static(html.Type("button")),
+	"submit": lib.Static(html.Type("submit")),
+	"reset":  lib.Static(html.Type("reset")),
+}
+
+var disabledAttr = lib.Static(html.Disabled())
+
 // Default creates a button with default variant
 func Default(children ...g.Node) g.Node {
 	return New(Props{}, children...)
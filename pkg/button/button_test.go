@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -163,4 +164,39 @@ func TestVariantHelpers(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestNewAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	btn := New(Props{
+		Variant: "outline",
+		Class:   "w-full",
+		Attrs: []g.Node{
+			g.Attr("class", "mt-2 w-full"),
+			g.Attr("id", "save"),
+			g.Attr("type", "submit"),
+			g.Attr("hx-post", "/save"),
+		},
+	}, g.Text("Save"))
+	if err := btn.Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	result := buf.String()
+	for _, attr := range []string{"class=", "id=", "type="} {
+		if n := strings.Count(result, " "+attr); n != 1 {
+			t.Errorf("Expected one %s attribute, got %d.\nGot: %s", attr, n, result)
+		}
+	}
+	for _, want := range []string{`w-full mt-2"`, `id="save"`, `type="submit"`, `hx-post="/save"`, `>Save</button>`} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Expected output to contain %q, but it didn't.\nGot: %s", want, result)
+		}
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
@@ -0,0 +1,36 @@
+package button
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Button",
+		Category:    "Forms",
+		Description: "Interactive button component",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Variant", Type: catalog.Enum, Default: "default", Options: []string{"default", "destructive", "outline", "secondary", "ghost", "link"}},
+			{Name: "Size", Type: catalog.Enum, Default: "default", Options: []string{"default", "sm", "lg"}},
+			{Name: "Disabled", Type: catalog.Bool, Default: "false"},
+			{Name: "Label", Default: "Button", Description: "Text of the button"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return New(Props{
+				Variant:  v.String("Variant"),
+				Size:     v.String("Size"),
+				Disabled: v.Bool("Disabled"),
+			}, g.Text(v.String("Label")))
+		},
+	})
+}
@@ -19,30 +19,33 @@ type Props struct {
 	MinDate   time.Time // Minimum selectable date
 	MaxDate   time.Time // Maximum selectable date
 	Class     string    // Additional custom classes
+	Attrs     []g.Node  // Additional attributes to pass through
 }
 
 // HeaderProps defines the properties for the CalendarHeader
 type HeaderProps struct {
 	Month         time.Time
-	ShowDropdowns bool   // Whether to show month/year dropdowns
+	ShowDropdowns bool // Whether to show month/year dropdowns
 	Class         string
+	Attrs         []g.Node // Additional attributes to pass through
 }
 
 // DayProps defines the properties for a calendar day
 type DayProps struct {
-	Date      time.Time
-	Selected  bool
-	Today     bool
-	Outside   bool // Day is outside the current month
-	Disabled  bool
-	Class     string
+	Date     time.Time
+	Selected bool
+	Today    bool
+	Outside  bool // Day is outside the current month
+	Disabled bool
+	Class    string
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Calendar component
 func New(props Props, children ...g.Node) g.Node {
 	// Set defaults
 	if props.Month.IsZero() {
-		props.Month = time.Now()
+		props.Month = lib.Now()
 	}
 	if !props.ShowDays {
 		props.ShowDays = true
@@ -53,12 +56,12 @@ func New(props Props, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		CalendarHeader(HeaderProps{Month: props.Month}),
 		CalendarGrid(props),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // CalendarHeader creates the calendar header with month/year
@@ -70,7 +73,7 @@ func CalendarHeader(props HeaderProps) g.Node {
 
 	monthYear := props.Month.Format("January 2006")
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Button(
 			html.Type("button"),
@@ -85,7 +88,7 @@ func CalendarHeader(props HeaderProps) g.Node {
 			g.Attr("aria-label", "Next month"),
 			icons.ChevronRight(html.Class("h-4 w-4")),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // CalendarGrid creates the calendar grid with days
@@ -152,7 +155,7 @@ func CalendarDays(props Props, startDate, endDate, firstDay, lastDay time.Time)
 			dayProps := DayProps{
 				Date:     date,
 				Selected: isSameDay(date, props.Value),
-				Today:    isSameDay(date, time.Now()),
+				Today:    isSameDay(date, lib.Now()),
 				Outside:  date.Before(firstDay) || date.After(lastDay),
 				Disabled: (!props.MinDate.IsZero() && date.Before(props.MinDate)) || 
 				         (!props.MaxDate.IsZero() && date.After(props.MaxDate)),
@@ -185,14 +188,14 @@ func CalendarDay(props DayProps) g.Node {
 		props.Class,
 	)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(classes),
 		g.Attr("aria-label", fmt.Sprintf("Select %s", props.Date.Format("January 2, 2006"))),
 		g.Attr("aria-selected", fmt.Sprintf("%t", props.Selected)),
 		g.If(props.Disabled, html.Disabled()),
 		g.Text(fmt.Sprintf("%d", props.Date.Day())),
-	)
+	}, props.Attrs)...)
 }
 
 // isSameDay checks if two dates are the same day
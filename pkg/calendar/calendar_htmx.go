@@ -11,6 +11,7 @@ import (
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the Calendar
@@ -25,7 +26,7 @@ type HTMXProps struct {
 func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	// Set defaults
 	if props.Month.IsZero() {
-		props.Month = time.Now()
+		props.Month = lib.Now()
 	}
 	if !props.ShowDays {
 		props.ShowDays = true
@@ -36,13 +37,13 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID),
 		html.Class(classes),
 		CalendarHeaderHTMX(HeaderProps{Month: props.Month}, htmxProps),
 		CalendarGridHTMX(props, htmxProps),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // CalendarHeaderHTMX creates an HTMX-enhanced calendar header
@@ -54,7 +55,7 @@ func CalendarHeaderHTMX(props HeaderProps, htmxProps HTMXProps) g.Node {
 
 	monthYear := props.Month.Format("January 2006")
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Button(
 			html.Type("button"),
@@ -77,7 +78,7 @@ func CalendarHeaderHTMX(props HeaderProps, htmxProps HTMXProps) g.Node {
 			hx.Swap("outerHTML"),
 			icons.ChevronRight(html.Class("h-4 w-4")),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // CalendarGridHTMX creates an HTMX-enhanced calendar grid
@@ -141,7 +142,7 @@ func CalendarDaysHTMX(props Props, htmxProps HTMXProps, startDate, endDate, firs
 			dayProps := DayProps{
 				Date:     date,
 				Selected: isSameDay(date, props.Value),
-				Today:    isSameDay(date, time.Now()),
+				Today:    isSameDay(date, lib.Now()),
 				Outside:  date.Before(firstDay) || date.After(lastDay),
 				Disabled: (!props.MinDate.IsZero() && date.Before(props.MinDate)) || 
 				         (!props.MaxDate.IsZero() && date.After(props.MaxDate)),
@@ -176,7 +177,7 @@ func CalendarDayHTMX(props DayProps, htmxProps HTMXProps) g.Node {
 
 	dateStr := props.Date.Format("2006-01-02")
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(classes),
 		g.Attr("aria-label", fmt.Sprintf("Select %s", props.Date.Format("January 2, 2006"))),
@@ -188,7 +189,7 @@ func CalendarDayHTMX(props DayProps, htmxProps HTMXProps) g.Node {
 			hx.Swap("outerHTML"),
 		})),
 		g.Text(fmt.Sprintf("%d", props.Date.Day())),
-	)
+	}, props.Attrs)...)
 }
 
 // getPrevMonth returns the previous month
@@ -246,7 +247,7 @@ func RenderCalendarDropdown(htmxProps HTMXProps, selectedDate time.Time) g.Node
 	}
 	
 	if calendarProps.Month.IsZero() {
-		calendarProps.Month = time.Now()
+		calendarProps.Month = lib.Now()
 	}
 
 	return html.Div(
@@ -314,17 +315,20 @@ func MonthYearPickerHTMX(htmxProps HTMXProps, currentMonth time.Time, class ...s
 	)
 }
 
-// CalendarHandlers creates HTTP handlers for calendar components
-func CalendarHandlers(mux *http.ServeMux) {
+// Handlers serves the calendar, date picker and month/year picker endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Basic calendar navigation
 	htmxProps := HTMXProps{
 		ID:           "calendar-example",
-		NavigatePath: "/api/calendar/navigate",
-		SelectPath:   "/api/calendar/select",
-		UpdatePath:   "/api/calendar/update",
+		NavigatePath: rt.Path("/api/calendar/navigate"),
+		SelectPath:   rt.Path("/api/calendar/select"),
+		UpdatePath:   rt.Path("/api/calendar/update"),
 	}
 
-	mux.HandleFunc("/api/calendar/navigate", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/calendar/navigate", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		monthStr := r.URL.Query().Get("month")
 		yearStr := r.URL.Query().Get("year")
 		
@@ -332,10 +336,10 @@ func CalendarHandlers(mux *http.ServeMux) {
 		year, _ := strconv.Atoi(yearStr)
 		
 		if month < 1 || month > 12 {
-			month = int(time.Now().Month())
+			month = int(lib.Now().Month())
 		}
 		if year < 1900 || year > 2100 {
-			year = time.Now().Year()
+			year = lib.Now().Year()
 		}
 		
 		selectedDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
@@ -346,13 +350,13 @@ func CalendarHandlers(mux *http.ServeMux) {
 		
 		node := NewHTMX(props, htmxProps)
 		node.Render(w)
-	})
+	}))
 
-	mux.HandleFunc("/api/calendar/select", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/calendar/select", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		dateStr := r.URL.Query().Get("date")
 		selectedDate, err := time.Parse("2006-01-02", dateStr)
 		if err != nil {
-			selectedDate = time.Now()
+			selectedDate = lib.Now()
 		}
 		
 		props := Props{
@@ -362,23 +366,23 @@ func CalendarHandlers(mux *http.ServeMux) {
 		
 		node := NewHTMX(props, htmxProps)
 		node.Render(w)
-	})
+	}))
 
 	// Date picker handlers
 	datePickerProps := HTMXProps{
 		ID:           "datepicker-example",
-		NavigatePath: "/api/datepicker/navigate",
-		SelectPath:   "/api/datepicker/select",
-		UpdatePath:   "/api/datepicker/show",
+		NavigatePath: rt.Path("/api/datepicker/navigate"),
+		SelectPath:   rt.Path("/api/datepicker/select"),
+		UpdatePath:   rt.Path("/api/datepicker/show"),
 	}
 
-	mux.HandleFunc("/api/datepicker/show", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/datepicker/show", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Show the calendar dropdown
-		node := RenderCalendarDropdown(datePickerProps, time.Now())
+		node := RenderCalendarDropdown(datePickerProps, lib.Now())
 		node.Render(w)
-	})
+	}))
 
-	mux.HandleFunc("/api/datepicker/select", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/datepicker/select", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		dateStr := r.URL.Query().Get("date")
 		selectedDate, _ := time.Parse("2006-01-02", dateStr)
 		
@@ -391,15 +395,15 @@ func CalendarHandlers(mux *http.ServeMux) {
 			`, datePickerProps.ID, selectedDate.Format("01/02/2006"), datePickerProps.ID))),
 		)
 		node.Render(w)
-	})
+	}))
 
 	// Month/Year picker handlers
 	monthYearProps := HTMXProps{
 		ID:         "monthyear-picker",
-		UpdatePath: "/api/monthyear/update",
+		UpdatePath: rt.Path("/api/monthyear/update"),
 	}
 
-	mux.HandleFunc("/api/monthyear/update", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/monthyear/update", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		monthStr := r.URL.Query().Get("month")
 		yearStr := r.URL.Query().Get("year")
 		
@@ -407,15 +411,22 @@ func CalendarHandlers(mux *http.ServeMux) {
 		year, _ := strconv.Atoi(yearStr)
 		
 		if month < 1 || month > 12 {
-			month = int(time.Now().Month())
+			month = int(lib.Now().Month())
 		}
 		if year < 1900 || year > 2100 {
-			year = time.Now().Year()
+			year = lib.Now().Year()
 		}
 		
 		selectedDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
 		
 		node := MonthYearPickerHTMX(monthYearProps, selectedDate)
 		node.Render(w)
-	})
+	}))
+}
+
+// CalendarHandlers creates HTTP handlers for calendar components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func CalendarHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
\ No newline at end of file
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -327,4 +328,20 @@ func TestIsSameDay(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.FixTime(t, time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC))
+
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":                Example,
+		"ExampleCustomStyling":   ExampleCustomStyling,
+		"ExampleDatePicker":      ExampleDatePicker,
+		"ExampleDateRange":       ExampleDateRange,
+		"ExampleHTMX":            ExampleHTMX,
+		"ExampleMonthYearPicker": ExampleMonthYearPicker,
+		"ExampleMultiMonth":      ExampleMultiMonth,
+		"ExampleWithMinMax":      ExampleWithMinMax,
+		"ExampleWithWeekNumbers": ExampleWithWeekNumbers,
+	})
+}
@@ -0,0 +1,29 @@
+package calendar
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Calendar",
+		Category:    "Data Display",
+		Description: "Date picker calendar",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+			{Name: "With Week Numbers", Func: "ExampleWithWeekNumbers", Render: ExampleWithWeekNumbers},
+			{Name: "Date Range", Func: "ExampleDateRange", Render: ExampleDateRange},
+			{Name: "With Min Max", Func: "ExampleWithMinMax", Render: ExampleWithMinMax},
+			{Name: "Date Picker", Func: "ExampleDatePicker", Render: ExampleDatePicker},
+			{Name: "Month Year Picker", Func: "ExampleMonthYearPicker", Render: ExampleMonthYearPicker},
+			{Name: "Multi Month", Func: "ExampleMultiMonth", Render: ExampleMultiMonth},
+			{Name: "Custom Styling", Func: "ExampleCustomStyling", Render: ExampleCustomStyling},
+		},
+	})
+}
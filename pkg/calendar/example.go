@@ -1,10 +1,10 @@
 package calendar
 
 import (
-	"time"
-
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
 )
 
 // Example creates a basic calendar example
@@ -12,8 +12,8 @@ func Example() g.Node {
 	return html.Div(
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Basic Calendar")),
 		New(Props{
-			Value: time.Now(),
-			Month: time.Now(),
+			Value: lib.Now(),
+			Month: lib.Now(),
 		}),
 	)
 }
@@ -23,8 +23,8 @@ func ExampleWithWeekNumbers() g.Node {
 	return html.Div(
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Calendar with Week Numbers")),
 		New(Props{
-			Value:     time.Now(),
-			Month:     time.Now(),
+			Value:     lib.Now(),
+			Month:     lib.Now(),
 			ShowWeeks: true,
 		}),
 	)
@@ -32,26 +32,26 @@ func ExampleWithWeekNumbers() g.Node {
 
 // ExampleDateRange creates a calendar showing a date range
 func ExampleDateRange() g.Node {
-	startDate := time.Now()
+	startDate := lib.Now()
 	endDate := startDate.AddDate(0, 0, 7) // 7 days later
 
 	return html.Div(
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Date Range Calendar")),
-		DateRangeCalendar(startDate, endDate, time.Now()),
+		DateRangeCalendar(startDate, endDate, lib.Now()),
 	)
 }
 
 // ExampleWithMinMax creates a calendar with min/max date constraints
 func ExampleWithMinMax() g.Node {
-	minDate := time.Now().AddDate(0, 0, -7)  // 7 days ago
-	maxDate := time.Now().AddDate(0, 0, 14) // 14 days from now
+	minDate := lib.Now().AddDate(0, 0, -7)  // 7 days ago
+	maxDate := lib.Now().AddDate(0, 0, 14) // 14 days from now
 
 	return html.Div(
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Calendar with Date Constraints")),
 		html.P(html.Class("text-sm text-muted-foreground mb-2"), g.Text("Only dates within ±7-14 days from today are selectable")),
 		New(Props{
-			Value:   time.Now(),
-			Month:   time.Now(),
+			Value:   lib.Now(),
+			Month:   lib.Now(),
 			MinDate: minDate,
 			MaxDate: maxDate,
 		}),
@@ -71,8 +71,8 @@ func ExampleHTMX() g.Node {
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Interactive Calendar")),
 		html.P(html.Class("text-sm text-muted-foreground mb-2"), g.Text("Click dates and navigation arrows to interact")),
 		NewHTMX(Props{
-			Value: time.Now(),
-			Month: time.Now(),
+			Value: lib.Now(),
+			Month: lib.Now(),
 		}, htmxProps),
 	)
 }
@@ -102,24 +102,24 @@ func ExampleMonthYearPicker() g.Node {
 
 	return html.Div(
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Month & Year Picker")),
-		MonthYearPickerHTMX(htmxProps, time.Now()),
+		MonthYearPickerHTMX(htmxProps, lib.Now()),
 	)
 }
 
 // ExampleMultiMonth creates a multi-month calendar view
 func ExampleMultiMonth() g.Node {
-	currentMonth := time.Now()
+	currentMonth := lib.Now()
 	nextMonth := currentMonth.AddDate(0, 1, 0)
 
 	return html.Div(
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Multi-Month View")),
 		html.Div(html.Class("grid grid-cols-1 md:grid-cols-2 gap-4"),
 			New(Props{
-				Value: time.Now(),
+				Value: lib.Now(),
 				Month: currentMonth,
 			}),
 			New(Props{
-				Value: time.Now(),
+				Value: lib.Now(),
 				Month: nextMonth,
 			}),
 		),
@@ -131,8 +131,8 @@ func ExampleCustomStyling() g.Node {
 	return html.Div(
 		html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Custom Styled Calendar")),
 		New(Props{
-			Value: time.Now(),
-			Month: time.Now(),
+			Value: lib.Now(),
+			Month: lib.Now(),
 			Class: "bg-slate-900 text-slate-50 border-slate-800",
 		}),
 	)
@@ -67,7 +67,8 @@ func CardAction(children ...g.Node) g.Node {
 type Props struct {
 	Title       string
 	Description string
-	Class       string // Additional custom classes
+	Class       string   // Additional custom classes
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // New creates a card with the given props and content
@@ -83,11 +84,11 @@ func New(props Props, content ...g.Node) g.Node {
 
 	classes := lib.CN("bg-card text-card-foreground flex flex-col gap-6 rounded-xl border py-6 shadow-sm", props.Class)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.If(len(headerContent) > 0, CardHeader(headerContent...)),
 		g.If(len(content) > 0, CardContent(content...)),
-	)
+	}, props.Attrs)...)
 }
 
 // WithFooter creates a card with header, content, and footer sections
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestCard(t *testing.T) {
@@ -203,4 +204,10 @@ func TestHelpers(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Examples": Examples,
+	})
+}
@@ -0,0 +1,22 @@
+package card
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Card",
+		Category:    "Layout",
+		Description: "Displays content in a card container",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Examples", Render: Examples},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib"
 )
 
 // Option is a functional option for configuring a carousel
@@ -21,6 +22,7 @@ type config struct {
 	showControls    bool
 	align           string // start, center, end
 	slidesToScroll  int
+	attrs           []g.Node // additional attributes for the root element
 }
 
 // New creates a new carousel component
@@ -43,7 +45,7 @@ func New(slides []g.Node, opts ...Option) g.Node {
 
 	classes := strings.TrimSpace("relative " + cfg.class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-carousel", "true"),
 		g.Attr("data-orientation", cfg.orientation),
@@ -67,7 +69,7 @@ func New(slides []g.Node, opts ...Option) g.Node {
 		g.If(cfg.showIndicators,
 			Indicators(len(slides), cfg),
 		),
-	)
+	}, cfg.attrs)...)
 }
 
 // Content creates the carousel content container
@@ -263,4 +265,11 @@ func WithSlidesToScroll(count int) Option {
 	return func(c *config) {
 		c.slidesToScroll = count
 	}
-}
\ No newline at end of file
+}
+
+// WithAttrs passes additional attributes to the root element
+func WithAttrs(attrs ...g.Node) Option {
+	return func(c *config) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
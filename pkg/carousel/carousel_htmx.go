@@ -6,6 +6,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib"
 )
 
 // HTMXConfig provides HTMX-specific configuration
@@ -54,7 +55,7 @@ func NewHTMX(id string, slides []g.Node, opts ...HTMXOption) g.Node {
 
 	classes := strings.TrimSpace("relative " + cfg.class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(id),
 		html.Class(classes),
 		g.Attr("data-carousel", "htmx"),
@@ -89,7 +90,7 @@ func NewHTMX(id string, slides []g.Node, opts ...HTMXOption) g.Node {
 		g.If(cfg.showIndicators,
 			HTMXIndicators(id, len(slides), cfg),
 		),
-	)
+	}, cfg.attrs)...)
 }
 
 // HTMXContent creates the HTMX carousel content container
@@ -284,6 +285,13 @@ func WithHTMXClass(class string) HTMXOption {
 	}
 }
 
+// WithHTMXAttrs passes additional attributes to the root element
+func WithHTMXAttrs(attrs ...g.Node) HTMXOption {
+	return func(c *HTMXConfig) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
 // WithHTMXOrientation sets the carousel orientation
 func WithHTMXOrientation(orientation string) HTMXOption {
 	return func(c *HTMXConfig) {
@@ -7,6 +7,7 @@ import (
 	"github.com/rizome-dev/shadcn-gomponents/pkg/carousel"
 	g "maragu.dev/gomponents"
 	h "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -448,4 +449,52 @@ func render(node g.Node) string {
 	var sb strings.Builder
 	_ = node.Render(&sb)
 	return sb.String()
-}
\ No newline at end of file
+}
+
+func TestAttrs(t *testing.T) {
+	slides := []g.Node{carousel.Item(h.Div(g.Text("Slide")))}
+	tests := []struct {
+		name     string
+		node     g.Node
+		contains []string
+	}{
+		{
+			name: "static",
+			node: carousel.New(slides,
+				carousel.WithClass("w-64"),
+				carousel.WithAttrs(h.Class("mx-auto"), g.Attr("data-testid", "hero")),
+			),
+			contains: []string{`class="relative w-64 mx-auto"`, `data-testid="hero"`},
+		},
+		{
+			name: "htmx",
+			node: carousel.NewHTMX("hero", slides,
+				carousel.WithHTMXAttrs(h.Class("mx-auto"), g.Attr("aria-label", "Featured")),
+			),
+			contains: []string{`id="hero" class="relative mx-auto"`, `aria-label="Featured"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(tt.node)
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected %q in %s", want, html)
+				}
+			}
+		})
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"ExampleAutoPlay":      carousel.ExampleAutoPlay,
+		"ExampleBasic":         carousel.ExampleBasic,
+		"ExampleCustomStyling": carousel.ExampleCustomStyling,
+		"ExampleHTMX":          carousel.ExampleHTMX,
+		"ExampleMultipleItems": carousel.ExampleMultipleItems,
+		"ExampleVertical":      carousel.ExampleVertical,
+		"ExampleWithLoop":      carousel.ExampleWithLoop,
+	})
+}
@@ -0,0 +1,27 @@
+package carousel
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Carousel",
+		Category:    "Display",
+		Description: "Image/content carousel",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Basic", Func: "ExampleBasic", Render: ExampleBasic},
+			{Name: "With Loop", Func: "ExampleWithLoop", Render: ExampleWithLoop},
+			{Name: "Auto Play", Func: "ExampleAutoPlay", Render: ExampleAutoPlay},
+			{Name: "Vertical", Func: "ExampleVertical", Render: ExampleVertical},
+			{Name: "Multiple Items", Func: "ExampleMultipleItems", Render: ExampleMultipleItems},
+			{Name: "Custom Styling", Func: "ExampleCustomStyling", Render: ExampleCustomStyling},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package chart
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Chart",
+		Category:    "Data Display",
+		Description: "Data visualization charts",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Line Chart", Func: "ExampleLineChart", Render: ExampleLineChart},
+			{Name: "Bar Chart", Func: "ExampleBarChart", Render: ExampleBarChart},
+			{Name: "Area Chart", Func: "ExampleAreaChart", Render: ExampleAreaChart},
+			{Name: "Pie Chart", Func: "ExamplePieChart", Render: ExamplePieChart},
+			{Name: "Donut Chart", Func: "ExampleDonutChart", Render: ExampleDonutChart},
+			{Name: "Minimal Chart", Func: "ExampleMinimalChart", Render: ExampleMinimalChart},
+			{Name: "Dark Theme Chart", Func: "ExampleDarkThemeChart", Render: ExampleDarkThemeChart},
+			{Name: "Multiple Charts", Func: "ExampleMultipleCharts", Render: ExampleMultipleCharts},
+		},
+	})
+}
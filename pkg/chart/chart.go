@@ -6,6 +6,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib"
 )
 
 // ChartType represents the type of chart
@@ -50,6 +51,7 @@ type config struct {
 	responsive  bool
 	animations  bool
 	theme       string // light or dark
+	attrs       []g.Node // additional attributes for the root element
 }
 
 // New creates a new chart component with server-side rendering
@@ -73,7 +75,7 @@ func New(id string, data ChartData, opts ...Option) g.Node {
 
 	classes := strings.TrimSpace("relative " + cfg.class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-chart", "static"),
 		g.Attr("data-chart-type", string(cfg.chartType)),
@@ -81,7 +83,7 @@ func New(id string, data ChartData, opts ...Option) g.Node {
 		
 		// Chart container with server-rendered content
 		ServerRenderedContainer(id, data, cfg),
-	)
+	}, cfg.attrs)...)
 }
 
 // Container creates the chart container
@@ -223,6 +225,13 @@ func WithClass(class string) Option{
 	}
 }
 
+// WithAttrs passes additional attributes to the root element
+func WithAttrs(attrs ...g.Node) Option{
+	return func(c *config) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
 // WithHeight sets the chart height
 func WithHeight(height string) Option{
 	return func(c *config) {
@@ -8,6 +8,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	h "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib"
 )
 
 // HTMXConfig provides HTMX-specific configuration
@@ -52,7 +53,7 @@ func NewHTMX(id string, data ChartData, opts ...HTMXOption) g.Node {
 	// Convert data to JSON for embedding
 	dataJSON, _ := json.Marshal(data)
 	
-	return h.Div(
+	return h.Div(lib.MergeAttrs([]g.Node{
 		h.ID(id+"-wrapper"),
 		h.Class(classes),
 		g.Attr("data-chart", "htmx"),
@@ -79,7 +80,7 @@ func NewHTMX(id string, data ChartData, opts ...HTMXOption) g.Node {
 		g.If(cfg.pollInterval == 0,
 			HTMXControls(id, cfg),
 		),
-	)
+	}, cfg.attrs)...)
 }
 
 // HTMXContainer creates the HTMX chart container
@@ -584,6 +585,13 @@ func WithHTMXClass(class string) HTMXOption {
 	}
 }
 
+// WithHTMXAttrs passes additional attributes to the root element
+func WithHTMXAttrs(attrs ...g.Node) HTMXOption {
+	return func(c *HTMXConfig) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
 // WithHTMXHeight sets the chart height
 func WithHTMXHeight(height string) HTMXOption {
 	return func(c *HTMXConfig) {
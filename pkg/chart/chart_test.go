@@ -6,6 +6,7 @@ import (
 
 	"github.com/rizome-dev/shadcn-gomponents/pkg/chart"
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -430,4 +431,58 @@ func render(node g.Node) string {
 	var sb strings.Builder
 	_ = node.Render(&sb)
 	return sb.String()
-}
\ No newline at end of file
+}
+
+func TestAttrs(t *testing.T) {
+	data := chart.ChartData{
+		Labels: []string{"A", "B"},
+		Series: []chart.SeriesData{{Name: "Test", Data: []float64{10, 20}}},
+	}
+	tests := []struct {
+		name     string
+		node     g.Node
+		contains []string
+	}{
+		{
+			name: "static",
+			node: chart.New("sales", data,
+				chart.WithClass("p-4"),
+				chart.WithAttrs(g.Attr("class", "mt-2"), g.Attr("data-testid", "sales")),
+			),
+			contains: []string{`class="relative p-4 mt-2"`, `data-testid="sales"`},
+		},
+		{
+			name: "htmx",
+			node: chart.NewHTMX("sales", data,
+				chart.WithHTMXAttrs(g.Attr("class", "mt-2"), g.Attr("data-testid", "sales")),
+			),
+			contains: []string{`id="sales-wrapper" class="relative mt-2"`, `data-testid="sales"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(tt.node)
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected %q in %s", want, html)
+				}
+			}
+		})
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"ExampleAreaChart":            chart.ExampleAreaChart,
+		"ExampleBarChart":             chart.ExampleBarChart,
+		"ExampleDarkThemeChart":       chart.ExampleDarkThemeChart,
+		"ExampleDonutChart":           chart.ExampleDonutChart,
+		"ExampleHTMXChart":            chart.ExampleHTMXChart,
+		"ExampleHTMXInteractiveChart": chart.ExampleHTMXInteractiveChart,
+		"ExampleLineChart":            chart.ExampleLineChart,
+		"ExampleMinimalChart":         chart.ExampleMinimalChart,
+		"ExampleMultipleCharts":       chart.ExampleMultipleCharts,
+		"ExamplePieChart":             chart.ExamplePieChart,
+	})
+}
@@ -0,0 +1,42 @@
+package checkbox
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Checkbox",
+		Category:    "Forms",
+		Description: "Checkbox input component",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Checked", Type: catalog.Bool, Default: "false"},
+			{Name: "Indeterminate", Type: catalog.Bool, Default: "false"},
+			{Name: "Disabled", Type: catalog.Bool, Default: "false"},
+			{Name: "Label", Default: "Accept terms and conditions"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return html.Div(
+				html.Class("flex items-center gap-2"),
+				New(Props{
+					ID:            "playground-checkbox",
+					Checked:       v.Bool("Checked"),
+					Indeterminate: v.Bool("Indeterminate"),
+					Disabled:      v.Bool("Disabled"),
+				}),
+				html.Label(html.For("playground-checkbox"), html.Class("text-sm font-medium"), g.Text(v.String("Label"))),
+			)
+		},
+	})
+}
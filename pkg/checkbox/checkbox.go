@@ -8,15 +8,16 @@ import (
 
 // Props defines the properties for the Checkbox component
 type Props struct {
-	ID             string // HTML id attribute
-	Name           string // Form field name
-	Value          string // Form field value
-	Checked        bool   // Whether the checkbox is checked
-	Indeterminate  bool   // Whether the checkbox is in indeterminate state
-	Disabled       bool   // Whether the checkbox is disabled
-	Required       bool   // Whether the checkbox is required
-	Class          string // Additional custom classes
-	OnChange       string // JavaScript onChange handler
+	ID            string   // HTML id attribute
+	Name          string   // Form field name
+	Value         string   // Form field value
+	Checked       bool     // Whether the checkbox is checked
+	Indeterminate bool     // Whether the checkbox is in indeterminate state
+	Disabled      bool     // Whether the checkbox is disabled
+	Required      bool     // Whether the checkbox is required
+	Class         string   // Additional custom classes
+	OnChange      string   // JavaScript onChange handler
+	Attrs         []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Checkbox component
@@ -98,7 +99,7 @@ func New(props Props) g.Node {
 		divAttrs = append(divAttrs, renderCheckIcon(props.Indeterminate))
 	}
 	
-	return html.Div(divAttrs...)
+	return html.Div(lib.MergeAttrs(divAttrs, props.Attrs)...)
 }
 
 // Default creates a checkbox with default settings
@@ -6,6 +6,7 @@ import (
 	"testing"
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/checkbox"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestCheckbox(t *testing.T) {
@@ -170,4 +171,10 @@ func TestCheckbox(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": checkbox.Example,
+	})
+}
@@ -0,0 +1,27 @@
+package collapsible
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Collapsible",
+		Category:    "Display",
+		Description: "Collapsible content section",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Basic", Func: "DemoBasic", Render: DemoBasic},
+			{Name: "Closed", Func: "DemoClosed", Render: DemoClosed},
+			{Name: "Styled", Func: "DemoStyled", Render: DemoStyled},
+			{Name: "Multiple", Func: "DemoMultiple", Render: DemoMultiple},
+			{Name: "Nested", Func: "DemoNested", Render: DemoNested},
+			{Name: "With Form", Func: "DemoWithForm", Render: DemoWithForm},
+		},
+	})
+}
@@ -11,18 +11,21 @@ import (
 
 // Props defines the properties for the Collapsible component
 type Props struct {
-	Open  bool   // Whether the collapsible is open
-	Class string // Additional custom classes
+	Open  bool     // Whether the collapsible is open
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines the properties for the CollapsibleTrigger
 type TriggerProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines the properties for the CollapsibleContent
 type ContentProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Collapsible component using HTML details/summary elements
@@ -57,10 +60,10 @@ func New(props Props, children ...g.Node) g.Node {
 	}
 
 	return g.El("details",
-		append(attrs,
+		lib.MergeAttrs(append(attrs,
 			g.El("summary", summaryNodes...),
 			g.Group(contentNodes),
-		)...,
+		), props.Attrs)...,
 	)
 }
 
@@ -87,11 +90,11 @@ func DivCollapsible(props Props, children ...g.Node) g.Node {
 		dataState = "open"
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-state", dataState),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a CollapsibleTrigger component
@@ -101,12 +104,12 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("role", "button"),
 		g.Attr("tabindex", "0"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // CollapsibleContent creates a CollapsibleContent component
@@ -116,11 +119,11 @@ func CollapsibleContent(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-state", "closed"), // Default state, should be controlled by JavaScript
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // TriggerButton creates a trigger button with chevron icon
@@ -137,12 +140,12 @@ func TriggerButton(props TriggerProps, isOpen bool) g.Node {
 		lib.CNIf(isOpen, "transform rotate-180", ""),
 	)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(classes),
 		icons.ChevronsUpDown(html.Class(iconClasses)),
 		html.Span(html.Class("sr-only"), g.Text("Toggle")),
-	)
+	}, props.Attrs)...)
 }
 
 // Example creates a basic collapsible example using details/summary
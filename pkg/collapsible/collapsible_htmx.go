@@ -3,13 +3,13 @@ package collapsible
 import (
 	"fmt"
 	"net/http"
-	"strings"
 	
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the Collapsible
@@ -22,14 +22,14 @@ type HTMXProps struct {
 func NewHTMX(props Props, htmxProps HTMXProps, trigger g.Node, content g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID),
 		g.If(classes != "", html.Class(classes)),
 		g.If(props.Open, g.Attr("data-state", "open")),
 		g.If(!props.Open, g.Attr("data-state", "closed")),
 		trigger,
 		g.If(props.Open, content),
-	)
+	}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger
@@ -39,7 +39,7 @@ func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, isOpen bool, children
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("role", "button"),
 		g.Attr("tabindex", "0"),
@@ -48,7 +48,7 @@ func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, isOpen bool, children
 		hx.Swap("outerHTML"),
 		hx.Vals(fmt.Sprintf(`{"open": "%t"}`, !isOpen)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ContentHTMX creates HTMX-enhanced collapsible content
@@ -59,15 +59,15 @@ func ContentHTMX(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // TriggerButtonHTMX creates an HTMX-enhanced trigger button with icon
 func TriggerButtonHTMX(props TriggerProps, htmxProps HTMXProps, isOpen bool) g.Node {
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		html.Class(lib.CN(
 			"flex items-center justify-between gap-4 px-4 py-2 font-medium transition-all hover:bg-accent",
@@ -85,7 +85,7 @@ func TriggerButtonHTMX(props TriggerProps, htmxProps HTMXProps, isOpen bool) g.N
 				map[bool]string{true: "rotate-180", false: ""}[isOpen],
 			)),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // ExampleHTMX creates an HTMX-enhanced collapsible example
@@ -219,16 +219,19 @@ func FAQExampleHTMX() g.Node {
 	)
 }
 
-// CollapsibleHandlers creates HTTP handlers for collapsible components
-func CollapsibleHandlers(mux *http.ServeMux) {
+// Handlers serves the collapsible and accordion endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Basic collapsible toggle handler
-	mux.HandleFunc("/api/collapsible/toggle", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/collapsible/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		isOpen := r.FormValue("open") == "true"
 		
 		htmxProps := HTMXProps{
 			ID:         "collapsible-example",
-			TogglePath: "/api/collapsible/toggle",
+			TogglePath: rt.Path("/api/collapsible/toggle"),
 		}
 		
 		// Render the collapsible with new state
@@ -251,20 +254,12 @@ func CollapsibleHandlers(mux *http.ServeMux) {
 		)
 		
 		node.Render(w)
-	})
+	}))
 	
 	// Accordion handlers
-	mux.HandleFunc("/api/accordion/", func(w http.ResponseWriter, r *http.Request) {
-		// Parse accordion ID and item ID from path
-		// Format: /api/accordion/{accordionID}/{itemID}/toggle
-		parts := strings.Split(r.URL.Path, "/")
-		if len(parts) < 6 {
-			http.Error(w, "Invalid path", http.StatusBadRequest)
-			return
-		}
-		
-		accordionID := parts[3]
-		itemID := parts[4]
+	rt.Handle(http.MethodPost, "/api/accordion/{accordion}/{item}/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accordionID := r.PathValue("accordion")
+		itemID := r.PathValue("item")
 		
 		r.ParseForm()
 		isOpen := r.FormValue("open") == "true"
@@ -309,5 +304,12 @@ func CollapsibleHandlers(mux *http.ServeMux) {
 		
 		node := RenderAccordionItem(htmxProps, item)
 		node.Render(w)
-	})
+	}))
+}
+
+// CollapsibleHandlers creates HTTP handlers for collapsible components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func CollapsibleHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
\ No newline at end of file
@@ -7,6 +7,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/collapsible"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestCollapsible(t *testing.T) {
@@ -362,4 +363,13 @@ func TestExampleStyled(t *testing.T) {
 			t.Error("expected package emoji")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":       collapsible.Example,
+		"ExampleClosed": collapsible.ExampleClosed,
+		"ExampleHTMX":   collapsible.ExampleHTMX,
+		"ExampleStyled": collapsible.ExampleStyled,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/popover"
 )
@@ -22,18 +23,19 @@ type Option struct {
 
 // Props defines the properties for the Combobox component
 type Props struct {
-	ID          string   // ID for the input field
-	Name        string   // Name for form submission
-	Value       string   // Currently selected value
-	Options     []Option // Available options
-	Placeholder string   // Placeholder text when no value selected
-	SearchPlaceholder string // Placeholder for search input
-	EmptyText   string   // Text to show when no options match
-	Open        bool     // Whether the popover is open
-	Disabled    bool     // Whether the combobox is disabled
-	Class       string   // Additional CSS classes
-	Width       string   // Width of the combobox (e.g., "200px", "w-full")
-	OnSelect    string   // JavaScript to run on selection
+	ID                string   // ID for the input field
+	Name              string   // Name for form submission
+	Value             string   // Currently selected value
+	Options           []Option // Available options
+	Placeholder       string   // Placeholder text when no value selected
+	SearchPlaceholder string   // Placeholder for search input
+	EmptyText         string   // Text to show when no options match
+	Open              bool     // Whether the popover is open
+	Disabled          bool     // Whether the combobox is disabled
+	Class             string   // Additional CSS classes
+	Width             string   // Width of the combobox (e.g., "200px", "w-full")
+	OnSelect          string   // JavaScript to run on selection
+	Attrs             []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Combobox component
@@ -68,6 +70,7 @@ func New(props Props) g.Node {
 		popover.Props{
 			Open:  props.Open,
 			Class: props.Class,
+			Attrs: props.Attrs,
 		},
 		// Trigger button
 		popover.Trigger(
@@ -111,6 +114,8 @@ func New(props Props) g.Node {
 			},
 			html.Div(
 				html.Class("max-h-[300px] overflow-auto"),
+				roving.Group(roving.Props{Virtual: true}),
+				roving.Script(),
 				// Search input
 				html.Div(
 					html.Class("flex items-center border-b px-3"),
@@ -135,6 +140,7 @@ func New(props Props) g.Node {
 						isSelected := opt.Value == props.Value
 						return html.Div(
 							g.Attr("role", "option"),
+							roving.VirtualItem(),
 							g.Attr("aria-selected", func() string {
 								if isSelected {
 									return "true"
@@ -144,6 +150,7 @@ func New(props Props) g.Node {
 							html.Class(lib.CN(
 								"relative flex cursor-default select-none items-center rounded-sm px-2 py-1.5 text-sm outline-none",
 								"hover:bg-accent hover:text-accent-foreground",
+								"data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground",
 								"data-[disabled=true]:pointer-events-none data-[disabled=true]:opacity-50",
 								func() string {
 									if isSelected {
@@ -172,19 +179,20 @@ func New(props Props) g.Node {
 
 // MultiProps defines properties for a multi-select combobox
 type MultiProps struct {
-	ID          string   // ID for the input field
-	Name        string   // Name for form submission
-	Values      []string // Currently selected values
-	Options     []Option // Available options
-	Placeholder string   // Placeholder text when no values selected
-	SearchPlaceholder string // Placeholder for search input
-	EmptyText   string   // Text to show when no options match
-	MaxItems    int      // Maximum number of items that can be selected (0 = unlimited)
-	Open        bool     // Whether the popover is open
-	Disabled    bool     // Whether the combobox is disabled
-	Class       string   // Additional CSS classes
-	Width       string   // Width of the combobox
-	OnSelect    string   // JavaScript to run on selection
+	ID                string   // ID for the input field
+	Name              string   // Name for form submission
+	Values            []string // Currently selected values
+	Options           []Option // Available options
+	Placeholder       string   // Placeholder text when no values selected
+	SearchPlaceholder string   // Placeholder for search input
+	EmptyText         string   // Text to show when no options match
+	MaxItems          int      // Maximum number of items that can be selected (0 = unlimited)
+	Open              bool     // Whether the popover is open
+	Disabled          bool     // Whether the combobox is disabled
+	Class             string   // Additional CSS classes
+	Width             string   // Width of the combobox
+	OnSelect          string   // JavaScript to run on selection
+	Attrs             []g.Node // Additional attributes to pass through
 }
 
 // Multi creates a multi-select combobox
@@ -236,6 +244,7 @@ func Multi(props MultiProps) g.Node {
 		popover.Props{
 			Open:  props.Open,
 			Class: props.Class,
+			Attrs: props.Attrs,
 		},
 		// Trigger button
 		popover.Trigger(
@@ -279,6 +288,8 @@ func Multi(props MultiProps) g.Node {
 			},
 			html.Div(
 				html.Class("max-h-[300px] overflow-auto"),
+				roving.Group(roving.Props{Virtual: true}),
+				roving.Script(),
 				// Search input
 				html.Div(
 					html.Class("flex items-center border-b px-3"),
@@ -305,6 +316,7 @@ func Multi(props MultiProps) g.Node {
 						
 						return html.Div(
 							g.Attr("role", "option"),
+							roving.VirtualItem(),
 							g.Attr("aria-selected", func() string {
 								if selected {
 									return "true"
@@ -314,6 +326,7 @@ func Multi(props MultiProps) g.Node {
 							html.Class(lib.CN(
 								"relative flex cursor-default select-none items-center rounded-sm px-2 py-1.5 text-sm outline-none",
 								"hover:bg-accent hover:text-accent-foreground",
+								"data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground",
 								"data-[disabled=true]:pointer-events-none data-[disabled=true]:opacity-50",
 								func() string {
 									if selected {
@@ -377,6 +390,7 @@ func WithGroups(props GroupedProps) g.Node {
 		popover.Props{
 			Open:  props.Open,
 			Class: props.Class,
+			Attrs: props.Attrs,
 		},
 		// Trigger button
 		popover.Trigger(
@@ -420,6 +434,8 @@ func WithGroups(props GroupedProps) g.Node {
 			},
 			html.Div(
 				html.Class("max-h-[300px] overflow-auto"),
+				roving.Group(roving.Props{Virtual: true}),
+				roving.Script(),
 				// Search input
 				html.Div(
 					html.Class("flex items-center border-b px-3"),
@@ -442,6 +458,8 @@ func WithGroups(props GroupedProps) g.Node {
 					),
 					g.Group(g.Map(props.Groups, func(group OptionGroup) g.Node {
 						return html.Div(
+							g.Attr("role", "group"),
+							g.Attr("aria-label", group.Label),
 							// Group label
 							html.Div(
 								html.Class("px-2 py-1.5 text-xs font-semibold text-muted-foreground"),
@@ -452,6 +470,7 @@ func WithGroups(props GroupedProps) g.Node {
 								isSelected := opt.Value == props.Value
 								return html.Div(
 									g.Attr("role", "option"),
+									roving.VirtualItem(),
 									g.Attr("aria-selected", func() string {
 										if isSelected {
 											return "true"
@@ -461,6 +480,7 @@ func WithGroups(props GroupedProps) g.Node {
 									html.Class(lib.CN(
 										"relative flex cursor-default select-none items-center rounded-sm px-2 py-1.5 text-sm outline-none",
 										"hover:bg-accent hover:text-accent-foreground",
+										"data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground",
 										"data-[disabled=true]:pointer-events-none data-[disabled=true]:opacity-50",
 										func() string {
 											if isSelected {
@@ -491,18 +511,19 @@ func WithGroups(props GroupedProps) g.Node {
 
 // GroupedProps defines properties for a combobox with grouped options
 type GroupedProps struct {
-	ID          string        // ID for the input field
-	Name        string        // Name for form submission
-	Value       string        // Currently selected value
-	Groups      []OptionGroup // Grouped options
-	Placeholder string        // Placeholder text
-	SearchPlaceholder string  // Placeholder for search input
-	EmptyText   string        // Text to show when no options match
-	Open        bool          // Whether the popover is open
-	Disabled    bool          // Whether the combobox is disabled
-	Class       string        // Additional CSS classes
-	Width       string        // Width of the combobox
-	OnSelect    string        // JavaScript to run on selection
+	ID                string        // ID for the input field
+	Name              string        // Name for form submission
+	Value             string        // Currently selected value
+	Groups            []OptionGroup // Grouped options
+	Placeholder       string        // Placeholder text
+	SearchPlaceholder string        // Placeholder for search input
+	EmptyText         string        // Text to show when no options match
+	Open              bool          // Whether the popover is open
+	Disabled          bool          // Whether the combobox is disabled
+	Class             string        // Additional CSS classes
+	Width             string        // Width of the combobox
+	OnSelect          string        // JavaScript to run on selection
+	Attrs             []g.Node      // Additional attributes to pass through
 }
 
 // OptionGroup represents a group of options
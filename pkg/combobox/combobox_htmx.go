@@ -0,0 +1,591 @@
+package combobox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	g "maragu.dev/gomponents"
+	hx "maragu.dev/gomponents-htmx"
+	html "maragu.dev/gomponents/html"
+)
+
+// OptionSource looks up the options of a remote combobox
+type OptionSource interface {
+	// Search returns up to limit options matching query, starting at cursor
+	// (empty for the first page), and the cursor of the next page, which is
+	// empty when there are no more options
+	Search(ctx context.Context, query, cursor string, limit int) ([]Option, string, error)
+}
+
+// OptionCreator is implemented by option sources that accept new entries
+type OptionCreator interface {
+	// Create adds an option labelled label and returns it. Rejected labels
+	// are reported with a *ValidationError.
+	Create(ctx context.Context, label string) (Option, error)
+}
+
+// ValidationError reports a rejected entry
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// HTMXProps defines the endpoints of a remote combobox
+type HTMXProps struct {
+	ID         string // Combobox ID
+	SearchPath string // Endpoint returning option fragments
+	CreatePath string // Endpoint creating an option from the query; enables the "Create" entry
+	DebounceMs int    // Delay after typing before searching (default: 300)
+}
+
+// RemoteProps defines the properties of a combobox whose options are
+// searched on the server
+type RemoteProps struct {
+	Name        string   // Name for form submission
+	Value       string   // Selected value (single mode)
+	Values      []string // Selected values (Multi mode)
+	Selected    []Option // Labels of the selected values
+	Multi       bool     // Select several options, shown as chips
+	MaxItems    int      // Maximum number of selected options in Multi mode (0 = unlimited)
+	Placeholder string   // Placeholder of the search input
+	EmptyText   string   // Text to show when no options match
+	Disabled    bool     // Whether the combobox is disabled
+	Width       string   // Width of the combobox (default: "w-[280px]")
+	Class       string   // Additional CSS classes
+	Attrs       []g.Node // Additional attributes to pass through
+}
+
+// NewHTMX creates a combobox that searches a server endpoint as the user
+// types. Results are paged in as the list is scrolled, and the selected
+// values are submitted as hidden inputs, one per value in Multi mode.
+func NewHTMX(props RemoteProps, htmxProps HTMXProps) g.Node {
+	props = remoteDefaults(props)
+	if htmxProps.ID == "" {
+		htmxProps.ID = fmt.Sprintf("remote-combobox-%d", time.Now().UnixNano())
+	}
+	if htmxProps.DebounceMs == 0 {
+		htmxProps.DebounceMs = 300
+	}
+	id := htmxProps.ID
+
+	label := func(value string) string {
+		i := slices.IndexFunc(props.Selected, func(opt Option) bool { return opt.Value == value })
+		if i < 0 {
+			return value
+		}
+		return props.Selected[i].Label
+	}
+
+	inputValue := ""
+	if !props.Multi && props.Value != "" {
+		inputValue = label(props.Value)
+	}
+
+	return html.Div(lib.MergeAttrs([]g.Node{
+		html.ID(id),
+		html.Class(lib.CN("relative", props.Width, props.Class)),
+		g.Attr("data-combobox-remote", ""),
+		g.Attr("data-state", "closed"),
+		g.If(props.Multi, g.Attr("data-multi", "")),
+		g.If(props.MaxItems > 0, g.Attr("data-max-items", strconv.Itoa(props.MaxItems))),
+		roving.Group(roving.Props{Virtual: true}),
+		roving.Script(),
+
+		// Control with the selected values and the search input
+		html.Div(
+			html.Class(lib.CN(
+				"border-input dark:bg-input/30 flex min-h-9 w-full flex-wrap items-center gap-1 rounded-md border bg-transparent px-2 py-1 text-sm shadow-xs",
+				"focus-within:border-ring focus-within:ring-ring/50 focus-within:ring-[3px]",
+				func() string {
+					if props.Disabled {
+						return "pointer-events-none opacity-50"
+					}
+					return ""
+				}(),
+			)),
+			html.Div(
+				html.ID(id+"-values"),
+				html.Class("contents"),
+				g.Attr("data-combobox-values", ""),
+				g.If(props.Multi, g.Group(g.Map(props.Values, func(value string) g.Node {
+					return chip(props, Option{Value: value, Label: label(value)})
+				}))),
+				g.If(!props.Multi, html.Input(
+					html.Type("hidden"),
+					g.If(props.Name != "", html.Name(props.Name)),
+					html.Value(props.Value),
+					g.If(props.Disabled, html.Disabled()),
+				)),
+			),
+			html.Input(
+				html.ID(id+"-input"),
+				html.Type("text"),
+				html.Class("placeholder:text-muted-foreground min-w-[6rem] flex-1 bg-transparent py-1 outline-none disabled:cursor-not-allowed"),
+				html.Placeholder(props.Placeholder),
+				html.Value(inputValue),
+				// The query is sent with the search request only; pointing the
+				// input at a form that does not exist keeps it out of the
+				// surrounding form's submission
+				html.Name("q"),
+				g.Attr("form", id+"-search"),
+				g.Attr("role", "combobox"),
+				g.Attr("aria-expanded", "false"),
+				g.Attr("aria-controls", id+"-listbox"),
+				g.Attr("aria-autocomplete", "list"),
+				g.Attr("autocomplete", "off"),
+				g.Attr("data-combobox-input", ""),
+				g.Attr("data-label", inputValue),
+				g.If(props.Disabled, html.Disabled()),
+				hx.Get(htmxProps.SearchPath),
+				hx.Trigger(fmt.Sprintf("input changed delay:%dms, focus once", htmxProps.DebounceMs)),
+				hx.Target("#"+id+"-listbox"),
+				hx.Swap("innerHTML"),
+				hx.Include("#"+id+"-values"),
+				hx.Indicator("#"+id+"-loading"),
+				hx.Sync("this:replace"),
+			),
+			html.Span(
+				html.ID(id+"-loading"),
+				html.Class("htmx-indicator"),
+				g.Attr("role", "status"),
+				icons.Loader(html.Class("h-4 w-4 animate-spin opacity-50")),
+				html.Span(html.Class("sr-only"), g.Text("Searching")),
+			),
+			icons.ChevronsUpDown(html.Class("h-4 w-4 shrink-0 opacity-50")),
+		),
+
+		// Results
+		html.Div(
+			html.Class("bg-popover text-popover-foreground z-50 w-full overflow-hidden rounded-md border p-1 shadow-md"),
+			g.Attr("data-combobox-popup", ""),
+			floating.Attrs(floating.Props{Side: floating.SideBottom, Align: floating.AlignStart, SideOffset: 4}),
+			g.Attr("hidden"),
+			html.Div(
+				html.ID(id+"-listbox"),
+				html.Class("max-h-[300px] overflow-y-auto"),
+				g.Attr("role", "listbox"),
+				g.If(props.Multi, g.Attr("aria-multiselectable", "true")),
+				html.Div(
+					html.Class("py-6 text-center text-sm text-muted-foreground"),
+					g.Text("Type to search..."),
+				),
+			),
+		),
+
+		g.If(props.Multi, html.Template(
+			g.Attr("data-combobox-chip-template", ""),
+			chip(props, Option{}),
+		)),
+		html.Script(g.Raw(remoteScript)),
+	}, props.Attrs)...)
+}
+
+// remoteDefaults fills in the default properties
+func remoteDefaults(props RemoteProps) RemoteProps {
+	if props.Placeholder == "" {
+		props.Placeholder = "Search..."
+	}
+	if props.EmptyText == "" {
+		props.EmptyText = "No results found."
+	}
+	if props.Width == "" {
+		props.Width = "w-[280px]"
+	}
+	return props
+}
+
+// chip renders a selected value of a Multi combobox
+func chip(props RemoteProps, opt Option) g.Node {
+	return html.Span(
+		html.Class("bg-secondary text-secondary-foreground inline-flex items-center gap-1 rounded-md px-1.5 py-0.5 text-xs font-medium"),
+		g.Attr("data-combobox-chip", ""),
+		g.Attr("data-value", opt.Value),
+		html.Span(g.Attr("data-combobox-chip-label", ""), g.Text(opt.Label)),
+		html.Button(
+			html.Type("button"),
+			html.Class("rounded-sm opacity-70 hover:opacity-100 focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring"),
+			g.Attr("data-combobox-remove", ""),
+			g.Attr("aria-label", "Remove "+opt.Label),
+			g.If(props.Disabled, html.Disabled()),
+			icons.X(html.Class("h-3 w-3")),
+		),
+		html.Input(
+			html.Type("hidden"),
+			g.If(props.Name != "", html.Name(props.Name)),
+			html.Value(opt.Value),
+			g.If(props.Disabled, html.Disabled()),
+		),
+	)
+}
+
+// RemoteOption renders an option of a remote combobox
+func RemoteOption(id string, opt Option, selected bool) g.Node {
+	state := "unchecked"
+	if selected {
+		state = "checked"
+	}
+	return html.Div(
+		html.ID(id+"-option-"+url.PathEscape(opt.Value)),
+		html.Class(lib.CN(
+			"group relative flex cursor-default select-none items-center rounded-sm px-2 py-1.5 text-sm outline-none",
+			"hover:bg-accent hover:text-accent-foreground",
+			"data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground",
+			"data-[disabled=true]:pointer-events-none data-[disabled=true]:opacity-50",
+		)),
+		g.Attr("role", "option"),
+		g.Attr("data-value", opt.Value),
+		g.Attr("data-label", opt.Label),
+		g.Attr("data-state", state),
+		roving.VirtualItem(),
+		g.If(opt.Disabled, g.Attr("data-disabled", "true")),
+		g.If(opt.Icon != nil, html.Span(
+			html.Class("mr-2 h-4 w-4"),
+			opt.Icon,
+		)),
+		g.Text(opt.Label),
+		icons.Check(html.Class("ml-auto hidden h-4 w-4 group-data-[state=checked]:block")),
+	)
+}
+
+// Results renders a page of search results: the options, a sentinel that
+// loads the next page when scrolled into view, the "Create" entry when the
+// query can be added, and the empty text when there is nothing to show
+func Results(props RemoteProps, htmxProps HTMXProps, query string, options []Option, selected []string, next string, first bool) g.Node {
+	props = remoteDefaults(props)
+	creatable := first && htmxProps.CreatePath != "" && strings.TrimSpace(query) != "" &&
+		!slices.ContainsFunc(options, func(opt Option) bool { return strings.EqualFold(opt.Label, strings.TrimSpace(query)) })
+
+	return g.Group{
+		g.Group(g.Map(options, func(opt Option) g.Node {
+			return RemoteOption(htmxProps.ID, opt, slices.Contains(selected, opt.Value))
+		})),
+		g.If(next != "", html.Div(
+			html.Class("flex items-center justify-center gap-2 py-2 text-sm text-muted-foreground"),
+			g.Attr("role", "status"),
+			hx.Get(htmxProps.SearchPath+"?"+url.Values{"q": {query}, "cursor": {next}}.Encode()),
+			hx.Trigger(fmt.Sprintf("intersect once root:#%s-listbox", htmxProps.ID)),
+			hx.Include("#"+htmxProps.ID+"-values"),
+			hx.Swap("outerHTML"),
+			icons.Loader(html.Class("h-4 w-4 animate-spin")),
+			g.Text("Loading more..."),
+		)),
+		g.If(creatable, createEntry(htmxProps, strings.TrimSpace(query))),
+		g.If(first && len(options) == 0 && !creatable, html.Div(
+			html.Class("py-6 text-center text-sm text-muted-foreground"),
+			g.Text(props.EmptyText),
+		)),
+	}
+}
+
+// createEntry renders the option that creates the query as a new entry
+func createEntry(htmxProps HTMXProps, query string) g.Node {
+	vals, _ := json.Marshal(map[string]string{"label": query})
+	return html.Div(
+		html.Class(lib.CN(
+			"relative flex cursor-default select-none items-center gap-2 rounded-sm px-2 py-1.5 text-sm outline-none",
+			"hover:bg-accent hover:text-accent-foreground",
+			"data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground",
+		)),
+		g.Attr("role", "option"),
+		g.Attr("data-combobox-create", ""),
+		roving.VirtualItem(),
+		hx.Post(htmxProps.CreatePath),
+		hx.Vals(string(vals)),
+		hx.Swap("outerHTML"),
+		icons.Plus(html.Class("h-4 w-4")),
+		g.Textf("Create %q", query),
+	)
+}
+
+// createError renders the reason a label was rejected in place of the
+// "Create" entry
+func createError(message string) g.Node {
+	return html.Div(
+		html.Class("px-2 py-1.5 text-sm text-destructive"),
+		g.Attr("role", "alert"),
+		g.Attr("data-combobox-create-error", ""),
+		g.Text(message),
+	)
+}
+
+// Handlers serves the search and create endpoints of a remote combobox
+type Handlers struct {
+	Props    RemoteProps  // Combobox properties (Name and EmptyText are used)
+	HTMX     HTMXProps    // Combobox ID and endpoint paths
+	Source   OptionSource // Options to search; must implement OptionCreator when HTMX.CreatePath is set
+	PageSize int          // Options per page (default: 20)
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
+	if h.HTMX.ID == "" {
+		panic("combobox.Handlers: ID is required")
+	}
+	if h.HTMX.SearchPath == "" {
+		panic("combobox.Handlers: SearchPath is required")
+	}
+	if h.Source == nil {
+		panic("combobox.Handlers: Source is required")
+	}
+	creator, canCreate := h.Source.(OptionCreator)
+	if h.HTMX.CreatePath != "" && !canCreate {
+		panic("combobox.Handlers: Source must implement OptionCreator when CreatePath is set")
+	}
+	if h.PageSize == 0 {
+		h.PageSize = 20
+	}
+
+	htmxProps := h.HTMX
+	htmxProps.SearchPath = rt.Path(h.HTMX.SearchPath)
+	if h.HTMX.CreatePath != "" {
+		htmxProps.CreatePath = rt.Path(h.HTMX.CreatePath)
+	}
+
+	rt.Handle(http.MethodGet, h.HTMX.SearchPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("q")
+		cursor := r.URL.Query().Get("cursor")
+		options, next, err := h.Source.Search(r.Context(), query, cursor, h.PageSize)
+		if err != nil {
+			log.Printf("combobox: searching %s: %v", r.URL.Path, err)
+			http.Error(w, "Search failed", http.StatusInternalServerError)
+			return
+		}
+		var selected []string
+		if h.Props.Name != "" {
+			selected = r.URL.Query()[h.Props.Name]
+		}
+		Results(h.Props, htmxProps, query, options, selected, next, cursor == "").Render(w)
+	}))
+
+	if h.HTMX.CreatePath == "" {
+		return
+	}
+	rt.Handle(http.MethodPost, h.HTMX.CreatePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		label := strings.TrimSpace(r.FormValue("label"))
+		if label == "" {
+			http.Error(w, "Label is required", http.StatusBadRequest)
+			return
+		}
+		opt, err := creator.Create(r.Context(), label)
+		// HTMX only swaps successful responses, so a rejected label is
+		// answered with 200 and replaces the "Create" entry with the reason
+		var invalid *ValidationError
+		if errors.As(err, &invalid) {
+			createError(invalid.Message).Render(w)
+			return
+		}
+		if err != nil {
+			log.Printf("combobox: creating %q: %v", label, err)
+			http.Error(w, "Create failed", http.StatusInternalServerError)
+			return
+		}
+		// The created option replaces the "Create" entry and is selected
+		// by the script as soon as it is swapped in
+		html.Div(
+			g.Attr("data-combobox-autoselect", ""),
+			html.Class("contents"),
+			RemoteOption(htmxProps.ID, opt, true),
+		).Render(w)
+	}))
+}
+
+// MemorySource is an OptionSource for a fixed list of options held in
+// memory. Options match when their label contains the query, ignoring case.
+// It accepts new entries, using the label as the value.
+type MemorySource struct {
+	mu      sync.Mutex
+	options []Option
+}
+
+// NewMemorySource creates a MemorySource with options
+func NewMemorySource(options []Option) *MemorySource {
+	return &MemorySource{options: slices.Clone(options)}
+}
+
+// Search returns a page of the options matching query. Cursors are offsets
+// into the matches.
+func (s *MemorySource) Search(ctx context.Context, query, cursor string, limit int) ([]Option, string, error) {
+	offset := 0
+	if cursor != "" {
+		n, err := strconv.Atoi(cursor)
+		if err != nil || n < 0 {
+			return nil, "", fmt.Errorf("combobox: invalid cursor %q", cursor)
+		}
+		offset = n
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	query = strings.ToLower(strings.TrimSpace(query))
+	var matches []Option
+	for _, opt := range s.options {
+		if strings.Contains(strings.ToLower(opt.Label), query) {
+			matches = append(matches, opt)
+		}
+	}
+	if offset >= len(matches) {
+		return nil, "", nil
+	}
+	end := min(offset+limit, len(matches))
+	next := ""
+	if end < len(matches) {
+		next = strconv.Itoa(end)
+	}
+	return matches[offset:end], next, nil
+}
+
+// Create adds an option labelled label
+func (s *MemorySource) Create(ctx context.Context, label string) (Option, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if slices.ContainsFunc(s.options, func(opt Option) bool { return strings.EqualFold(opt.Label, label) }) {
+		return Option{}, &ValidationError{Message: fmt.Sprintf("%q already exists", label)}
+	}
+	opt := Option{Value: label, Label: label}
+	s.options = append(s.options, opt)
+	return opt, nil
+}
+
+// remoteScript opens the results while the search input has focus, writes
+// the chosen options to the hidden inputs and manages the chips of Multi
+// comboboxes. Keyboard navigation of the results comes from the roving script.
+const remoteScript = `
+(function() {
+	if (window.shadcnRemoteCombobox) return;
+	window.shadcnRemoteCombobox = true;
+
+	function parts(root) {
+		return {
+			root: root,
+			multi: root.hasAttribute('data-multi'),
+			input: root.querySelector('[data-combobox-input]'),
+			popup: root.querySelector('[data-combobox-popup]'),
+			listbox: root.querySelector('[role="listbox"]'),
+			values: root.querySelector('[data-combobox-values]'),
+			template: root.querySelector('[data-combobox-chip-template]')
+		};
+	}
+	function selectedValues(c) {
+		return Array.from(c.values.querySelectorAll('input[type="hidden"]')).map(input => input.value).filter(v => v !== '');
+	}
+	function sync(c) {
+		const values = selectedValues(c);
+		c.listbox.querySelectorAll('[role="option"][data-value]').forEach(el => {
+			el.dataset.state = values.includes(el.dataset.value) ? 'checked' : 'unchecked';
+		});
+	}
+	function setOpen(c, open) {
+		if (c.input.disabled) open = false;
+		c.root.dataset.state = open ? 'open' : 'closed';
+		c.input.setAttribute('aria-expanded', String(open));
+		c.popup.hidden = !open;
+		if (!open && !c.multi) {
+			if (c.input.value.trim() === '') {
+				c.values.querySelector('input').value = '';
+				c.input.dataset.label = '';
+			}
+			c.input.value = c.input.dataset.label || '';
+		}
+	}
+	function changed(c) {
+		c.values.dispatchEvent(new Event('change', { bubbles: true }));
+	}
+	function removeChip(c, chip) {
+		chip.remove();
+		sync(c);
+		changed(c);
+	}
+	function choose(c, item) {
+		const value = item.dataset.value, label = item.dataset.label;
+		if (c.multi) {
+			const existing = Array.from(c.values.querySelectorAll('[data-combobox-chip]')).find(el => el.dataset.value === value);
+			if (existing) {
+				removeChip(c, existing);
+				return;
+			}
+			const max = parseInt(c.root.dataset.maxItems, 10);
+			if (max && selectedValues(c).length >= max) return;
+			const chip = c.template.content.firstElementChild.cloneNode(true);
+			chip.dataset.value = value;
+			chip.querySelector('[data-combobox-chip-label]').textContent = label;
+			chip.querySelector('[data-combobox-remove]').setAttribute('aria-label', 'Remove ' + label);
+			chip.querySelector('input').value = value;
+			c.values.appendChild(chip);
+			c.input.value = '';
+			c.input.focus();
+		} else {
+			c.values.querySelector('input').value = value;
+			c.input.dataset.label = label;
+			c.input.value = label;
+			setOpen(c, false);
+		}
+		sync(c);
+		changed(c);
+	}
+
+	document.addEventListener('click', (e) => {
+		document.querySelectorAll('[data-combobox-remote][data-state="open"]').forEach(root => {
+			if (!root.contains(e.target)) setOpen(parts(root), false);
+		});
+		const root = e.target.closest('[data-combobox-remote]');
+		if (!root) return;
+		const c = parts(root);
+		const remove = e.target.closest('[data-combobox-remove]');
+		if (remove) {
+			removeChip(c, remove.closest('[data-combobox-chip]'));
+			return;
+		}
+		const item = e.target.closest('[role="option"][data-value]');
+		if (item && c.listbox.contains(item) && item.dataset.disabled !== 'true') choose(c, item);
+	});
+	document.addEventListener('focusin', (e) => {
+		if (e.target.matches('[data-combobox-input]')) setOpen(parts(e.target.closest('[data-combobox-remote]')), true);
+	});
+	document.addEventListener('input', (e) => {
+		if (e.target.matches('[data-combobox-input]')) setOpen(parts(e.target.closest('[data-combobox-remote]')), true);
+	});
+	document.addEventListener('keydown', (e) => {
+		if (!e.target.matches || !e.target.matches('[data-combobox-input]')) return;
+		const c = parts(e.target.closest('[data-combobox-remote]'));
+		if (e.key === 'Escape' && c.root.dataset.state === 'open') {
+			e.preventDefault();
+			setOpen(c, false);
+		} else if (e.key === 'Tab') {
+			setOpen(c, false);
+		} else if (e.key === 'Backspace' && c.multi && c.input.value === '') {
+			const chips = c.values.querySelectorAll('[data-combobox-chip]');
+			if (chips.length > 0) removeChip(c, chips[chips.length - 1]);
+		}
+	});
+	document.addEventListener('htmx:afterSwap', (e) => {
+		const root = e.target.closest && e.target.closest('[data-combobox-remote]');
+		if (root) sync(parts(root));
+	});
+	document.addEventListener('htmx:load', (e) => {
+		const el = e.detail.elt;
+		if (!el.matches || !el.matches('[data-combobox-autoselect]')) return;
+		const root = el.closest('[data-combobox-remote]');
+		const item = el.querySelector('[role="option"]');
+		el.replaceWith(item);
+		if (root && item) choose(parts(root), item);
+	});
+})();
+`
@@ -0,0 +1,214 @@
+package combobox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+)
+
+func TestNewHTMX(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    RemoteProps
+		contains []string
+		excludes []string
+	}{
+		{
+			name:  "single",
+			props: RemoteProps{Name: "customer", Value: "c1", Selected: []Option{{Value: "c1", Label: "Acme"}}},
+			contains: []string{
+				`data-combobox-remote=""`,
+				`data-roving-virtual=""`,
+				`<input type="hidden" name="customer" value="c1">`,
+				`id="customers-input" type="text"`,
+				`value="Acme" name="q" form="customers-search" role="combobox" aria-expanded="false" aria-controls="customers-listbox"`,
+				`hx-get="/customers/search"`,
+				`hx-trigger="input changed delay:300ms, focus once"`,
+				`hx-target="#customers-listbox"`,
+				`hx-include="#customers-values"`,
+				`hx-indicator="#customers-loading"`,
+				`id="customers-loading" class="htmx-indicator"`,
+				`role="listbox"`,
+				`window.shadcnRemoteCombobox`,
+			},
+			excludes: []string{`aria-multiselectable`, `<template`},
+		},
+		{
+			name: "multi",
+			props: RemoteProps{
+				Name:     "tags",
+				Values:   []string{"go", "htmx"},
+				Selected: []Option{{Value: "go", Label: "Go"}},
+				Multi:    true,
+				MaxItems: 3,
+			},
+			contains: []string{
+				`data-multi="" data-max-items="3"`,
+				`data-combobox-chip="" data-value="go"><span data-combobox-chip-label="">Go</span>`,
+				`aria-label="Remove Go"`,
+				`data-value="htmx"><span data-combobox-chip-label="">htmx</span>`,
+				`<input type="hidden" name="tags" value="htmx">`,
+				`aria-multiselectable="true"`,
+				`<template data-combobox-chip-template="">`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := NewHTMX(tt.props, HTMXProps{ID: "customers", SearchPath: "/customers/search"}).Render(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			html := buf.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected HTML to contain %q, got %s", want, html)
+				}
+			}
+			for _, notWant := range tt.excludes {
+				if strings.Contains(html, notWant) {
+					t.Errorf("expected HTML not to contain %q", notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestMemorySource(t *testing.T) {
+	var options []Option
+	for i := 1; i <= 5; i++ {
+		options = append(options, Option{Value: fmt.Sprint(i), Label: fmt.Sprintf("Item %d", i)})
+	}
+	source := NewMemorySource(options)
+	ctx := context.Background()
+
+	page, next, err := source.Search(ctx, "item", "", 2)
+	if err != nil || len(page) != 2 || next != "2" {
+		t.Fatalf("unexpected first page %v %q %v", page, next, err)
+	}
+	page, next, err = source.Search(ctx, "ITEM", next, 4)
+	if err != nil || len(page) != 3 || page[0].Value != "3" || next != "" {
+		t.Fatalf("unexpected last page %v %q %v", page, next, err)
+	}
+	if _, _, err := source.Search(ctx, "", "x", 2); err == nil {
+		t.Error("expected an error for an invalid cursor")
+	}
+
+	if _, err := source.Create(ctx, "item 1"); err == nil {
+		t.Error("expected an error creating an existing label")
+	}
+	created, err := source.Create(ctx, "Other")
+	if err != nil || created.Value != "Other" {
+		t.Fatalf("unexpected created option %v %v", created, err)
+	}
+	if page, _, _ := source.Search(ctx, "oth", "", 10); len(page) != 1 {
+		t.Errorf("expected the created option to be searchable, got %v", page)
+	}
+}
+
+func TestHandlers(t *testing.T) {
+	mux := http.NewServeMux()
+	Handlers{
+		Props: RemoteProps{Name: "tags"},
+		HTMX: HTMXProps{
+			ID:         "tags",
+			SearchPath: "/tags/search",
+			CreatePath: "/tags/create",
+		},
+		Source: NewMemorySource([]Option{
+			{Value: "go", Label: "Go"},
+			{Value: "gomponents", Label: "Gomponents"},
+			{Value: "htmx", Label: "HTMX"},
+		}),
+		PageSize: 1,
+	}.Register(router.ServeMux(mux))
+
+	get := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tags/search?"+query, nil))
+		return rec
+	}
+
+	rec := get("q=go&tags=go")
+	body := rec.Body.String()
+	for _, want := range []string{
+		`role="option" data-value="go" data-label="Go" data-state="checked"`,
+		`hx-get="/tags/search?cursor=1&amp;q=go"`,
+		`hx-trigger="intersect once root:#tags-listbox"`,
+		`hx-swap="outerHTML"`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected search results to contain %q, got %s", want, body)
+		}
+	}
+	if strings.Contains(body, "data-combobox-create") {
+		t.Error("expected no create entry for an exact match")
+	}
+
+	body = get("q=go&cursor=1").Body.String()
+	if !strings.Contains(body, `data-value="gomponents" data-label="Gomponents" data-state="unchecked"`) || strings.Contains(body, "intersect") {
+		t.Errorf("unexpected last page %s", body)
+	}
+
+	body = get("q=rust").Body.String()
+	if !strings.Contains(body, `hx-post="/tags/create"`) || !strings.Contains(body, `Create &#34;rust&#34;`) {
+		t.Errorf("expected a create entry, got %s", body)
+	}
+	if strings.Contains(body, "No results found.") {
+		t.Error("expected the create entry to replace the empty text")
+	}
+
+	post := func(label string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/tags/create", strings.NewReader(url.Values{"label": {label}}.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec = post("Rust")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `data-combobox-autoselect=""`) ||
+		!strings.Contains(rec.Body.String(), `data-value="Rust"`) {
+		t.Errorf("unexpected create response %d %s", rec.Code, rec.Body.String())
+	}
+	rec = post("rust")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `role="alert"`) ||
+		!strings.Contains(rec.Body.String(), `&#34;rust&#34; already exists`) {
+		t.Errorf("expected a duplicate label to be rejected in a swappable fragment, got %d %s", rec.Code, rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), `data-combobox-autoselect`) {
+		t.Error("expected a rejected label not to be selected")
+	}
+
+	rec = get("q=go&cursor=-1")
+	if rec.Code != http.StatusInternalServerError || strings.Contains(rec.Body.String(), "invalid cursor") {
+		t.Errorf("expected a generic search error, got %d %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestHandlersRequireCreator(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Register to panic")
+		}
+	}()
+	Handlers{
+		HTMX:   HTMXProps{ID: "tags", SearchPath: "/tags/search", CreatePath: "/tags/create"},
+		Source: searchOnly{},
+	}.Register(router.ServeMux(http.NewServeMux()))
+}
+
+type searchOnly struct{}
+
+func (searchOnly) Search(ctx context.Context, query, cursor string, limit int) ([]Option, string, error) {
+	return nil, "", nil
+}
@@ -7,6 +7,9 @@ import (
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -482,4 +485,23 @@ func TestComboboxNotContains(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	a11ytest.Conformance(t, Example(), a11y.Options{Fragment: true}, []string{
+		`role="combobox"`,
+		`role="listbox"`,
+		`role="option"`,
+		`data-roving-virtual=""`,
+		"window.shadcnRoving",
+	}, []string{
+		// Virtual options must not be focusable
+		`role="option" data-roving-item="" tabindex`,
+	})
+}
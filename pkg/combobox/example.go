@@ -1,6 +1,9 @@
 package combobox
 
 import (
+	"fmt"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
@@ -390,6 +393,41 @@ func Example() g.Node {
 			),
 		),
 
+		// Remote options
+		html.Div(
+			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Remote Options")),
+			html.Div(html.Class("space-y-4 max-w-md"),
+				html.Div(html.Class("space-y-2"),
+					html.Label(
+						html.For(exampleCustomer.ID+"-input"),
+						html.Class("text-sm font-medium"),
+						g.Text("Customer"),
+					),
+					NewHTMX(RemoteProps{
+						Name:        "customer",
+						Placeholder: "Search customers...",
+						Width:       "w-full",
+					}, exampleCustomer),
+				),
+				html.Div(html.Class("space-y-2"),
+					html.Label(
+						html.For(exampleTags.ID+"-input"),
+						html.Class("text-sm font-medium"),
+						g.Text("Tags"),
+					),
+					NewHTMX(RemoteProps{
+						Name:        "tags",
+						Values:      []string{"Go"},
+						Selected:    []Option{{Value: "Go", Label: "Go"}},
+						Multi:       true,
+						MaxItems:    5,
+						Placeholder: "Add tags...",
+						Width:       "w-full",
+					}, exampleTags),
+				),
+			),
+		),
+
 		// Usage notes
 		html.Div(
 			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Usage Notes")),
@@ -401,7 +439,7 @@ func Example() g.Node {
 					html.Li(g.Text("Searchable variant helps with large datasets")),
 					html.Li(g.Text("Grouped options organize related items")),
 					html.Li(g.Text("Selected items show a check mark")),
-					html.Li(g.Text("For HTMX integration, use the HTMX variant (coming soon)")),
+					html.Li(g.Text("The remote variant searches the server as you type and pages in more results on scroll")),
 				),
 			),
 		),
@@ -451,4 +489,27 @@ combobox.WithGroups(combobox.GroupedProps{
 			),
 		),
 	)
-}
\ No newline at end of file
+}
+
+var (
+	exampleCustomer = HTMXProps{ID: "example-customer", SearchPath: "/htmx/combobox/customers"}
+	exampleTags     = HTMXProps{ID: "example-tags", SearchPath: "/htmx/combobox/tags", CreatePath: "/htmx/combobox/tags/create"}
+)
+
+// ExampleHandlers serves the options of the remote combobox examples
+func ExampleHandlers() []router.Registrar {
+	customers := make([]Option, 0, 500)
+	for i := 1; i <= 500; i++ {
+		customers = append(customers, Option{Value: fmt.Sprintf("cus_%03d", i), Label: fmt.Sprintf("Customer %03d", i)})
+	}
+	tags := []Option{
+		{Value: "Go", Label: "Go"},
+		{Value: "HTMX", Label: "HTMX"},
+		{Value: "Tailwind", Label: "Tailwind"},
+		{Value: "Templates", Label: "Templates"},
+	}
+	return []router.Registrar{
+		Handlers{Props: RemoteProps{Name: "customer"}, HTMX: exampleCustomer, Source: NewMemorySource(customers)},
+		Handlers{Props: RemoteProps{Name: "tags"}, HTMX: exampleTags, Source: NewMemorySource(tags)},
+	}
+}
@@ -0,0 +1,29 @@
+package command
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Command",
+		Category:    "Navigation",
+		Description: "Command palette",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+			{Name: "Dialog", Func: "ExampleDialog", Render: ExampleDialog},
+			{Name: "No Search", Func: "ExampleNoSearch", Render: ExampleNoSearch},
+			{Name: "With Categories", Func: "ExampleWithCategories", Render: ExampleWithCategories},
+			{Name: "Disabled Items", Func: "ExampleDisabledItems", Render: ExampleDisabledItems},
+			{Name: "Custom Styling", Func: "ExampleCustomStyling", Render: ExampleCustomStyling},
+			{Name: "Empty", Func: "ExampleEmpty", Render: ExampleEmpty},
+			{Name: "Separators", Func: "ExampleSeparators", Render: ExampleSeparators},
+		},
+	})
+}
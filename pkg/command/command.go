@@ -6,6 +6,8 @@ import (
 
 	g "maragu.dev/gomponents"
 	h "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
 )
 
 // CommandItem represents an item in the command menu
@@ -43,6 +45,7 @@ type config struct {
 	maxHeight      string
 	width          string
 	theme          string // light or dark
+	attrs          []g.Node // additional attributes for the root element
 }
 
 // New creates a new command menu component
@@ -66,16 +69,15 @@ func New(id string, groups []CommandGroup, opts ...Option) g.Node {
 
 	classes := strings.TrimSpace("relative overflow-hidden rounded-lg border bg-popover text-popover-foreground shadow-md " + cfg.class)
 
-	return h.Div(
+	return h.Div(lib.MergeAttrs([]g.Node{
 		h.ID(id),
 		h.Class(classes),
 		g.Attr("data-command", "true"),
 		g.Attr("data-theme", cfg.theme),
 		h.Style(fmt.Sprintf("width: %s", cfg.width)),
-		g.Attr("role", "combobox"),
-		g.Attr("aria-expanded", "false"),
-		g.Attr("aria-haspopup", "listbox"),
 		g.Attr("aria-label", "Command menu"),
+		roving.Group(roving.Props{Virtual: true}),
+		roving.Script(),
 
 		// Search input
 		g.If(cfg.showSearch,
@@ -84,7 +86,7 @@ func New(id string, groups []CommandGroup, opts ...Option) g.Node {
 
 		// Command list
 		CommandList(id, groups, cfg),
-	)
+	}, cfg.attrs)...)
 }
 
 // SearchInput creates the search input for the command menu
@@ -92,7 +94,7 @@ func SearchInput(id string, cfg *config) g.Node {
 	return h.Div(
 		h.Class("flex items-center border-b px-3"),
 		// Search icon
-		g.Raw(`<svg class="mr-2 h-4 w-4 shrink-0 opacity-50" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round">
+		g.Raw(`<svg class="mr-2 h-4 w-4 shrink-0 opacity-50" aria-hidden="true" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round">
 			<circle cx="11" cy="11" r="8"></circle>
 			<path d="m21 21-4.35-4.35"></path>
 		</svg>`),
@@ -104,6 +106,7 @@ func SearchInput(id string, cfg *config) g.Node {
 			h.Class("flex h-11 w-full rounded-md bg-transparent py-3 text-sm outline-none placeholder:text-muted-foreground disabled:cursor-not-allowed disabled:opacity-50"),
 			h.Placeholder(cfg.placeholder),
 			g.Attr("role", "combobox"),
+			g.Attr("aria-expanded", "true"),
 			g.Attr("aria-controls", id+"-list"),
 			g.Attr("aria-autocomplete", "list"),
 			g.Attr("autocomplete", "off"),
@@ -197,13 +200,14 @@ func CommandItemNode(item CommandItem, cfg *config) g.Node {
 	if item.Disabled {
 		classes += " opacity-50 cursor-not-allowed"
 	} else {
-		classes += " hover:bg-accent hover:text-accent-foreground data-[selected=true]:bg-accent data-[selected=true]:text-accent-foreground"
+		classes += " hover:bg-accent hover:text-accent-foreground data-[selected=true]:bg-accent data-[selected=true]:text-accent-foreground data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground"
 	}
 
 	return h.Div(
 		h.Class(classes),
 		g.Attr("role", "option"),
 		g.Attr("aria-selected", "false"),
+		roving.VirtualItem(),
 		g.If(item.ID != "", g.Attr("id", item.ID)),
 		g.Attr("data-command-item", "true"),
 		g.Attr("data-value", item.Value),
@@ -281,6 +285,13 @@ func WithClass(class string) Option {
 	}
 }
 
+// WithAttrs passes additional attributes to the root element
+func WithAttrs(attrs ...g.Node) Option {
+	return func(c *config) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
 // WithPlaceholder sets the search input placeholder
 func WithPlaceholder(placeholder string) Option {
 	return func(c *config) {
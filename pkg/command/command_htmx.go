@@ -9,7 +9,9 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXConfig contains HTMX-specific configuration
@@ -52,16 +54,15 @@ func NewHTMX(id string, groups []CommandGroup, htmxCfg HTMXConfig, opts ...Optio
 		cfg.class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(id),
 		html.Class(classes),
 		g.Attr("data-command", "true"),
 		g.Attr("data-theme", cfg.theme),
 		html.Style(fmt.Sprintf("width: %s", cfg.width)),
-		g.Attr("role", "combobox"),
-		g.Attr("aria-expanded", "false"),
-		g.Attr("aria-haspopup", "listbox"),
 		g.Attr("aria-label", "Command menu"),
+		roving.Group(roving.Props{Virtual: true}),
+		roving.Script(),
 
 		// Search input
 		g.If(cfg.showSearch,
@@ -70,7 +71,7 @@ func NewHTMX(id string, groups []CommandGroup, htmxCfg HTMXConfig, opts ...Optio
 
 		// Command list
 		CommandListHTMX(id, groups, cfg, htmxCfg),
-	)
+	}, cfg.attrs)...)
 }
 
 // SearchInputHTMX creates the HTMX-enhanced search input
@@ -86,6 +87,7 @@ func SearchInputHTMX(id string, cfg *config, htmxCfg HTMXConfig) g.Node {
 			html.Class("flex h-11 w-full rounded-md bg-transparent py-3 text-sm outline-none placeholder:text-muted-foreground disabled:cursor-not-allowed disabled:opacity-50"),
 			html.Placeholder(cfg.placeholder),
 			g.Attr("role", "combobox"),
+			g.Attr("aria-expanded", "true"),
 			g.Attr("aria-controls", id+"-list"),
 			g.Attr("aria-autocomplete", "list"),
 			g.Attr("autocomplete", "off"),
@@ -193,7 +195,7 @@ func CommandItemNodeHTMX(item CommandItem, cfg *config, htmxCfg HTMXConfig) g.No
 		"relative flex cursor-default select-none items-center rounded-sm px-2 py-1.5 text-sm outline-none",
 		lib.CNIf(item.Disabled,
 			"opacity-50 cursor-not-allowed",
-			"hover:bg-accent hover:text-accent-foreground data-[selected=true]:bg-accent data-[selected=true]:text-accent-foreground cursor-pointer",
+			"hover:bg-accent hover:text-accent-foreground data-[selected=true]:bg-accent data-[selected=true]:text-accent-foreground data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground cursor-pointer",
 		),
 	)
 
@@ -201,6 +203,7 @@ func CommandItemNodeHTMX(item CommandItem, cfg *config, htmxCfg HTMXConfig) g.No
 		html.Class(classes),
 		g.Attr("role", "option"),
 		g.Attr("aria-selected", "false"),
+		roving.VirtualItem(),
 		g.If(item.ID != "", g.Attr("id", item.ID)),
 		g.Attr("data-command-item", "true"),
 		g.Attr("data-value", item.Value),
@@ -352,10 +355,13 @@ func matchesQuery(item CommandItem, query string) bool {
 	return false
 }
 
-// CommandHandlers creates HTTP handlers for command menu
-func CommandHandlers(mux *http.ServeMux) {
+// Handlers serves the command menu search and select endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Search handler
-	mux.HandleFunc("/api/command/search", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/command/search", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		query := r.FormValue("search")
 
 		// Example command groups
@@ -384,15 +390,15 @@ func CommandHandlers(mux *http.ServeMux) {
 		}
 
 		htmxCfg := HTMXConfig{
-			SelectEndpoint: "/api/command/select",
+			SelectEndpoint: rt.Path("/api/command/select"),
 		}
 
 		result := RenderSearchResults(query, groups, cfg, htmxCfg)
 		result.Render(w)
-	})
+	}))
 
 	// Select handler
-	mux.HandleFunc("/api/command/select", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/command/select", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		value := r.FormValue("value")
 		label := r.FormValue("label")
 		category := r.FormValue("category")
@@ -416,7 +422,14 @@ func CommandHandlers(mux *http.ServeMux) {
 		)
 
 		response.Render(w)
-	})
+	}))
+}
+
+// CommandHandlers creates HTTP handlers for command menu
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func CommandHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
 
 // CreateSampleGroups creates sample command groups for examples
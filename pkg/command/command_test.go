@@ -7,6 +7,10 @@ import (
 
 	h "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -40,6 +44,23 @@ func TestNew(t *testing.T) {
 				`Type a command or search...`,
 			},
 		},
+		{
+			name: "with attrs",
+			id:   "attrs-command",
+			groups: []CommandGroup{
+				{
+					Items: []CommandItem{
+						{Value: "item1", Label: "Item 1"},
+					},
+				},
+			},
+			opts: []Option{WithAttrs(h.Class("w-96"), h.Style("max-width: 100%"), g.Attr("data-testid", "palette"))},
+			contains: []string{
+				`shadow-md w-96"`,
+				`style="width: 100%; max-width: 100%"`,
+				`data-testid="palette"`,
+			},
+		},
 		{
 			name: "without search",
 			id:   "no-search",
@@ -442,4 +463,34 @@ func TestSeparator(t *testing.T) {
 			t.Errorf("Expected separator to contain %q", exp)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	groups := []CommandGroup{{Items: []CommandItem{{Value: "item1", Label: "Item 1"}}}}
+	a11ytest.Conformance(t, New("kbd", groups), a11y.Options{Fragment: true}, []string{
+		`data-roving-virtual=""`,
+		`role="combobox"`,
+		`aria-controls="kbd-list"`,
+		`role="listbox"`,
+		`role="option"`,
+		`data-roving-item=""`,
+		"data-[highlighted]:bg-accent",
+	}, []string{
+		// Virtual options must not be focusable
+		`role="option" aria-selected="false" tabindex`,
+	})
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":               Example,
+		"ExampleCustomStyling":  ExampleCustomStyling,
+		"ExampleDialog":         ExampleDialog,
+		"ExampleDisabledItems":  ExampleDisabledItems,
+		"ExampleEmpty":          ExampleEmpty,
+		"ExampleHTMX":           ExampleHTMX,
+		"ExampleNoSearch":       ExampleNoSearch,
+		"ExampleSeparators":     ExampleSeparators,
+		"ExampleWithCategories": ExampleWithCategories,
+	})
+}
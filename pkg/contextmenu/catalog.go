@@ -0,0 +1,24 @@
+package contextmenu
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Context Menu",
+		Category:    "Navigation",
+		Description: "Right-click context menus",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+			{Name: "With Icons", Func: "ExampleWithIcons", Render: ExampleWithIcons},
+			{Name: "Custom Styling", Func: "ExampleCustomStyling", Render: ExampleCustomStyling},
+		},
+	})
+}
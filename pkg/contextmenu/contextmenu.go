@@ -4,24 +4,30 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 )
 
 // Props defines the properties for the ContextMenu component
 type Props struct {
-	ID    string // Unique ID for the context menu
-	Class string // Additional custom classes
+	ID    string   // Unique ID for the context menu
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for the context menu trigger
 type TriggerProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for the context menu content
 type ContentProps struct {
-	Class    string
-	Position string // Position relative to trigger
-	Align    string // Alignment relative to trigger
+	Class     string
+	Position  string             // Position relative to trigger
+	Align     string             // Alignment relative to trigger
+	Collision floating.Collision // Viewport collision handling
+	Attrs     []g.Node           // Additional attributes to pass through
 }
 
 // ItemProps defines properties for context menu items
@@ -29,17 +35,20 @@ type ItemProps struct {
 	Class    string
 	Disabled bool
 	Inset    bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // LabelProps defines properties for context menu labels
 type LabelProps struct {
 	Class string
 	Inset bool
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SeparatorProps defines properties for context menu separators
 type SeparatorProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // CheckboxItemProps defines properties for checkbox items
@@ -47,12 +56,14 @@ type CheckboxItemProps struct {
 	Class    string
 	Checked  bool
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // RadioGroupProps defines properties for radio groups
 type RadioGroupProps struct {
 	Value string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // RadioItemProps defines properties for radio items
@@ -60,11 +71,13 @@ type RadioItemProps struct {
 	Value    string
 	Class    string
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // SubProps defines properties for submenus
 type SubProps struct {
-	Open bool
+	Open  bool
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SubTriggerProps defines properties for submenu triggers
@@ -72,26 +85,30 @@ type SubTriggerProps struct {
 	Class    string
 	Disabled bool
 	Inset    bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // SubContentProps defines properties for submenu content
 type SubContentProps struct {
-	Class string
+	Class     string
+	Collision floating.Collision // Viewport collision handling
+	Attrs     []g.Node           // Additional attributes to pass through
 }
 
 // ShortcutProps defines properties for keyboard shortcuts
 type ShortcutProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new ContextMenu container
 func New(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.If(props.ID != "", html.ID(props.ID)),
 		g.If(props.Class != "", html.Class(props.Class)),
 		g.Attr("data-context-menu", "root"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a context menu trigger area
@@ -101,12 +118,12 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-context-menu", "trigger"),
 		g.Attr("oncontextmenu", "return false;"), // Prevent default context menu
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Content creates the context menu content container
@@ -117,15 +134,36 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
+		html.Role("menu"),
 		g.Attr("data-context-menu", "content"),
 		g.Attr("data-state", "closed"),
-		g.If(props.Position != "", g.Attr("data-side", props.Position)),
-		g.If(props.Align != "", g.Attr("data-align", props.Align)),
+		floating.Attrs(props.floatingProps()),
+		roving.Group(roving.Props{}),
 		html.Style("position: absolute; display: none;"),
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
+}
+
+// floatingProps returns the placement handed to the positioning script.
+// Menus open below and to the right of the pointer by default.
+func (props ContentProps) floatingProps() floating.Props {
+	side := props.Position
+	if side == "" {
+		side = floating.SideBottom
+	}
+	align := props.Align
+	if align == "" {
+		align = floating.AlignStart
+	}
+
+	return floating.Props{
+		Side:      side,
+		Align:     align,
+		Collision: props.Collision,
+	}
 }
 
 // Item creates a context menu item
@@ -138,8 +176,9 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 
 	attrs := []g.Node{
 		html.Class(classes),
+		html.Role("menuitem"),
 		g.Attr("data-context-menu", "item"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -147,7 +186,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -162,7 +201,7 @@ func CheckboxItem(props CheckboxItemProps, children ...g.Node) g.Node {
 		html.Class(classes),
 		g.Attr("data-context-menu", "checkbox-item"),
 		g.Attr("role", "menuitemcheckbox"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Checked {
@@ -180,6 +219,7 @@ func CheckboxItem(props CheckboxItemProps, children ...g.Node) g.Node {
 			html.Class("absolute left-2 flex h-3.5 w-3.5 items-center justify-center"),
 			g.If(props.Checked,
 				g.El("svg",
+					g.Attr("aria-hidden", "true"),
 					g.Attr("viewBox", "0 0 24 24"),
 					g.Attr("fill", "none"),
 					g.Attr("stroke", "currentColor"),
@@ -194,19 +234,19 @@ func CheckboxItem(props CheckboxItemProps, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, append(itemChildren, children...)...)...,
+		lib.MergeAttrs(append(attrs, append(itemChildren, children...)...), props.Attrs)...,
 	)
 }
 
 // RadioGroup creates a radio group container
 func RadioGroup(props RadioGroupProps, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("role", "group"),
 		g.Attr("data-context-menu", "radio-group"),
 		g.If(props.Value != "", g.Attr("data-value", props.Value)),
 		g.If(props.Class != "", html.Class(props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // RadioItem creates a radio menu item
@@ -221,7 +261,7 @@ func RadioItem(props RadioItemProps, children ...g.Node) g.Node {
 		g.Attr("data-context-menu", "radio-item"),
 		g.Attr("role", "menuitemradio"),
 		g.Attr("data-value", props.Value),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -232,6 +272,7 @@ func RadioItem(props RadioItemProps, children ...g.Node) g.Node {
 		html.Span(
 			html.Class("absolute left-2 flex h-3.5 w-3.5 items-center justify-center"),
 			g.El("svg",
+				g.Attr("aria-hidden", "true"),
 				g.Attr("viewBox", "0 0 24 24"),
 				g.Attr("fill", "currentColor"),
 				html.Class("h-2 w-2 fill-current"),
@@ -243,7 +284,7 @@ func RadioItem(props RadioItemProps, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, append(itemChildren, children...)...)...,
+		lib.MergeAttrs(append(attrs, append(itemChildren, children...)...), props.Attrs)...,
 	)
 }
 
@@ -255,11 +296,11 @@ func LabelComponent(props LabelProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-context-menu", "label"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Separator creates a context menu separator
@@ -269,21 +310,21 @@ func Separator(props SeparatorProps) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-context-menu", "separator"),
 		g.Attr("role", "separator"),
-	)
+	}, props.Attrs)...)
 }
 
 // SubMenu creates a submenu container
 func SubMenu(props SubProps, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-context-menu", "sub"),
 		g.If(props.Open, g.Attr("data-state", "open")),
 		g.If(!props.Open, g.Attr("data-state", "closed")),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // SubTrigger creates a submenu trigger item
@@ -296,8 +337,11 @@ func SubTrigger(props SubTriggerProps, children ...g.Node) g.Node {
 
 	attrs := []g.Node{
 		html.Class(classes),
+		html.Role("menuitem"),
+		g.Attr("aria-haspopup", "menu"),
+		g.Attr("aria-expanded", "false"),
 		g.Attr("data-context-menu", "sub-trigger"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -307,6 +351,7 @@ func SubTrigger(props SubTriggerProps, children ...g.Node) g.Node {
 	// Add chevron icon
 	childrenWithIcon := append(children,
 		g.El("svg",
+			g.Attr("aria-hidden", "true"),
 			g.Attr("viewBox", "0 0 24 24"),
 			g.Attr("fill", "none"),
 			g.Attr("stroke", "currentColor"),
@@ -319,7 +364,7 @@ func SubTrigger(props SubTriggerProps, children ...g.Node) g.Node {
 	)
 
 	return html.Div(
-		append(attrs, childrenWithIcon...)...,
+		lib.MergeAttrs(append(attrs, childrenWithIcon...), props.Attrs)...,
 	)
 }
 
@@ -331,12 +376,21 @@ func SubContent(props SubContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
+		html.Role("menu"),
 		g.Attr("data-context-menu", "sub-content"),
+		roving.Group(roving.Props{}),
+		floating.Attrs(floating.Props{
+			Side:        floating.SideRight,
+			Align:       floating.AlignStart,
+			AlignOffset: -5,
+			Collision:   props.Collision,
+		}),
 		html.Style("position: absolute; display: none;"),
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Shortcut creates a keyboard shortcut display
@@ -346,9 +400,9 @@ func Shortcut(props ShortcutProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Span(
+	return html.Span(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-context-menu", "shortcut"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
\ No newline at end of file
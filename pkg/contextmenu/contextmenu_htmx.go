@@ -9,6 +9,9 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the ContextMenu
@@ -20,7 +23,7 @@ type HTMXProps struct {
 
 // NewHTMX creates an HTMX-enhanced ContextMenu component
 func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID),
 		g.If(props.Class != "", html.Class(props.Class)),
 		g.Attr("data-context-menu", "root"),
@@ -45,7 +48,7 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 		g.Group(children),
 		// Menu container
 		html.Div(html.ID(htmxProps.ID+"-menu"), html.Style("position: relative;")),
-	)
+	}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced context menu trigger
@@ -55,14 +58,16 @@ func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, children ...g.Node) g.
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-context-menu", "trigger"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
-// ContentHTMX creates HTMX-enhanced context menu content
+// ContentHTMX creates HTMX-enhanced context menu content opened at the page
+// coordinates x and y (event.pageX/pageY). The positioning script converts
+// them to viewport coordinates and keeps the menu on screen.
 func ContentHTMX(props ContentProps, x, y int, children ...g.Node) g.Node {
 	classes := lib.CN(
 		"z-50 min-w-[8rem] overflow-hidden rounded-md border bg-popover p-1 text-popover-foreground shadow-md",
@@ -70,18 +75,19 @@ func ContentHTMX(props ContentProps, x, y int, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	// Calculate position to ensure menu stays within viewport
-	style := fmt.Sprintf("position: fixed; left: %dpx; top: %dpx;", x, y)
-
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
+		html.Role("menu"),
 		g.Attr("data-context-menu", "content"),
 		g.Attr("data-state", "open"),
-		html.Style(style),
+		floating.AtPoint(props.floatingProps(), floating.Point{X: x, Y: y}),
+		roving.Group(roving.Props{AutoFocus: true}),
+		html.Style("position: fixed;"),
+		roving.Script(),
 		// Prevent click propagation to avoid closing menu when clicking inside
 		hx.On("click", "event.stopPropagation()"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ItemHTMX creates an HTMX-enhanced context menu item
@@ -94,8 +100,9 @@ func ItemHTMX(props ItemProps, htmxProps HTMXProps, action string, children ...g
 
 	attrs := []g.Node{
 		html.Class(classes),
+		html.Role("menuitem"),
 		g.Attr("data-context-menu", "item"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if !props.Disabled {
@@ -110,7 +117,7 @@ func ItemHTMX(props ItemProps, htmxProps HTMXProps, action string, children ...g
 	}
 
 	return html.Div(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -125,7 +132,7 @@ func CheckboxItemHTMX(props CheckboxItemProps, htmxProps HTMXProps, name string,
 		html.Class(classes),
 		g.Attr("data-context-menu", "checkbox-item"),
 		g.Attr("role", "menuitemcheckbox"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Checked {
@@ -150,6 +157,7 @@ func CheckboxItemHTMX(props CheckboxItemProps, htmxProps HTMXProps, name string,
 			html.Class("absolute left-2 flex h-3.5 w-3.5 items-center justify-center"),
 			g.If(props.Checked,
 				g.El("svg",
+					g.Attr("aria-hidden", "true"),
 					g.Attr("viewBox", "0 0 24 24"),
 					g.Attr("fill", "none"),
 					g.Attr("stroke", "currentColor"),
@@ -164,7 +172,7 @@ func CheckboxItemHTMX(props CheckboxItemProps, htmxProps HTMXProps, name string,
 	}
 
 	return html.Div(
-		append(attrs, append(itemChildren, children...)...)...,
+		lib.MergeAttrs(append(attrs, append(itemChildren, children...)...), props.Attrs)...,
 	)
 }
 
@@ -180,7 +188,7 @@ func RadioItemHTMX(props RadioItemProps, htmxProps HTMXProps, groupName string,
 		g.Attr("data-context-menu", "radio-item"),
 		g.Attr("role", "menuitemradio"),
 		g.Attr("data-value", props.Value),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if selected {
@@ -205,6 +213,7 @@ func RadioItemHTMX(props RadioItemProps, htmxProps HTMXProps, groupName string,
 			html.Class("absolute left-2 flex h-3.5 w-3.5 items-center justify-center"),
 			g.If(selected,
 				g.El("svg",
+					g.Attr("aria-hidden", "true"),
 					g.Attr("viewBox", "0 0 24 24"),
 					g.Attr("fill", "currentColor"),
 					html.Class("h-2 w-2 fill-current"),
@@ -215,19 +224,19 @@ func RadioItemHTMX(props RadioItemProps, htmxProps HTMXProps, groupName string,
 	}
 
 	return html.Div(
-		append(attrs, append(itemChildren, children...)...)...,
+		lib.MergeAttrs(append(attrs, append(itemChildren, children...)...), props.Attrs)...,
 	)
 }
 
 // SubHTMX creates an HTMX-enhanced submenu
 func SubHTMX(props SubProps, htmxProps HTMXProps, subID string, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-context-menu", "sub"),
 		g.Attr("data-sub-id", subID),
 		g.If(props.Open, g.Attr("data-state", "open")),
 		g.If(!props.Open, g.Attr("data-state", "closed")),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // SubTriggerHTMX creates an HTMX-enhanced submenu trigger
@@ -240,8 +249,11 @@ func SubTriggerHTMX(props SubTriggerProps, htmxProps HTMXProps, subID string, ch
 
 	attrs := []g.Node{
 		html.Class(classes),
+		html.Role("menuitem"),
+		g.Attr("aria-haspopup", "menu"),
+		g.Attr("aria-expanded", "false"),
 		g.Attr("data-context-menu", "sub-trigger"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if !props.Disabled {
@@ -250,9 +262,7 @@ func SubTriggerHTMX(props SubTriggerProps, htmxProps HTMXProps, subID string, ch
 				const submenu = this.nextElementSibling;
 				if (submenu && submenu.getAttribute('data-context-menu') === 'sub-content') {
 					submenu.style.display = 'block';
-					const rect = this.getBoundingClientRect();
-					submenu.style.left = rect.right + 'px';
-					submenu.style.top = rect.top + 'px';
+					if (window.shadcnFloating) window.shadcnFloating.position(submenu);
 				}
 			`)),
 			hx.On("mouseleave", fmt.Sprintf(`
@@ -271,6 +281,7 @@ func SubTriggerHTMX(props SubTriggerProps, htmxProps HTMXProps, subID string, ch
 	// Add chevron icon
 	childrenWithIcon := append(children,
 		g.El("svg",
+			g.Attr("aria-hidden", "true"),
 			g.Attr("viewBox", "0 0 24 24"),
 			g.Attr("fill", "none"),
 			g.Attr("stroke", "currentColor"),
@@ -283,7 +294,7 @@ func SubTriggerHTMX(props SubTriggerProps, htmxProps HTMXProps, subID string, ch
 	)
 
 	return html.Div(
-		append(attrs, childrenWithIcon...)...,
+		lib.MergeAttrs(append(attrs, childrenWithIcon...), props.Attrs)...,
 	)
 }
 
@@ -306,8 +317,11 @@ func ExampleHTMX() g.Node {
 	)
 }
 
-// ContextMenuHandlers creates HTTP handlers for context menu
-func ContextMenuHandlers(mux *http.ServeMux) {
+// Handlers serves the context menu endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Track state
 	var bookmarksChecked = true
 	var fullURLsChecked = false
@@ -315,11 +329,11 @@ func ContextMenuHandlers(mux *http.ServeMux) {
 
 	htmxProps := HTMXProps{
 		ID:       "context-menu-example",
-		MenuPath: "/api/context-menu/show",
-		ItemPath: "/api/context-menu/action",
+		MenuPath: rt.Path("/api/context-menu/show"),
+		ItemPath: rt.Path("/api/context-menu/action"),
 	}
 
-	mux.HandleFunc("/api/context-menu/show", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/context-menu/show", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		x := r.FormValue("x")
 		y := r.FormValue("y")
@@ -369,9 +383,9 @@ func ContextMenuHandlers(mux *http.ServeMux) {
 		)
 
 		node.Render(w)
-	})
+	}))
 
-	mux.HandleFunc("/api/context-menu/action", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/context-menu/action", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		action := r.FormValue("action")
 
@@ -388,5 +402,12 @@ func ContextMenuHandlers(mux *http.ServeMux) {
 
 		// Return empty to close menu
 		w.Write([]byte(""))
-	})
+	}))
+}
+
+// ContextMenuHandlers creates HTTP handlers for context menu
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func ContextMenuHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
\ No newline at end of file
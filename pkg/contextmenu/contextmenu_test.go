@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -367,4 +370,50 @@ func TestCompleteMenu(t *testing.T) {
 			t.Errorf("Complete menu missing: %v", want)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestContentHTMXAtPointer(t *testing.T) {
+	got := renderToString(ContentHTMX(ContentProps{}, 240, 1800, g.Text("Menu content")))
+
+	wants := []string{
+		`data-floating=""`,
+		`data-side="bottom"`,
+		`data-align="start"`,
+		`data-floating-x="240"`,
+		`data-floating-y="1800"`,
+	}
+	for _, want := range wants {
+		if !strings.Contains(got, want) {
+			t.Errorf("ContentHTMX() = %v, want %v", got, want)
+		}
+	}
+	if strings.Contains(got, "top: 1800px") {
+		t.Errorf("ContentHTMX() should not use page coordinates as fixed offsets, got %v", got)
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":              Example,
+		"ExampleCustomStyling": ExampleCustomStyling,
+		"ExampleHTMX":          ExampleHTMX,
+		"ExampleWithIcons":     ExampleWithIcons,
+	})
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	menu := New(Props{},
+		Trigger(TriggerProps{}, g.Text("Right click here")),
+		ContentComponent(ContentProps{},
+			Item(ItemProps{}, g.Text("Back")),
+			CheckboxItem(CheckboxItemProps{Checked: true}, g.Text("Show Bookmarks")),
+		),
+	)
+	a11ytest.Conformance(t, menu, a11y.Options{Fragment: true}, []string{
+		`role="menu"`,
+		`role="menuitem"`,
+		`data-roving-orientation="vertical"`,
+		`tabindex="-1" data-roving-item=""`,
+		"window.shadcnRoving",
+	}, nil)
+}
@@ -0,0 +1,434 @@
+package datatable
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/dropdownmenu"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/input"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// arrangeColumns returns the columns in display order: ColumnOrder first,
+// then the remaining columns as defined, with left-pinned columns moved to
+// the start and right-pinned columns to the end
+func arrangeColumns(props Props) []Column {
+	columns := make([]Column, 0, len(props.Columns))
+	for _, id := range props.ColumnOrder {
+		for _, col := range props.Columns {
+			if col.ID == id && !containsColumn(columns, id) {
+				columns = append(columns, col)
+			}
+		}
+	}
+	for _, col := range props.Columns {
+		if !containsColumn(columns, col.ID) {
+			columns = append(columns, col)
+		}
+	}
+
+	rank := map[string]int{"left": 0, "": 1, "right": 2}
+	slices.SortStableFunc(columns, func(a, b Column) int {
+		return rank[a.Pin] - rank[b.Pin]
+	})
+	return columns
+}
+
+func containsColumn(columns []Column, id string) bool {
+	return slices.ContainsFunc(columns, func(col Column) bool { return col.ID == id })
+}
+
+// isHidden reports whether the column is hidden in the current view
+func isHidden(props Props, col Column) bool {
+	return slices.Contains(props.HiddenColumns, col.ID)
+}
+
+// visibleColumnCount returns the number of columns that are not hidden
+func visibleColumnCount(props Props) int {
+	count := 0
+	for _, col := range props.Columns {
+		if !isHidden(props, col) {
+			count++
+		}
+	}
+	return count
+}
+
+// leadingColumnCount returns the number of selection and toggle columns
+// before the data columns
+func leadingColumnCount(props Props) int {
+	count := 0
+	if props.Selectable {
+		count++
+	}
+	if isExpandable(props) {
+		count++
+	}
+	return count
+}
+
+// totalColumnCount returns the number of cells in a full row
+func totalColumnCount(props Props) int {
+	return leadingColumnCount(props) + visibleColumnCount(props)
+}
+
+// columnWidth returns the width of the column in the current view
+func columnWidth(props Props, col Column) string {
+	if width := props.ColumnWidths[col.ID]; width != "" {
+		return width
+	}
+	return col.Width
+}
+
+// hasColumnFeatures reports whether the table needs the columns script
+func hasColumnFeatures(props Props) bool {
+	if props.ColumnMenu || props.Reorderable || props.ViewsPath != "" || props.ExportPath != "" {
+		return true
+	}
+	return slices.ContainsFunc(props.Columns, func(col Column) bool {
+		return col.Resizable || col.Pin != ""
+	})
+}
+
+// hasViewControls reports whether the toolbar shows view controls
+func hasViewControls(props Props) bool {
+	return props.ColumnMenu || len(props.Views) > 0 || props.ViewsPath != "" || props.ExportPath != "" || hasBulkActions(props)
+}
+
+// columnClasses returns the classes shared by a column's header and cells
+func columnClasses(props Props, col Column) string {
+	switch col.Pin {
+	case "left":
+		return "sticky left-0 z-[1] bg-background"
+	case "right":
+		return "sticky right-0 z-[1] bg-background"
+	}
+	if col.Resizable {
+		return "relative"
+	}
+	return ""
+}
+
+// columnCellAttrs returns the attributes of a column's body cells
+func columnCellAttrs(props Props, col Column) []g.Node {
+	return []g.Node{
+		g.Attr("data-column-id", col.ID),
+		g.If(col.Pin != "", g.Attr("data-pinned", col.Pin)),
+		g.If(isHidden(props, col), g.Attr("hidden")),
+	}
+}
+
+// columnHeadAttrs returns the attributes of a column's header cell
+func columnHeadAttrs(props Props, col Column) []g.Node {
+	return append(columnCellAttrs(props, col),
+		g.If(props.Reorderable, g.Attr("draggable", "true")),
+	)
+}
+
+// resizeHandle renders the drag handle on the edge of a resizable header
+func resizeHandle() g.Node {
+	return html.Span(
+		html.Class("absolute right-0 top-0 h-full w-1 cursor-col-resize touch-none select-none hover:bg-border"),
+		g.Attr("data-datatable-resize", ""),
+		g.Attr("aria-hidden", "true"),
+	)
+}
+
+// renderViewControls renders the saved views and the column visibility menu
+func renderViewControls(props Props) g.Node {
+	return g.Group{
+		g.If(hasBulkActions(props), renderBulkActions(props)),
+		g.If(len(props.Views) > 0, renderViewSelect(props)),
+		g.If(props.ViewsPath != "", renderSaveView(props)),
+		g.If(props.ViewsPath == "" && props.ExportPath != "", viewState(props)),
+		g.If(props.ColumnMenu, renderColumnMenu(props)),
+		g.If(props.ExportPath != "", ExportButton(ExportButtonProps{
+			Path: props.ExportPath,
+			View: ViewFromProps(props.ActiveView, props),
+		})),
+	}
+}
+
+// renderViewSelect renders a form that reloads the page with the chosen view
+func renderViewSelect(props Props) g.Node {
+	return html.Form(
+		html.Method("get"),
+		html.Select(
+			html.Name(ViewParam),
+			g.Attr("aria-label", "Saved views"),
+			html.Class("h-8 rounded-md border border-input bg-background px-2 text-sm"),
+			g.Attr("onchange", "this.form.requestSubmit()"),
+			html.Option(html.Value(""), g.Text("Default view")),
+			g.Group(g.Map(props.Views, func(v View) g.Node {
+				return html.Option(
+					html.Value(v.Name),
+					g.If(v.Name == props.ActiveView, html.Selected()),
+					g.Text(v.Name),
+				)
+			})),
+		),
+		html.NoScript(
+			button.New(button.Props{Variant: "outline", Size: "sm", Type: "submit"}, g.Text("Apply")),
+		),
+	)
+}
+
+// viewState renders the hidden input the script keeps in sync with the view
+func viewState(props Props, attrs ...g.Node) g.Node {
+	state, _ := json.Marshal(ViewFromProps(props.ActiveView, props))
+
+	return html.Input(
+		html.Type("hidden"),
+		html.Value(string(state)),
+		g.Attr("data-datatable-state", ""),
+		g.Group(attrs),
+	)
+}
+
+// renderSaveView renders the form that saves the current view under a name
+func renderSaveView(props Props) g.Node {
+	return html.Form(
+		html.Method("post"),
+		html.Action(props.ViewsPath),
+		html.Class("flex items-center gap-2"),
+		viewState(props, html.Name("state")),
+		input.New(input.Props{
+			Type:        "text",
+			Name:        "name",
+			Placeholder: "View name",
+			Value:       props.ActiveView,
+			Required:    true,
+			Class:       "h-8 w-32",
+			Attrs:       []g.Node{g.Attr("aria-label", "View name")},
+		}),
+		button.New(button.Props{Variant: "outline", Size: "sm", Type: "submit"}, g.Text("Save view")),
+		g.If(props.ActiveView != "",
+			button.New(
+				button.Props{
+					Variant: "ghost",
+					Size:    "sm",
+					Type:    "submit",
+					Attrs:   []g.Node{html.Name("delete"), html.Value("1")},
+				},
+				g.Text("Delete view"),
+			),
+		),
+	)
+}
+
+// renderColumnMenu renders the "View" dropdown with a checkbox per hideable column
+func renderColumnMenu(props Props) g.Node {
+	return dropdownmenu.New(
+		dropdownmenu.Props{},
+		button.New(
+			button.Props{
+				Variant: "outline",
+				Size:    "sm",
+				Attrs: []g.Node{
+					g.Attr("aria-haspopup", "menu"),
+					g.Attr("aria-expanded", "false"),
+					g.Attr("data-datatable-view-trigger", ""),
+				},
+			},
+			icons.Settings(html.Class("h-4 w-4")),
+			g.Text("View"),
+		),
+		dropdownmenu.DropdownContent(
+			dropdownmenu.ContentProps{
+				Class: "absolute right-0 mt-2 w-40",
+				Align: "end",
+				Attrs: []g.Node{
+					g.Attr("hidden"),
+					g.Attr("data-datatable-view-menu", ""),
+				},
+			},
+			dropdownmenu.DropdownLabel(dropdownmenu.LabelProps{}, "Toggle columns"),
+			dropdownmenu.Separator(dropdownmenu.SeparatorProps{}),
+			g.Group(g.Map(props.Columns, func(col Column) g.Node {
+				if !col.Hideable {
+					return nil
+				}
+				// The checkmark is always rendered and hidden with CSS so the
+				// script can toggle it along with aria-checked
+				checked := "true"
+				if isHidden(props, col) {
+					checked = "false"
+				}
+				return dropdownmenu.CheckboxItem(
+					dropdownmenu.CheckboxItemProps{
+						Checked: true,
+						Class:   "[&[aria-checked=false]>span>svg]:invisible",
+						Attrs: []g.Node{
+							g.Attr("aria-checked", checked),
+							g.Attr("data-datatable-toggle", col.ID),
+						},
+					},
+					g.Text(col.Header),
+				)
+			})),
+		),
+	)
+}
+
+// columnsScript wires up the column menu, drag-to-reorder, resize handles and
+// pinned column offsets, and keeps the save-view form's state in sync. Every
+// change dispatches a "datatable:viewchange" event with the view state.
+const columnsScript = `
+(function() {
+	function init(root) {
+		if (root.hasAttribute('data-datatable-ready')) return;
+		root.setAttribute('data-datatable-ready', '');
+		const table = root.querySelector('table');
+		if (!table) return;
+		const state = root.querySelector('[data-datatable-state]');
+
+		function headers() {
+			return Array.from(table.querySelectorAll('thead [data-column-id]'));
+		}
+		function cells(id) {
+			return table.querySelectorAll('[data-column-id="' + CSS.escape(id) + '"]');
+		}
+		function pin() {
+			let left = 0;
+			headers().forEach(function(th) {
+				if (th.dataset.pinned !== 'left' || th.hidden) return;
+				cells(th.dataset.columnId).forEach(function(c) { c.style.left = left + 'px'; });
+				left += th.offsetWidth;
+			});
+			let right = 0;
+			headers().reverse().forEach(function(th) {
+				if (th.dataset.pinned !== 'right' || th.hidden) return;
+				cells(th.dataset.columnId).forEach(function(c) { c.style.right = right + 'px'; });
+				right += th.offsetWidth;
+			});
+		}
+		function exports(view) {
+			const links = root.querySelectorAll('[data-datatable-export]');
+			if (!links.length) return;
+			const bytes = new TextEncoder().encode(JSON.stringify(view));
+			let binary = '';
+			bytes.forEach(function(b) { binary += String.fromCharCode(b); });
+			const encoded = btoa(binary).replace(/\+/g, '-').replace(/\//g, '_').replace(/=+$/, '');
+			links.forEach(function(a) {
+				const url = new URL(a.href, location.href);
+				url.searchParams.set('view', encoded);
+				a.href = url.toString();
+			});
+		}
+		function changed() {
+			pin();
+			const view = state && state.value ? JSON.parse(state.value) : {};
+			view.hidden = headers().filter(function(th) { return th.hidden; }).map(function(th) { return th.dataset.columnId; });
+			view.order = headers().map(function(th) { return th.dataset.columnId; });
+			view.widths = {};
+			headers().forEach(function(th) {
+				if (th.style.width) view.widths[th.dataset.columnId] = th.style.width;
+			});
+			const filter = root.querySelector('#datatable-filter');
+			if (filter) view.filter = filter.value;
+			if (state) state.value = JSON.stringify(view);
+			exports(view);
+			root.dispatchEvent(new CustomEvent('datatable:viewchange', { bubbles: true, detail: view }));
+		}
+
+		// Column visibility and export menus
+		root.querySelectorAll('[data-datatable-view-trigger], [data-datatable-menu-trigger]').forEach(function(trigger) {
+			const menu = trigger.nextElementSibling;
+			if (!menu) return;
+			function setOpen(open) {
+				menu.hidden = !open;
+				trigger.setAttribute('aria-expanded', String(open));
+				if (open) {
+					const first = menu.querySelector('[role^="menuitem"]');
+					if (first) first.focus();
+				}
+			}
+			trigger.addEventListener('click', function() { setOpen(menu.hidden); });
+			document.addEventListener('click', function(e) {
+				if (!menu.hidden && !menu.contains(e.target) && !trigger.contains(e.target)) setOpen(false);
+			});
+			menu.addEventListener('keydown', function(e) {
+				if (e.key === 'Escape') {
+					setOpen(false);
+					trigger.focus();
+				} else if ((e.key === 'Enter' || e.key === ' ') && e.target.hasAttribute('data-datatable-toggle')) {
+					e.preventDefault();
+					e.target.click();
+				}
+			});
+		});
+		root.querySelectorAll('[data-datatable-toggle]').forEach(function(item) {
+			item.addEventListener('click', function() {
+				const show = item.getAttribute('aria-checked') !== 'true';
+				item.setAttribute('aria-checked', String(show));
+				cells(item.dataset.datatableToggle).forEach(function(c) { c.hidden = !show; });
+				changed();
+			});
+		});
+
+		// Drag to reorder
+		let dragged = null;
+		headers().forEach(function(th) {
+			if (th.getAttribute('draggable') !== 'true') return;
+			th.addEventListener('dragstart', function(e) {
+				dragged = th;
+				e.dataTransfer.effectAllowed = 'move';
+				e.dataTransfer.setData('text/plain', th.dataset.columnId);
+			});
+			th.addEventListener('dragover', function(e) {
+				if (dragged && dragged !== th && (dragged.dataset.pinned || '') === (th.dataset.pinned || '')) e.preventDefault();
+			});
+			th.addEventListener('drop', function(e) {
+				e.preventDefault();
+				if (!dragged || dragged === th) return;
+				const all = headers();
+				const after = all.indexOf(dragged) < all.indexOf(th);
+				const from = dragged.dataset.columnId, to = th.dataset.columnId;
+				table.querySelectorAll('tr').forEach(function(tr) {
+					const src = tr.querySelector('[data-column-id="' + CSS.escape(from) + '"]');
+					const dst = tr.querySelector('[data-column-id="' + CSS.escape(to) + '"]');
+					if (src && dst) dst.insertAdjacentElement(after ? 'afterend' : 'beforebegin', src);
+				});
+				dragged = null;
+				changed();
+			});
+			th.addEventListener('dragend', function() { dragged = null; });
+		});
+
+		// Resize handles
+		root.querySelectorAll('[data-datatable-resize]').forEach(function(handle) {
+			const th = handle.closest('th');
+			handle.addEventListener('pointerdown', function(e) {
+				e.preventDefault();
+				e.stopPropagation();
+				const startX = e.clientX, startWidth = th.offsetWidth;
+				const draggable = th.getAttribute('draggable');
+				th.removeAttribute('draggable');
+				handle.setPointerCapture(e.pointerId);
+				function move(e) {
+					th.style.width = Math.max(40, startWidth + e.clientX - startX) + 'px';
+					pin();
+				}
+				function up() {
+					handle.removeEventListener('pointermove', move);
+					handle.removeEventListener('pointerup', up);
+					if (draggable) th.setAttribute('draggable', draggable);
+					changed();
+				}
+				handle.addEventListener('pointermove', move);
+				handle.addEventListener('pointerup', up);
+			});
+		});
+
+		const filter = root.querySelector('#datatable-filter');
+		if (filter) filter.addEventListener('change', changed);
+
+		pin();
+	}
+
+	document.querySelectorAll('[data-datatable]').forEach(init);
+})();
+`
@@ -1,7 +1,9 @@
 package datatable
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
@@ -15,15 +17,23 @@ import (
 
 // Column defines a column in the data table
 type Column struct {
-	ID         string      // Unique identifier for the column
-	Header     string      // Header text
-	Accessor   string      // Field accessor for data binding
-	Cell       CellFunc    // Custom cell renderer
-	Sortable   bool        // Whether column is sortable
-	Filterable bool        // Whether column is filterable
-	Width      string      // Column width (e.g., "100px", "20%")
-	Align      string      // Text alignment: "left", "center", "right"
-	Class      string      // Additional CSS classes
+	ID            string        // Unique identifier for the column
+	Header        string        // Header text
+	Accessor      string        // Field accessor for data binding
+	Cell          CellFunc      // Custom cell renderer
+	Sortable      bool          // Whether column is sortable
+	Filterable    bool          // Whether column is filterable
+	Width         string        // Column width (e.g., "100px", "20%")
+	Align         string        // Text alignment: "left", "center", "right"
+	Class         string        // Additional CSS classes
+	Hideable      bool          // Whether the column can be hidden from the view menu
+	Resizable     bool          // Whether the column has a resize handle
+	Pin           string        // Stick the column to an edge: "left", "right" or ""
+	Export        ExportFunc    // Formats the value for exports (defaults to the raw value)
+	Editable      bool          // Whether cells can be edited inline (requires Props.EditPath)
+	Editor        Editor        // Inline editor settings
+	Aggregate     AggregateFunc // Summarizes the column in group subtotals and the footer total (e.g. Sum)
+	AggregateCell CellFunc      // Renders the aggregate value; row is the aggregated rows
 }
 
 // CellFunc is a function that renders a cell
@@ -31,33 +41,51 @@ type CellFunc func(value interface{}, row interface{}) g.Node
 
 // Props defines properties for the DataTable component
 type Props struct {
-	ID              string      // Table ID
-	Columns         []Column    // Column definitions
-	Data            []interface{} // Table data
-	Caption         string      // Table caption
-	EmptyMessage    string      // Message when no data
-	Selectable      bool        // Enable row selection
-	SelectedRows    []int       // Currently selected row indices
-	Sortable        bool        // Enable sorting
-	SortColumn      string      // Currently sorted column ID
-	SortDirection   string      // "asc" or "desc"
-	Filterable      bool        // Enable filtering
-	FilterValue     string      // Current filter value
-	Pagination      bool        // Enable pagination
-	PageSize        int         // Rows per page
-	CurrentPage     int         // Current page (0-indexed)
-	TotalRows       int         // Total number of rows (for server-side pagination)
-	Loading         bool        // Show loading state
-	Striped         bool        // Striped rows
-	Hoverable       bool        // Highlight rows on hover
-	Dense           bool        // Compact table layout
-	ShowHeader      bool        // Show/hide header
-	StickyHeader    bool        // Make header sticky
-	Class           string      // Additional CSS classes
-	OnSort          string      // JavaScript to run on sort
-	OnFilter        string      // JavaScript to run on filter
-	OnPageChange    string      // JavaScript to run on page change
-	OnRowSelect     string      // JavaScript to run on row selection
+	ID            string            // Table ID
+	Columns       []Column          // Column definitions
+	Data          []interface{}     // Table data
+	Caption       string            // Table caption
+	EmptyMessage  string            // Message when no data
+	Selectable    bool              // Enable row selection
+	SelectedRows  []int             // Currently selected row indices
+	Sortable      bool              // Enable sorting
+	SortColumn    string            // Currently sorted column ID
+	SortDirection string            // "asc" or "desc"
+	Filterable    bool              // Enable filtering
+	FilterValue   string            // Current filter value
+	Pagination    bool              // Enable pagination
+	PageSize      int               // Rows per page
+	CurrentPage   int               // Current page (0-indexed)
+	TotalRows     int               // Total number of rows (for server-side pagination)
+	Loading       bool              // Show loading state
+	Striped       bool              // Striped rows
+	Hoverable     bool              // Highlight rows on hover
+	Dense         bool              // Compact table layout
+	ShowHeader    bool              // Show/hide header
+	StickyHeader  bool              // Make header sticky
+	Class         string            // Additional CSS classes
+	OnSort        string            // JavaScript to run on sort
+	OnFilter      string            // JavaScript to run on filter
+	OnPageChange  string            // JavaScript to run on page change
+	OnRowSelect   string            // JavaScript to run on row selection
+	HiddenColumns []string          // IDs of hidden columns
+	ColumnOrder   []string          // Column IDs in display order; unlisted columns follow in definition order
+	ColumnWidths  map[string]string // Column widths by ID, overriding Column.Width
+	ColumnMenu    bool              // Show the "View" menu for toggling hideable columns
+	Reorderable   bool              // Allow reordering columns by dragging their headers
+	Views         []View            // Saved views offered in the toolbar
+	ActiveView    string            // Name of the view currently applied
+	ViewsPath     string            // Path the "Save view" form posts to (see Handlers)
+	ExportPath    string            // ExportHandler path; shows the export menu in the toolbar
+	RowKey        string            // Accessor of the value that identifies a row (required for editing)
+	EditPath      string            // Path of the inline edit endpoint (see Handlers)
+	BulkPath      string            // Path bulk actions post the selected rows to
+	BulkActions   []BulkAction      // Actions offered for the selected rows
+	GroupBy       []string          // Column IDs to group rows by, outermost first
+	SubRows       string            // Accessor of each row's child rows, rendering the data as a tree
+	Collapsed     bool              // Render groups and sub-rows collapsed
+	DetailPath    string            // Path expandable detail rows are loaded from (requires ID and RowKey)
+	Attrs         []g.Node          // Additional attributes to pass through
 }
 
 // New creates a new DataTable component
@@ -72,6 +100,7 @@ func New(props Props) g.Node {
 	if props.ShowHeader == false && props.Caption == "" {
 		props.ShowHeader = true
 	}
+	props.Columns = arrangeColumns(props)
 
 	// Calculate pagination
 	totalRows := props.TotalRows
@@ -81,19 +110,7 @@ func New(props Props) g.Node {
 	totalPages := (totalRows + props.PageSize - 1) / props.PageSize
 	
 	// Paginate data if client-side pagination
-	displayData := props.Data
-	if props.Pagination && props.TotalRows == 0 {
-		start := props.CurrentPage * props.PageSize
-		end := start + props.PageSize
-		if end > len(props.Data) {
-			end = len(props.Data)
-		}
-		if start < len(props.Data) {
-			displayData = props.Data[start:end]
-		} else {
-			displayData = []interface{}{}
-		}
-	}
+	displayData := pageData(props)
 
 	// Build table classes
 	tableClasses := []string{}
@@ -116,12 +133,13 @@ func New(props Props) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(wrapperClasses),
 		g.If(props.ID != "", html.ID(props.ID)),
+		g.Attr("data-datatable", ""),
 
-		// Header with filter
-		g.If(props.Filterable,
+		// Header with filter and view controls
+		g.If(props.Filterable || hasViewControls(props),
 			renderTableHeader(props),
 		),
 
@@ -140,13 +158,21 @@ func New(props Props) g.Node {
 			),
 			// Body
 			renderTableBody(props, displayData),
+			// Aggregates
+			g.Iff(hasAggregates(props) && !props.Loading && len(displayData) > 0, func() g.Node {
+				return renderAggregateFooter(props)
+			}),
 		),
 
 		// Footer with pagination
 		g.If(props.Pagination,
 			renderTableFooter(props, totalPages, totalRows, len(displayData)),
 		),
-	)
+
+		g.If(hasColumnFeatures(props), html.Script(g.Raw(columnsScript))),
+		g.If(hasEditing(props), html.Script(g.Raw(editScript))),
+		g.If(hasExpansion(props), html.Script(g.Raw(expansionScript))),
+	}, props.Attrs)...)
 }
 
 // renderTableHeader renders the filter header
@@ -156,21 +182,23 @@ func renderTableHeader(props Props) g.Node {
 		// Filter input
 		html.Div(
 			html.Class("flex items-center gap-2"),
-			icons.Search(html.Class("h-4 w-4 text-muted-foreground")),
-			input.New(
-				input.Props{
-					Type:        "text",
-					Placeholder: "Filter...",
-					Value:       props.FilterValue,
-					Class:       "max-w-sm",
-					ID:          "datatable-filter",
-				},
-			),
+			g.If(props.Filterable, g.Group{
+				icons.Search(html.Class("h-4 w-4 text-muted-foreground")),
+				input.New(
+					input.Props{
+						Type:        "text",
+						Placeholder: "Filter...",
+						Value:       props.FilterValue,
+						Class:       "max-w-sm",
+						ID:          "datatable-filter",
+					},
+				),
+			}),
 		),
-		// Additional toolbar space
+		// Saved views and column visibility
 		html.Div(
 			html.Class("flex items-center gap-2"),
-			// Space for additional controls
+			renderViewControls(props),
 		),
 	)
 }
@@ -194,6 +222,13 @@ func renderTableHead(props Props, data []interface{}) g.Node {
 					),
 				),
 			),
+			// Detail toggle column
+			g.If(isExpandable(props),
+				table.Head(
+					table.HeadProps{Class: "w-[40px]"},
+					html.Span(html.Class("sr-only"), g.Text("Details")),
+				),
+			),
 			// Data columns
 			g.Group(g.Map(props.Columns, func(col Column) g.Node {
 				// Build header classes
@@ -219,11 +254,14 @@ func renderTableHead(props Props, data []interface{}) g.Node {
 					}(),
 				)
 
+				width := columnWidth(props, col)
+
 				return table.Head(
 					table.HeadProps{
-						Class: headerClasses,
+						Class: lib.CN(headerClasses, columnClasses(props, col)),
+						Attrs: columnHeadAttrs(props, col),
 					},
-					g.If(col.Width != "", html.Style(fmt.Sprintf("width: %s", col.Width))),
+					g.If(width != "", html.Style(fmt.Sprintf("width: %s", width))),
 					g.If(props.Sortable && col.Sortable,
 						html.Div(
 							html.Class(lib.CN(
@@ -251,12 +289,27 @@ func renderTableHead(props Props, data []interface{}) g.Node {
 					g.If(props.OnSort != "" && col.Sortable,
 						g.Attr("data-column", col.ID),
 					),
+					g.If(col.Resizable, resizeHandle()),
 				)
 			})),
 		),
 	)
 }
 
+// pageData returns the rows shown on the current page. Pages hold 10 rows
+// unless PageSize is set.
+func pageData(props Props) []interface{} {
+	if !props.Pagination || props.TotalRows != 0 {
+		return props.Data
+	}
+	size := cmp.Or(props.PageSize, 10)
+	start := props.CurrentPage * size
+	if start >= len(props.Data) {
+		return []interface{}{}
+	}
+	return props.Data[start:min(start+size, len(props.Data))]
+}
+
 // renderTableBody renders the table body
 func renderTableBody(props Props, data []interface{}) g.Node {
 	// Loading state
@@ -267,12 +320,7 @@ func renderTableBody(props Props, data []interface{}) g.Node {
 				table.RowProps{},
 				table.Cell(
 					table.CellProps{
-						ColSpan: len(props.Columns) + func() int {
-							if props.Selectable {
-								return 1
-							}
-							return 0
-						}(),
+						ColSpan: totalColumnCount(props),
 						Class: "h-24 text-center",
 					},
 					html.Div(
@@ -293,12 +341,7 @@ func renderTableBody(props Props, data []interface{}) g.Node {
 				table.RowProps{},
 				table.Cell(
 					table.CellProps{
-						ColSpan: len(props.Columns) + func() int {
-							if props.Selectable {
-								return 1
-							}
-							return 0
-						}(),
+						ColSpan: totalColumnCount(props),
 						Class: "h-24 text-center text-muted-foreground",
 					},
 					g.Text(props.EmptyMessage),
@@ -308,75 +351,97 @@ func renderTableBody(props Props, data []interface{}) g.Node {
 	}
 
 	// Data rows
-	var rows []g.Node
-	for index, row := range data {
-		isSelected := false
-		for _, selectedIndex := range props.SelectedRows {
-			if selectedIndex == index {
-				isSelected = true
-				break
-			}
-		}
+	return table.Body(
+		table.Props{},
+		g.Group(renderDataRows(props, data)),
+	)
+}
 
-		rowNode := table.Row(
-			table.RowProps{
-				Class: lib.CN(
-					func() string {
-						if isSelected {
-							return "bg-muted"
-						}
-						return ""
-					}(),
-				),
-			},
-				// Selection checkbox
-				g.If(props.Selectable,
-					table.Cell(
-						table.CellProps{},
-						checkbox.New(
-							checkbox.Props{
-								ID:       fmt.Sprintf("select-%d", index),
-								Checked:  isSelected,
-								Value:    fmt.Sprintf("%d", index),
-								OnChange: props.OnRowSelect,
-							},
-						),
+// renderRow renders a data row. Rows of tables with a RowKey are identified
+// by their key so that edit handlers can render a single row again. Rows
+// without an index are sub-rows, which are only selectable by key.
+func renderRow(props Props, index int, row interface{}, isSelected bool, level rowLevel) g.Node {
+	key := rowKey(props, row)
+	value := fmt.Sprintf("%d", index)
+	id := ""
+	if props.RowKey != "" {
+		value = key
+		if props.ID != "" {
+			id = rowID(props, key)
+		}
+	}
+	name := ""
+	if len(props.BulkActions) > 0 {
+		name = "rows"
+	}
+	first := slices.IndexFunc(props.Columns, func(col Column) bool { return !isHidden(props, col) })
+
+	return table.Row(
+		table.RowProps{
+			Class: lib.CN(
+				func() string {
+					if isSelected {
+						return "bg-muted"
+					}
+					return ""
+				}(),
+			),
+			ID:    id,
+			Attrs: append([]g.Node{g.If(props.RowKey != "", g.Attr("data-row-key", key))}, levelAttrs(props, level)...),
+		},
+		// Selection checkbox
+		g.If(props.Selectable,
+			table.Cell(
+				table.CellProps{},
+				g.If(index >= 0 || props.RowKey != "",
+					checkbox.New(
+						checkbox.Props{
+							ID:       "select-" + value,
+							Name:     name,
+							Checked:  isSelected,
+							Value:    value,
+							OnChange: props.OnRowSelect,
+						},
 					),
 				),
-				// Data cells
-				g.Group(g.Map(props.Columns, func(col Column) g.Node {
-					cellClasses := lib.CN(
-						func() string {
-							if col.Align == "center" {
-								return "text-center"
-							}
-							return ""
-						}(),
-						func() string {
-							if col.Align == "right" {
-								return "text-right"
-							}
-							return ""
-						}(),
-					)
-
-					return table.Cell(
-						table.CellProps{
-							Class: cellClasses,
-						},
-						renderCellContent(col, row),
-					)
-				})),
-		)
-		rows = append(rows, rowNode)
-	}
+			),
+		),
+		// Detail toggle
+		g.If(isExpandable(props),
+			table.Cell(table.CellProps{}, detailButton(props, key)),
+		),
+		// Data cells
+		g.Group(g.Map(props.Columns, func(col Column) g.Node {
+			content := renderCellContent(col, row)
+			if isEditable(props, col) {
+				content = editableCell(props, col, row)
+			}
+			if first >= 0 && col.ID == props.Columns[first].ID {
+				content = levelCell(props, level, content)
+			}
 
-	return table.Body(
-		table.Props{},
-		g.Group(rows),
+			return table.Cell(
+				table.CellProps{
+					Class: lib.CN(alignClass(col), columnClasses(props, col)),
+					Attrs: columnCellAttrs(props, col),
+				},
+				content,
+			)
+		})),
 	)
 }
 
+// alignClass returns the text alignment class of a column's cells
+func alignClass(col Column) string {
+	switch col.Align {
+	case "center":
+		return "text-center"
+	case "right":
+		return "text-right"
+	}
+	return ""
+}
+
 // renderCellContent renders the content of a cell
 func renderCellContent(col Column, row interface{}) g.Node {
 	// If custom cell renderer is provided
@@ -614,6 +679,36 @@ func (b *ColumnBuilder) Filterable() *ColumnBuilder {
 	return b
 }
 
+// Hideable lets the column be hidden from the view menu
+func (b *ColumnBuilder) Hideable() *ColumnBuilder {
+	b.column.Hideable = true
+	return b
+}
+
+// Resizable adds a resize handle to the column header
+func (b *ColumnBuilder) Resizable() *ColumnBuilder {
+	b.column.Resizable = true
+	return b
+}
+
+// WithPin sticks the column to the "left" or "right" edge
+func (b *ColumnBuilder) WithPin(side string) *ColumnBuilder {
+	b.column.Pin = side
+	return b
+}
+
+// WithExport sets the formatter used for exports
+func (b *ColumnBuilder) WithExport(export ExportFunc) *ColumnBuilder {
+	b.column.Export = export
+	return b
+}
+
+// WithAggregate summarizes the column with fn
+func (b *ColumnBuilder) WithAggregate(fn AggregateFunc) *ColumnBuilder {
+	b.column.Aggregate = fn
+	return b
+}
+
 // WithWidth sets the column width
 func (b *ColumnBuilder) WithWidth(width string) *ColumnBuilder {
 	b.column.Width = width
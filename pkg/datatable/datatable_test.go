@@ -3,13 +3,83 @@ package datatable
 import (
 	"bytes"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"testing"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/selector"
 )
 
+// testTables are the tables the export, edit and grouping tests render, by ID
+var testTables = map[string]Props{
+	"products": {
+		Columns: []Column{
+			{ID: "id", Header: "ID", Accessor: "id"},
+			{ID: "name", Header: "Name", Accessor: "name", Filterable: true},
+			{
+				ID: "price", Header: "Price", Accessor: "price",
+				Export: func(value interface{}, row interface{}) interface{} {
+					return value.(float64) * 100
+				},
+			},
+		},
+		Data: []interface{}{
+			map[string]interface{}{"id": 1, "name": "Widget, large", "price": 2.5},
+			map[string]interface{}{"id": 2, "name": "Gadget", "price": 1.25},
+			map[string]interface{}{"id": 3, "name": "Gizmo <b>", "price": 4.0},
+		},
+	},
+	"users": {
+		Columns: []Column{
+			{ID: "name", Header: "Name", Accessor: "name", Editable: true, Editor: Editor{Required: true}},
+			{ID: "role", Header: "Role", Accessor: "role", Editable: true, Editor: Editor{
+				Type:    "select",
+				Options: []selector.OptionType{{Value: "admin", Label: "Admin"}, {Value: "user", Label: "User"}},
+			}},
+			{ID: "active", Header: "Active", Accessor: "active", Editable: true, Editor: Editor{Type: "switch"}},
+		},
+		Data: []interface{}{
+			map[string]interface{}{"id": 1, "name": "John Doe", "role": "admin", "active": true},
+			map[string]interface{}{"id": 2, "name": "Jane Smith", "role": "user", "active": false},
+		},
+		RowKey:      "id",
+		EditPath:    "/users/edit",
+		Selectable:  true,
+		BulkPath:    "/users/bulk",
+		BulkActions: []BulkAction{{Name: "delete", Label: "Delete", Variant: "destructive", Confirm: "Delete rows?"}},
+	},
+	"sales": {
+		Columns: []Column{
+			{ID: "region", Header: "Region", Accessor: "region"},
+			{ID: "rep", Header: "Rep", Accessor: "rep"},
+			{ID: "amount", Header: "Amount", Accessor: "amount", Aggregate: Sum},
+		},
+		Data: []interface{}{
+			map[string]interface{}{"region": "North", "rep": "Ann", "amount": 100},
+			map[string]interface{}{"region": "South", "rep": "Bob", "amount": 50},
+			map[string]interface{}{"region": "North", "rep": "Cid", "amount": 25.5},
+		},
+		GroupBy: []string{"region"},
+	},
+}
+
+// testTable returns a copy of the test table with id, whose columns and rows
+// a test can change without affecting the others
+func testTable(id string) Props {
+	props := testTables[id]
+	props.ID = id
+	props.Columns = slices.Clone(props.Columns)
+	props.Data = slices.Clone(props.Data)
+	for i, row := range props.Data {
+		props.Data[i] = maps.Clone(row.(map[string]interface{}))
+	}
+	return props
+}
+
 func TestNew(t *testing.T) {
 	// Test data
 	testData := []interface{}{
@@ -514,4 +584,142 @@ func TestPagination(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestColumnFeatures(t *testing.T) {
+	testData := []interface{}{
+		map[string]interface{}{"id": 1, "name": "John Doe", "email": "john@example.com"},
+	}
+
+	testColumns := []Column{
+		{ID: "id", Header: "ID", Accessor: "id"},
+		{ID: "name", Header: "Name", Accessor: "name", Hideable: true},
+		{ID: "email", Header: "Email", Accessor: "email", Hideable: true, Resizable: true},
+	}
+
+	tests := []struct {
+		name     string
+		props    Props
+		order    []string
+		contains []string
+		excludes []string
+	}{
+		{
+			name: "column order",
+			props: Props{
+				Columns:     testColumns,
+				Data:        testData,
+				ColumnOrder: []string{"email", "id"},
+			},
+			order: []string{`data-column-id="email"`, `data-column-id="id"`, `data-column-id="name"`},
+		},
+		{
+			name: "pinned columns",
+			props: Props{
+				Columns: []Column{
+					{ID: "id", Header: "ID", Accessor: "id", Pin: "right"},
+					{ID: "name", Header: "Name", Accessor: "name"},
+					{ID: "email", Header: "Email", Accessor: "email", Pin: "left"},
+				},
+				Data: testData,
+			},
+			order:    []string{`data-column-id="email"`, `data-column-id="name"`, `data-column-id="id"`},
+			contains: []string{`sticky left-0`, `sticky right-0`, `data-pinned="left"`, `data-datatable-ready`},
+		},
+		{
+			name: "hidden columns",
+			props: Props{
+				Columns:       testColumns,
+				Data:          []interface{}{},
+				HiddenColumns: []string{"email"},
+			},
+			contains: []string{`data-column-id="email" hidden`, `colspan="2"`},
+		},
+		{
+			name: "column menu",
+			props: Props{
+				Columns:       testColumns,
+				Data:          testData,
+				ColumnMenu:    true,
+				HiddenColumns: []string{"email"},
+			},
+			contains: []string{
+				`data-datatable-view-trigger`,
+				`data-datatable-toggle="name"`,
+				`data-datatable-toggle="email"`,
+				`aria-checked="false"`,
+			},
+			excludes: []string{`data-datatable-toggle="id"`},
+		},
+		{
+			name: "resize and reorder",
+			props: Props{
+				Columns:      testColumns,
+				Data:         testData,
+				Reorderable:  true,
+				ColumnWidths: map[string]string{"email": "240px"},
+			},
+			contains: []string{`draggable="true"`, `data-datatable-resize`, `width: 240px`},
+		},
+		{
+			name: "saved views",
+			props: Props{
+				Columns:    testColumns,
+				Data:       testData,
+				Views:      []View{{Name: "Compact"}, {Name: "Wide"}},
+				ActiveView: "Wide",
+				ViewsPath:  "/views",
+			},
+			contains: []string{
+				`name="view"`,
+				`<option value="Wide" selected>`,
+				`action="/views"`,
+				`data-datatable-state`,
+				`Delete view`,
+			},
+		},
+		{
+			name: "no column features",
+			props: Props{
+				Columns: testColumns[:2],
+				Data:    testData,
+			},
+			excludes: []string{`data-datatable-ready`, `data-datatable-view-trigger`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := New(tt.props).Render(&buf); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html := buf.String()
+
+			rest := html
+			for _, want := range tt.order {
+				i := strings.Index(rest, want)
+				if i < 0 {
+					t.Fatalf("expected %q in order, but got:\n%s", want, html)
+				}
+				rest = rest[i+len(want):]
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected HTML to contain %q, but got:\n%s", want, html)
+				}
+			}
+			for _, notWant := range tt.excludes {
+				if strings.Contains(html, notWant) {
+					t.Errorf("expected HTML not to contain %q", notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
@@ -0,0 +1,399 @@
+package datatable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/datepicker"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/input"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/selector"
+	switchcomp "github.com/rizome-dev/shadcn-gomponents/pkg/switch"
+	g "maragu.dev/gomponents"
+	hx "maragu.dev/gomponents-htmx"
+	html "maragu.dev/gomponents/html"
+)
+
+// Editor configures the inline editor of an editable column
+type Editor struct {
+	Type        string                // "text" (default) | "number" | "email" | "select" | "switch" | "date"
+	Options     []selector.OptionType // Choices for "select"
+	Placeholder string                // Placeholder text
+	Required    bool                  // Whether a value is required
+}
+
+// BulkAction is an action applied to the selected rows
+type BulkAction struct {
+	Name    string // Action name posted to BulkPath
+	Label   string // Button text
+	Variant string // Button variant (default: "outline")
+	Confirm string // Confirmation prompt shown before the action runs
+}
+
+// RowStore loads and updates the rows of an editable table
+type RowStore interface {
+	// Row returns the row identified by key
+	Row(ctx context.Context, key string) (interface{}, error)
+	// UpdateCell sets a column of a row from the submitted editor value and
+	// returns the updated row. Rejected values are reported with a
+	// *ValidationError.
+	UpdateCell(ctx context.Context, key, column, value string) (interface{}, error)
+	// Bulk applies action to the rows identified by keys
+	Bulk(ctx context.Context, action string, keys []string) error
+}
+
+// ValidationError reports a rejected value; its message is shown below the
+// editor
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// isEditable reports whether the cells of col are edited inline
+func isEditable(props Props, col Column) bool {
+	return col.Editable && props.EditPath != "" && props.RowKey != ""
+}
+
+// hasEditing reports whether the table needs the edit script
+func hasEditing(props Props) bool {
+	return slices.ContainsFunc(props.Columns, func(col Column) bool { return isEditable(props, col) })
+}
+
+// hasBulkActions reports whether the toolbar shows bulk actions
+func hasBulkActions(props Props) bool {
+	return props.Selectable && props.BulkPath != "" && len(props.BulkActions) > 0
+}
+
+// rowKey returns the key of a row as text
+func rowKey(props Props, row interface{}) string {
+	if props.RowKey == "" {
+		return ""
+	}
+	return exportText(getFieldValue(row, props.RowKey))
+}
+
+// keyID encodes a row key for element ids, so that the ids can be used as
+// CSS selectors. Letters, digits and '-' are kept and any other byte is
+// written as '_' and two hex digits, which keeps distinct keys distinct.
+func keyID(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if c == '-' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "_%02x", c)
+	}
+	return b.String()
+}
+
+// rowID returns the element id of a row
+func rowID(props Props, key string) string {
+	return props.ID + "-row-" + keyID(key)
+}
+
+// cellID returns the element id prefix of a cell's editor
+func cellID(props Props, key string, col Column) string {
+	return props.ID + "-" + keyID(key) + "-" + col.ID
+}
+
+// editURL returns the edit endpoint for a cell
+func editURL(props Props, key string, col Column, extra ...string) string {
+	query := url.Values{"row": {key}, "column": {col.ID}}
+	for i := 0; i+1 < len(extra); i += 2 {
+		query.Set(extra[i], extra[i+1])
+	}
+	return props.EditPath + "?" + query.Encode()
+}
+
+// editableCell renders a cell value as a button that swaps in its editor
+func editableCell(props Props, col Column, row interface{}) g.Node {
+	key := rowKey(props, row)
+	return html.Button(
+		html.Type("button"),
+		html.Class("-mx-2 -my-1 w-[calc(100%+1rem)] rounded-sm px-2 py-1 text-[inherit] hover:bg-accent focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring"),
+		g.Attr("aria-label", fmt.Sprintf("Edit %s", col.Header)),
+		hx.Get(editURL(props, key, col)),
+		hx.Target("closest td"),
+		hx.Swap("innerHTML"),
+		renderCellContent(col, row),
+	)
+}
+
+// EditCell renders the inline editor of a column for row, with message
+// shown as a validation error when it is not empty
+func EditCell(props Props, col Column, row interface{}, value, message string) g.Node {
+	key := rowKey(props, row)
+	id := cellID(props, key, col)
+	errorID := id + "-error"
+
+	return html.Form(
+		html.ID(id+"-editor"),
+		html.Class("group flex items-center gap-2"),
+		g.Attr("data-datatable-editor", ""),
+		hx.Post(props.EditPath),
+		hx.Target("closest tr"),
+		hx.Swap("outerHTML"),
+		g.Attr("hx-include", "closest tr [name='rows']"),
+		html.Input(html.Type("hidden"), html.Name("row"), html.Value(key)),
+		html.Input(html.Type("hidden"), html.Name("column"), html.Value(col.ID)),
+		html.Div(
+			html.Class("grid flex-1 gap-1 group-data-[pending]:hidden"),
+			renderEditor(col, id+"-input", value, message, errorID),
+			g.If(message != "",
+				html.P(
+					html.ID(errorID),
+					html.Class("text-sm text-destructive"),
+					g.Text(message),
+				),
+			),
+		),
+		html.Span(
+			html.Class("hidden opacity-60 group-data-[pending]:inline"),
+			g.Attr("data-datatable-preview", ""),
+		),
+		html.Div(
+			html.Class("flex gap-1 group-data-[pending]:hidden"),
+			button.New(button.Props{Size: "sm", Type: "submit"}, g.Text("Save")),
+			button.New(
+				button.Props{
+					Variant: "ghost",
+					Size:    "sm",
+					Attrs: []g.Node{
+						hx.Get(editURL(props, key, col, "cancel", "1")),
+						hx.Target("closest td"),
+						hx.Swap("innerHTML"),
+						hx.Trigger("click, keyup[key=='Escape'] from:closest form"),
+					},
+				},
+				g.Text("Cancel"),
+			),
+		),
+	)
+}
+
+// renderEditor renders the form control chosen by the column's editor type
+func renderEditor(col Column, id, value, message, errorID string) g.Node {
+	invalid := []g.Node{
+		g.If(message != "", g.Attr("aria-invalid", "true")),
+		g.If(message != "", g.Attr("aria-describedby", errorID)),
+	}
+
+	switch col.Editor.Type {
+	case "select":
+		return selector.New(selector.Props{
+			ID:          id,
+			Name:        "value",
+			Value:       value,
+			Placeholder: col.Editor.Placeholder,
+			Options:     col.Editor.Options,
+			Required:    col.Editor.Required,
+			Size:        "sm",
+			Attrs:       append([]g.Node{g.Attr("aria-label", col.Header), html.AutoFocus()}, invalid...),
+		})
+	case "switch":
+		return html.Div(
+			// Unchecked switches submit the hidden value instead
+			html.Input(html.Type("hidden"), html.Name("value"), html.Value("false")),
+			switchcomp.New(switchcomp.Props{
+				ID:      id,
+				Name:    "value",
+				Value:   "true",
+				Checked: value == "true",
+				Size:    "sm",
+				Attrs:   append([]g.Node{html.Span(html.Class("sr-only"), g.Text(col.Header))}, invalid...),
+			}),
+		)
+	case "date":
+		date, _ := time.Parse("2006-01-02", value)
+		return datepicker.WithInput(datepicker.InputProps{
+			ID:          id,
+			Name:        "value",
+			Value:       date,
+			Placeholder: col.Editor.Placeholder,
+			Required:    col.Editor.Required,
+			Attrs:       invalid,
+		})
+	}
+
+	typ := col.Editor.Type
+	if typ == "" {
+		typ = "text"
+	}
+	return input.New(input.Props{
+		Type:        typ,
+		ID:          id,
+		Name:        "value",
+		Value:       value,
+		Placeholder: col.Editor.Placeholder,
+		Required:    col.Editor.Required,
+		AriaInvalid: message != "",
+		Class:       "h-8",
+		Attrs: []g.Node{
+			g.Attr("aria-label", col.Header),
+			html.AutoFocus(),
+			g.If(message != "", g.Attr("aria-describedby", errorID)),
+		},
+	})
+}
+
+// editorValue returns the editor value of a column for row
+func editorValue(col Column, row interface{}) string {
+	value := getFieldValue(row, col.Accessor)
+	if t, ok := value.(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("2006-01-02")
+	}
+	return exportText(value)
+}
+
+// renderBulkActions renders the buttons that post the selected rows
+func renderBulkActions(props Props) g.Node {
+	return html.Div(
+		html.Class("flex items-center gap-2"),
+		g.Attr("role", "group"),
+		g.Attr("aria-label", "Bulk actions"),
+		g.Group(g.Map(props.BulkActions, func(a BulkAction) g.Node {
+			variant := a.Variant
+			if variant == "" {
+				variant = "outline"
+			}
+			vals, _ := json.Marshal(map[string]string{"action": a.Name})
+			return button.New(
+				button.Props{
+					Variant: variant,
+					Size:    "sm",
+					Attrs: []g.Node{
+						hx.Post(props.BulkPath),
+						hx.Vals(string(vals)),
+						g.Attr("hx-include", "#"+props.ID+" [name='rows']"),
+						hx.Target("#" + props.ID),
+						hx.Swap("outerHTML"),
+						g.If(a.Confirm != "", hx.Confirm(a.Confirm)),
+					},
+				},
+				g.Text(a.Label),
+			)
+		})),
+	)
+}
+
+// serveEdit renders a cell editor (GET), or the cell again when cancelled,
+// and saves an edit (POST). A saved row is rendered again in full; a
+// rejected value re-renders the editor with its error in place.
+func (h Handlers) serveEdit(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form", http.StatusBadRequest)
+		return
+	}
+	props := h.Table(r)
+	props.Columns = arrangeColumns(props)
+	key := r.Form.Get("row")
+	i := slices.IndexFunc(props.Columns, func(col Column) bool { return col.ID == r.Form.Get("column") })
+	if key == "" || i < 0 || !isEditable(props, props.Columns[i]) {
+		http.Error(w, "Unknown cell", http.StatusBadRequest)
+		return
+	}
+	col := props.Columns[i]
+
+	if r.Method == http.MethodGet {
+		row, err := h.Rows.Row(r.Context(), key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if r.Form.Get("cancel") != "" {
+			editableCell(props, col, row).Render(w)
+			return
+		}
+		EditCell(props, col, row, editorValue(col, row), "").Render(w)
+		return
+	}
+
+	values := r.PostForm["value"]
+	value := ""
+	if len(values) > 0 {
+		value = values[len(values)-1]
+	}
+	row, err := h.Rows.UpdateCell(r.Context(), key, col.ID, value)
+	var invalid *ValidationError
+	if errors.As(err, &invalid) {
+		current, rowErr := h.Rows.Row(r.Context(), key)
+		if rowErr != nil {
+			http.Error(w, rowErr.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("HX-Retarget", "#"+cellID(props, key, col)+"-editor")
+		w.Header().Set("HX-Reswap", "outerHTML")
+		EditCell(props, col, current, value, invalid.Message).Render(w)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// The row keeps its place in the groups and tree rows it was edited in
+	index, level, _ := findRow(props, pageData(props), key)
+	selected := slices.Contains(r.PostForm["rows"], key)
+	renderRow(props, index, row, selected, level).Render(w)
+}
+
+// serveBulk applies a bulk action to the posted rows and renders the table
+func (h Handlers) serveBulk(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form", http.StatusBadRequest)
+		return
+	}
+	action := r.PostForm.Get("action")
+	if action == "" {
+		http.Error(w, "Action is required", http.StatusBadRequest)
+		return
+	}
+	if err := h.Rows.Bulk(r.Context(), action, r.PostForm["rows"]); err != nil {
+		var invalid *ValidationError
+		if errors.As(err, &invalid) {
+			http.Error(w, invalid.Message, http.StatusUnprocessableEntity)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	New(h.Table(r)).Render(w)
+}
+
+// editScript shows the submitted value in place of the editor while a save
+// is in flight, and restores the editor if the request fails
+const editScript = `
+(function() {
+	if (window.shadcnDataTableEdit) return;
+	window.shadcnDataTableEdit = true;
+	document.addEventListener('htmx:beforeRequest', function(e) {
+		const form = e.detail.elt;
+		if (!form.matches || !form.matches('form[data-datatable-editor]')) return;
+		const field = Array.from(form.querySelectorAll('[name="value"]')).pop();
+		let text = field ? field.value : '';
+		if (field && field.type === 'checkbox') text = field.checked ? 'Yes' : 'No';
+		if (field && field.tagName === 'SELECT' && field.selectedIndex >= 0) text = field.options[field.selectedIndex].text;
+		const preview = form.querySelector('[data-datatable-preview]');
+		if (preview) preview.textContent = text;
+		form.setAttribute('data-pending', '');
+	});
+	document.addEventListener('htmx:afterRequest', function(e) {
+		const form = e.detail.elt;
+		if (form.matches && form.matches('form[data-datatable-editor]') && e.detail.failed) form.removeAttribute('data-pending');
+	});
+})();
+`
@@ -0,0 +1,236 @@
+package datatable
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+)
+
+// testRows is an in-memory RowStore of a test table, keyed by the "id" field
+type testRows struct {
+	props Props
+	bulk  []string
+}
+
+// Table returns the table with its current rows
+func (s *testRows) Table(r *http.Request) Props {
+	return s.props
+}
+
+func (s *testRows) Row(ctx context.Context, key string) (interface{}, error) {
+	for _, row := range s.props.Data {
+		if fmt.Sprint(row.(map[string]interface{})["id"]) == key {
+			return row, nil
+		}
+	}
+	return nil, fmt.Errorf("row %s not found", key)
+}
+
+func (s *testRows) UpdateCell(ctx context.Context, key, column, value string) (interface{}, error) {
+	row, err := s.Row(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if column == "name" && value == "" {
+		return nil, &ValidationError{Message: "Name is required"}
+	}
+	m := row.(map[string]interface{})
+	m[column] = value
+	if column == "active" {
+		m[column] = value == "true"
+	}
+	return m, nil
+}
+
+func (s *testRows) Bulk(ctx context.Context, action string, keys []string) error {
+	s.bulk = append(s.bulk, action+":"+strings.Join(keys, ","))
+	return nil
+}
+
+func TestEditableTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(testTable("users")).Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+
+	for _, want := range []string{
+		`id="users-row-1"`,
+		`data-row-key="2"`,
+		`hx-get="/users/edit?column=name&amp;row=1"`,
+		`hx-target="closest td"`,
+		`aria-label="Edit Name"`,
+		`name="rows"`,
+		`value="2"`,
+		`hx-post="/users/bulk"`,
+		`hx-vals="{&#34;action&#34;:&#34;delete&#34;}"`,
+		`hx-include="#users [name=&#39;rows&#39;]"`,
+		`hx-confirm="Delete rows?"`,
+		`shadcnDataTableEdit`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected HTML to contain %q", want)
+		}
+	}
+}
+
+func TestHandlersEdit(t *testing.T) {
+	store := &testRows{props: testTable("users")}
+	mux := http.NewServeMux()
+	Handlers{EditPath: "/users/edit", BulkPath: "/users/bulk", Rows: store, Table: store.Table}.Register(router.ServeMux(mux))
+
+	get := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/edit?"+query, nil))
+		return rec
+	}
+	post := func(path string, form url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, r)
+		return rec
+	}
+
+	tests := []struct {
+		name     string
+		rec      *httptest.ResponseRecorder
+		code     int
+		contains []string
+		headers  map[string]string
+	}{
+		{
+			name:     "text editor",
+			rec:      get("row=1&column=name"),
+			code:     http.StatusOK,
+			contains: []string{`id="users-1-name-editor"`, `hx-post="/users/edit"`, `value="John Doe"`, `required`, `autofocus`},
+		},
+		{
+			name:     "select editor",
+			rec:      get("row=2&column=role"),
+			code:     http.StatusOK,
+			contains: []string{`<select`, `<option value="user" selected>User</option>`},
+		},
+		{
+			name:     "switch editor",
+			rec:      get("row=1&column=active"),
+			code:     http.StatusOK,
+			contains: []string{`type="hidden" name="value" value="false"`, `name="value" value="true" checked`},
+		},
+		{
+			name:     "cancel",
+			rec:      get("row=1&column=name&cancel=1"),
+			code:     http.StatusOK,
+			contains: []string{`aria-label="Edit Name"`, `John Doe`},
+		},
+		{
+			name: "unknown column",
+			rec:  get("row=1&column=id"),
+			code: http.StatusBadRequest,
+		},
+		{
+			name:     "validation error",
+			rec:      post("/users/edit", url.Values{"row": {"1"}, "column": {"name"}, "value": {""}}),
+			code:     http.StatusOK,
+			contains: []string{`Name is required`, `aria-invalid="true"`, `aria-describedby="users-1-name-error"`},
+			headers:  map[string]string{"HX-Retarget": "#users-1-name-editor", "HX-Reswap": "outerHTML"},
+		},
+		{
+			name:     "save",
+			rec:      post("/users/edit", url.Values{"row": {"1"}, "column": {"name"}, "value": {"Johnny"}, "rows": {"1"}}),
+			code:     http.StatusOK,
+			contains: []string{`<tr`, `id="users-row-1"`, `Johnny`, `checked`},
+		},
+		{
+			name:     "save switch",
+			rec:      post("/users/edit", url.Values{"row": {"2"}, "column": {"active"}, "value": {"false", "true"}}),
+			code:     http.StatusOK,
+			contains: []string{`id="users-row-2"`, `Yes`},
+		},
+		{
+			name:     "bulk action",
+			rec:      post("/users/bulk", url.Values{"action": {"delete"}, "rows": {"1", "2"}}),
+			code:     http.StatusOK,
+			contains: []string{`id="users"`, `data-datatable`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.rec.Code != tt.code {
+				t.Fatalf("expected status %d, got %d: %s", tt.code, tt.rec.Code, tt.rec.Body.String())
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(tt.rec.Body.String(), want) {
+					t.Errorf("expected response to contain %q, got %s", want, tt.rec.Body.String())
+				}
+			}
+			for name, want := range tt.headers {
+				if got := tt.rec.Header().Get(name); got != want {
+					t.Errorf("expected header %s %q, got %q", name, want, got)
+				}
+			}
+		})
+	}
+
+	if len(store.bulk) != 1 || store.bulk[0] != "delete:1,2" {
+		t.Errorf("unexpected bulk calls %v", store.bulk)
+	}
+}
+
+func TestHandlersEditGrouped(t *testing.T) {
+	props := testTable("users")
+	props.GroupBy = []string{"role"}
+	store := &testRows{props: props}
+	mux := http.NewServeMux()
+	Handlers{EditPath: "/users/edit", Rows: store, Table: store.Table}.Register(router.ServeMux(mux))
+
+	form := url.Values{"row": {"2"}, "column": {"name"}, "value": {"Janet"}}
+	r := httptest.NewRequest(http.MethodPost, "/users/edit", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, r)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	for _, want := range []string{`id="users-row-2"`, `Janet`, `data-datatable-ancestors="users-branch-1"`, `padding-left: 1.5rem`} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("expected response to contain %q, got %s", want, rec.Body.String())
+		}
+	}
+}
+
+func TestKeyID(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"1", "1"},
+		{"row-1", "row-1"},
+		{"jane.doe@example.com", "jane_2edoe_40example_2ecom"},
+		{"docs/read me:1", "docs_2fread_20me_3a1"},
+		{"a_2e", "a_5f2e"},
+		{"ü", "_c3_bc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := keyID(tt.key); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	props := testTable("users")
+	if got, expected := cellID(props, "a.b", props.Columns[0]), "users-a_2eb-name"; got != expected {
+		t.Errorf("expected cell id %q, got %q", expected, got)
+	}
+}
@@ -269,6 +269,68 @@ func Example() g.Node {
 			),
 		),
 
+		// Column views
+		html.Div(
+			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Column Views")),
+			html.P(html.Class("text-sm text-muted-foreground mb-4"),
+				g.Text("Toggle columns from the View menu, drag headers to reorder and drag the header edges to resize")),
+			New(Props{
+				Columns: []Column{
+					NewColumn("name", "Product").WithPin("left").Build(),
+					NewColumn("category", "Category").Hideable().Resizable().Build(),
+					NewColumn("stock", "Stock").Hideable().Resizable().WithAlign("right").Build(),
+					NewColumn("status", "Status").Hideable().Build(),
+					NewColumn("price", "Price").WithPin("right").WithAlign("right").Build(),
+				},
+				Data:          products[:5],
+				ColumnMenu:    true,
+				Reorderable:   true,
+				HiddenColumns: []string{"status"},
+				Hoverable:     true,
+			}),
+		),
+
+		// Grouped rows
+		html.Div(
+			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Grouped Rows")),
+			html.P(html.Class("text-sm text-muted-foreground mb-4"),
+				g.Text("Rows grouped by category with subtotals and a grand total")),
+			New(Props{
+				ID: "grouped-products",
+				Columns: []Column{
+					NewColumn("category", "Category").Build(),
+					NewColumn("name", "Product").Build(),
+					NewColumn("stock", "Stock").WithAlign("right").WithAggregate(Sum).Build(),
+					NewColumn("price", "Avg. Price").WithAlign("right").WithAggregate(Avg).Build(),
+				},
+				Data:    products[:10],
+				GroupBy: []string{"category"},
+			}),
+		),
+
+		// Tree data
+		html.Div(
+			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Tree Data")),
+			New(Props{
+				ID: "tree-files",
+				Columns: []Column{
+					NewColumn("name", "Name").Build(),
+					NewColumn("size", "Size (KB)").WithAlign("right").Build(),
+				},
+				Data: []interface{}{
+					map[string]interface{}{"name": "src", "size": 48, "children": []interface{}{
+						map[string]interface{}{"name": "main.go", "size": 12},
+						map[string]interface{}{"name": "components", "size": 36, "children": []interface{}{
+							map[string]interface{}{"name": "button.go", "size": 20},
+							map[string]interface{}{"name": "table.go", "size": 16},
+						}},
+					}},
+					map[string]interface{}{"name": "go.mod", "size": 1},
+				},
+				SubRows: "children",
+			}),
+		),
+
 		// Different styles
 		html.Div(
 			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Table Styles")),
@@ -0,0 +1,552 @@
+package datatable
+
+import (
+	"archive/zip"
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"iter"
+	"log"
+	"math"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/dropdownmenu"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// ExportFunc formats a cell value for exports. It is separate from Cell so
+// that exports get plain values rather than markup; numbers and booleans are
+// kept as such in JSON and XLSX.
+type ExportFunc func(value interface{}, row interface{}) interface{}
+
+// ExportFormat is a file format a table can be exported to
+type ExportFormat string
+
+const (
+	CSV    ExportFormat = "csv"
+	NDJSON ExportFormat = "ndjson"
+	JSON   ExportFormat = "json"
+	XLSX   ExportFormat = "xlsx"
+)
+
+// ExportFormats lists the supported formats in menu order
+var ExportFormats = []ExportFormat{CSV, JSON, NDJSON, XLSX}
+
+// ContentType returns the MIME type of the format
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case CSV:
+		return "text/csv; charset=utf-8"
+	case NDJSON:
+		return "application/x-ndjson"
+	case JSON:
+		return "application/json"
+	case XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return ""
+}
+
+// Label returns the name of the format shown in the export menu
+func (f ExportFormat) Label() string {
+	switch f {
+	case NDJSON:
+		return "NDJSON"
+	case XLSX:
+		return "Excel (XLSX)"
+	}
+	return strings.ToUpper(string(f))
+}
+
+// RowSource streams the rows of a table sorted and filtered as described by
+// view. Rows are read one at a time so exports never hold the full table.
+type RowSource func(ctx context.Context, view View) iter.Seq2[interface{}, error]
+
+// SliceSource returns a RowSource over data that is already in memory. Rows
+// are filtered on the text of the filterable columns (all columns when none
+// is filterable) and sorted by the view's sort column.
+func SliceSource(columns []Column, data []interface{}) RowSource {
+	return func(ctx context.Context, view View) iter.Seq2[interface{}, error] {
+		return func(yield func(interface{}, error) bool) {
+			rows := filterRows(columns, data, view.FilterValue)
+			sortRows(columns, rows, view.SortColumn, view.SortDirection)
+			for _, row := range rows {
+				if err := ctx.Err(); err != nil {
+					yield(nil, err)
+					return
+				}
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func filterRows(columns []Column, data []interface{}, filter string) []interface{} {
+	if filter == "" {
+		return slices.Clone(data)
+	}
+	filtered := slices.DeleteFunc(slices.Clone(columns), func(col Column) bool { return !col.Filterable })
+	if len(filtered) == 0 {
+		filtered = columns
+	}
+	filter = strings.ToLower(filter)
+
+	var rows []interface{}
+	for _, row := range data {
+		for _, col := range filtered {
+			if strings.Contains(strings.ToLower(exportText(getFieldValue(row, col.Accessor))), filter) {
+				rows = append(rows, row)
+				break
+			}
+		}
+	}
+	return rows
+}
+
+func sortRows(columns []Column, rows []interface{}, sortColumn, direction string) {
+	i := slices.IndexFunc(columns, func(col Column) bool { return col.ID == sortColumn })
+	if i < 0 {
+		return
+	}
+	accessor := columns[i].Accessor
+	slices.SortStableFunc(rows, func(a, b interface{}) int {
+		c := compareValues(getFieldValue(a, accessor), getFieldValue(b, accessor))
+		if direction == "desc" {
+			return -c
+		}
+		return c
+	})
+}
+
+// compareValues orders numbers numerically and anything else by its text
+func compareValues(a, b interface{}) int {
+	x, xok := toFloat(a)
+	y, yok := toFloat(b)
+	if xok && yok {
+		return cmp.Compare(x, y)
+	}
+	return strings.Compare(exportText(a), exportText(b))
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// exportText returns the plain text of a value
+func exportText(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float32:
+		return strconv.FormatFloat(float64(t), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case time.Time:
+		return t.Format(time.RFC3339)
+	case fmt.Stringer:
+		return t.String()
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// exportValue returns the exported value of a column for row
+func exportValue(col Column, row interface{}) interface{} {
+	value := getFieldValue(row, col.Accessor)
+	if col.Export != nil {
+		return col.Export(value, row)
+	}
+	return value
+}
+
+// ExportColumns returns the columns of view in display order, without hidden
+// columns
+func ExportColumns(columns []Column, view View) []Column {
+	props := Props{Columns: columns, ColumnOrder: view.ColumnOrder, HiddenColumns: view.HiddenColumns}
+	return slices.DeleteFunc(arrangeColumns(props), func(col Column) bool { return isHidden(props, col) })
+}
+
+// WriteExport streams rows to w in format, with a header row of column
+// headers for CSV and XLSX and objects keyed by column ID for JSON formats
+func WriteExport(w io.Writer, format ExportFormat, columns []Column, rows iter.Seq2[interface{}, error]) error {
+	switch format {
+	case CSV:
+		return writeCSV(w, columns, rows)
+	case NDJSON:
+		return writeJSON(w, columns, rows, false)
+	case JSON:
+		return writeJSON(w, columns, rows, true)
+	case XLSX:
+		return writeXLSX(w, columns, rows)
+	}
+	return fmt.Errorf("datatable: unsupported export format %q", format)
+}
+
+func writeCSV(w io.Writer, columns []Column, rows iter.Seq2[interface{}, error]) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, len(columns))
+	for i, col := range columns {
+		record[i] = csvText(col.Header)
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for row, err := range rows {
+		if err != nil {
+			return err
+		}
+		for i, col := range columns {
+			record[i] = csvText(exportValue(col, row))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvText returns the text of a CSV cell. Text that a spreadsheet would read
+// as a formula is prefixed with an apostrophe; numbers are left as they are,
+// so negative values stay numeric.
+func csvText(v interface{}) string {
+	text := exportText(v)
+	if f, ok := toFloat(v); ok && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return text
+	}
+	if formulaLike(text) {
+		return "'" + text
+	}
+	return text
+}
+
+// formulaLike reports whether a spreadsheet would evaluate text entered in a
+// cell as a formula
+func formulaLike(text string) bool {
+	return text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0]))
+}
+
+func writeJSON(w io.Writer, columns []Column, rows iter.Seq2[interface{}, error], array bool) error {
+	bw := bufio.NewWriter(w)
+	keys := make([][]byte, len(columns))
+	for i, col := range columns {
+		keys[i], _ = json.Marshal(col.ID)
+	}
+
+	if array {
+		bw.WriteString("[")
+	}
+	first := true
+	for row, err := range rows {
+		if err != nil {
+			return err
+		}
+		if array && !first {
+			bw.WriteString(",")
+		}
+		first = false
+		if array {
+			bw.WriteString("\n")
+		}
+
+		// Objects are written by hand to keep the keys in column order
+		bw.WriteString("{")
+		for i, col := range columns {
+			value, err := json.Marshal(exportValue(col, row))
+			if err != nil {
+				return fmt.Errorf("datatable: export column %q: %w", col.ID, err)
+			}
+			if i > 0 {
+				bw.WriteString(",")
+			}
+			bw.Write(keys[i])
+			bw.WriteString(":")
+			bw.Write(value)
+		}
+		bw.WriteString("}")
+		if !array {
+			bw.WriteString("\n")
+		}
+	}
+	if array {
+		if !first {
+			bw.WriteString("\n")
+		}
+		bw.WriteString("]\n")
+	}
+	return bw.Flush()
+}
+
+// The parts of a minimal single-sheet workbook. Cells use inline strings so
+// the sheet can be written in one pass without a shared string table. Styles
+// 0 and 1 are body and header cells; 2 and 3 are the same with a quote
+// prefix, which keeps formula-like text a string when the cell is edited.
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/><Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/></Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/></Relationships>`
+	xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts><fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills><borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders><cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs><cellXfs count="4"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0" quotePrefix="1"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1" quotePrefix="1"/></cellXfs></styleSheet>`
+	xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+func writeXLSX(w io.Writer, columns []Column, rows iter.Seq2[interface{}, error]) error {
+	zw := zip.NewWriter(w)
+	for _, part := range []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	} {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(f)
+	bw.WriteString(xlsxSheetStart)
+
+	values := make([]interface{}, len(columns))
+	for i, col := range columns {
+		values[i] = col.Header
+	}
+	writeXLSXRow(bw, 1, values, true)
+
+	n := 1
+	for row, err := range rows {
+		if err != nil {
+			return err
+		}
+		n++
+		for i, col := range columns {
+			values[i] = exportValue(col, row)
+		}
+		writeXLSXRow(bw, n, values, false)
+	}
+
+	bw.WriteString(xlsxSheetEnd)
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func writeXLSXRow(bw *bufio.Writer, n int, values []interface{}, header bool) {
+	fmt.Fprintf(bw, `<row r="%d">`, n)
+	for i, v := range values {
+		ref := xlsxColumn(i) + strconv.Itoa(n)
+		base := 0
+		if header {
+			base = 1
+		}
+		style := ""
+		if base != 0 {
+			style = fmt.Sprintf(` s="%d"`, base)
+		}
+		// NaN and infinities have no numeric cell form and are written as text
+		if f, ok := toFloat(v); ok && !math.IsNaN(f) && !math.IsInf(f, 0) {
+			fmt.Fprintf(bw, `<c r="%s"%s><v>%s</v></c>`, ref, style, strconv.FormatFloat(f, 'f', -1, 64))
+			continue
+		}
+		if b, ok := v.(bool); ok {
+			value := "0"
+			if b {
+				value = "1"
+			}
+			fmt.Fprintf(bw, `<c r="%s"%s t="b"><v>%s</v></c>`, ref, style, value)
+			continue
+		}
+		if v == nil {
+			continue
+		}
+		text := exportText(v)
+		if formulaLike(text) {
+			style = fmt.Sprintf(` s="%d"`, base+2)
+		}
+		fmt.Fprintf(bw, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">`, ref, style)
+		xml.EscapeText(bw, []byte(text))
+		bw.WriteString(`</t></is></c>`)
+	}
+	bw.WriteString(`</row>`)
+}
+
+// xlsxColumn returns the spreadsheet column letters for a zero-based index
+func xlsxColumn(i int) string {
+	name := ""
+	for i >= 0 {
+		name = string(rune('A'+i%26)) + name
+		i = i/26 - 1
+	}
+	return name
+}
+
+// ExportHandler serves a table export in the format named by the "format"
+// query parameter. The view is restored from the ViewParam parameter, as
+// produced by ExportButton, so the export follows what the user sees.
+type ExportHandler struct {
+	Filename string                       // Download name without extension (default: "export")
+	Columns  []Column                     // Column definitions (required)
+	Rows     RowSource                    // Rows to export (required)
+	Store    ViewStore                    // Saved views that can be named in the URL
+	User     func(r *http.Request) string // Identifies the user views belong to
+}
+
+// ServeHTTP streams the export as an attachment
+func (h ExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format := ExportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = CSV
+	}
+	if format.ContentType() == "" {
+		http.Error(w, "Unsupported export format", http.StatusBadRequest)
+		return
+	}
+
+	user := ""
+	if h.User != nil {
+		user = h.User(r)
+	}
+	view, _, err := RestoreView(r, h.Store, user)
+	if err != nil {
+		http.Error(w, "Invalid view", http.StatusBadRequest)
+		return
+	}
+
+	filename := h.Filename
+	if filename == "" {
+		filename = "export"
+	}
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": filename + "." + string(format),
+	}))
+
+	// The response has started once rows are written, so a failure part way
+	// through can only abort it, for clients to see a broken download rather
+	// than a truncated file
+	if err := WriteExport(w, format, ExportColumns(h.Columns, view), h.Rows(r.Context(), view)); err != nil {
+		log.Printf("datatable: exporting %s: %v", r.URL.Path, err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// ExportButtonProps defines the properties for the export menu
+type ExportButtonProps struct {
+	Path    string         // ExportHandler path
+	Formats []ExportFormat // Formats offered (default: ExportFormats)
+	View    View           // View the export links start from
+	Class   string         // Additional CSS classes
+	Attrs   []g.Node       // Additional attributes to pass through
+}
+
+// ExportButton renders a toolbar button that opens a menu of export
+// formats. Inside a data table the links follow column changes made in the
+// browser.
+func ExportButton(props ExportButtonProps) g.Node {
+	formats := props.Formats
+	if len(formats) == 0 {
+		formats = ExportFormats
+	}
+	view, _ := EncodeView(props.View)
+
+	return dropdownmenu.New(
+		dropdownmenu.Props{Class: props.Class, Attrs: props.Attrs},
+		button.New(
+			button.Props{
+				Variant: "outline",
+				Size:    "sm",
+				Attrs: []g.Node{
+					g.Attr("aria-haspopup", "menu"),
+					g.Attr("aria-expanded", "false"),
+					g.Attr("data-datatable-menu-trigger", ""),
+				},
+			},
+			icons.Download(html.Class("h-4 w-4")),
+			g.Text("Export"),
+		),
+		dropdownmenu.DropdownContent(
+			dropdownmenu.ContentProps{
+				Class: "absolute right-0 mt-2 w-40",
+				Align: "end",
+				Attrs: []g.Node{
+					g.Attr("hidden"),
+					g.Attr("data-datatable-menu", ""),
+				},
+			},
+			g.Group(g.Map(formats, func(f ExportFormat) g.Node {
+				query := url.Values{"format": {string(f)}, ViewParam: {view}}
+				return html.A(
+					html.Class("relative flex cursor-default select-none items-center gap-2 rounded-sm px-2 py-1.5 text-sm outline-none transition-colors hover:bg-accent focus:bg-accent focus:text-accent-foreground"),
+					g.Attr("role", "menuitem"),
+					roving.Item(false),
+					html.Href(props.Path+"?"+query.Encode()),
+					g.Attr("download", ""),
+					g.Attr("data-datatable-export", string(f)),
+					g.Text(f.Label()),
+				)
+			})),
+		),
+	)
+}
@@ -0,0 +1,234 @@
+package datatable
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWriteExport(t *testing.T) {
+	products := testTable("products")
+	columns, data := products.Columns, products.Data
+	view := View{SortColumn: "price", SortDirection: "desc", HiddenColumns: []string{"id"}}
+
+	tests := []struct {
+		format ExportFormat
+		want   string
+	}{
+		{
+			format: CSV,
+			want:   "Name,Price\nGizmo <b>,400\n\"Widget, large\",250\nGadget,125\n",
+		},
+		{
+			format: NDJSON,
+			want:   "{\"name\":\"Gizmo \\u003cb\\u003e\",\"price\":400}\n{\"name\":\"Widget, large\",\"price\":250}\n{\"name\":\"Gadget\",\"price\":125}\n",
+		},
+		{
+			format: JSON,
+			want:   "[\n{\"name\":\"Gizmo \\u003cb\\u003e\",\"price\":400},\n{\"name\":\"Widget, large\",\"price\":250},\n{\"name\":\"Gadget\",\"price\":125}\n]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			rows := SliceSource(columns, data)(context.Background(), view)
+			if err := WriteExport(&buf, tt.format, ExportColumns(columns, view), rows); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("expected\n%q\ngot\n%q", tt.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestWriteExportXLSX(t *testing.T) {
+	products := testTable("products")
+	columns, data := products.Columns, products.Data
+	view := View{FilterValue: "gi", ColumnOrder: []string{"name"}}
+
+	var buf bytes.Buffer
+	rows := SliceSource(columns, data)(context.Background(), view)
+	if err := WriteExport(&buf, XLSX, ExportColumns(columns, view), rows); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sheet string
+	for _, f := range zr.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, _ := f.Open()
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			sheet = string(b)
+		}
+	}
+
+	for _, want := range []string{
+		`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">Name</t></is></c>`,
+		`<c r="A2" t="inlineStr"><is><t xml:space="preserve">Gizmo &lt;b&gt;</t></is></c><c r="B2"><v>3</v></c><c r="C2"><v>400</v></c>`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("expected sheet to contain %q, got %s", want, sheet)
+		}
+	}
+	if strings.Contains(sheet, "Widget") || strings.Contains(sheet, "Gadget") {
+		t.Error("expected filtered rows to be left out")
+	}
+}
+
+func TestWriteExportUnsafeValues(t *testing.T) {
+	columns := []Column{
+		{ID: "note", Header: "Note", Accessor: "note"},
+		{ID: "score", Header: "Score", Accessor: "score"},
+	}
+	rows := func(yield func(interface{}, error) bool) {
+		for _, row := range []map[string]interface{}{
+			{"note": "=HYPERLINK(\"http://x\")", "score": -5},
+			{"note": "@SUM(A1)", "score": math.NaN()},
+			{"note": "+1", "score": math.Inf(-1)},
+		} {
+			if !yield(row, nil) {
+				return
+			}
+		}
+	}
+
+	var csv bytes.Buffer
+	if err := WriteExport(&csv, CSV, columns, rows); err != nil {
+		t.Fatal(err)
+	}
+	want := "Note,Score\n\"'=HYPERLINK(\"\"http://x\"\")\",-5\n'@SUM(A1),NaN\n'+1,'-Inf\n"
+	if csv.String() != want {
+		t.Errorf("expected\n%q\ngot\n%q", want, csv.String())
+	}
+
+	var xlsx bytes.Buffer
+	if err := WriteExport(&xlsx, XLSX, columns, rows); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(xlsx.Bytes()), int64(xlsx.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sheet string
+	for _, f := range zr.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, _ := f.Open()
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			sheet = string(b)
+		}
+	}
+	for _, want := range []string{
+		`<c r="A2" s="2" t="inlineStr"><is><t xml:space="preserve">=HYPERLINK(&#34;http://x&#34;)</t></is></c><c r="B2"><v>-5</v></c>`,
+		`<c r="B3" t="inlineStr"><is><t xml:space="preserve">NaN</t></is></c>`,
+		`<c r="B4" s="2" t="inlineStr"><is><t xml:space="preserve">-Inf</t></is></c>`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("expected sheet to contain %q, got %s", want, sheet)
+		}
+	}
+}
+
+func TestXLSXColumn(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA"} {
+		if got := xlsxColumn(i); got != want {
+			t.Errorf("xlsxColumn(%d) = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestExportHandler(t *testing.T) {
+	products := testTable("products")
+	columns, data := products.Columns, products.Data
+	h := ExportHandler{Filename: "products", Columns: columns, Rows: SliceSource(columns, data)}
+	view, _ := EncodeView(View{HiddenColumns: []string{"price"}, SortColumn: "name"})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/export?format=csv&view="+view, nil))
+
+	if got := rec.Header().Get("Content-Disposition"); got != `attachment; filename=products.csv` {
+		t.Errorf("unexpected Content-Disposition %q", got)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "text/csv; charset=utf-8" {
+		t.Errorf("unexpected Content-Type %q", got)
+	}
+	if want := "ID,Name\n2,Gadget\n3,Gizmo <b>\n1,\"Widget, large\"\n"; rec.Body.String() != want {
+		t.Errorf("expected %q, got %q", want, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/export?format=pdf", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected bad request for unknown format, got %d", rec.Code)
+	}
+}
+
+func TestExportHandlerRowError(t *testing.T) {
+	products := testTable("products")
+	columns, data := products.Columns, products.Data
+	rows := make([]interface{}, 0, 5000)
+	for len(rows) < cap(rows) {
+		rows = append(rows, data...)
+	}
+	h := ExportHandler{Columns: columns, Rows: func(ctx context.Context, view View) iter.Seq2[interface{}, error] {
+		return func(yield func(interface{}, error) bool) {
+			for i, row := range rows {
+				if i == len(rows)/2 {
+					yield(nil, errors.New("database gone"))
+					return
+				}
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}}
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "?format=csv")
+	if err == nil {
+		defer resp.Body.Close()
+		_, err = io.ReadAll(resp.Body)
+	}
+	if err == nil {
+		t.Error("expected the download to break when the rows fail")
+	}
+}
+
+func TestExportButton(t *testing.T) {
+	products := testTable("products")
+	columns, data := products.Columns, products.Data
+
+	var buf bytes.Buffer
+	if err := New(Props{Columns: columns, Data: data, ExportPath: "/export"}).Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+
+	for _, want := range []string{
+		`data-datatable-menu-trigger`,
+		`href="/export?format=csv&amp;view=`,
+		`data-datatable-export="xlsx"`,
+		`Excel (XLSX)`,
+		`data-datatable-state`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected HTML to contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,487 @@
+package datatable
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/table"
+	g "maragu.dev/gomponents"
+	hx "maragu.dev/gomponents-htmx"
+	html "maragu.dev/gomponents/html"
+)
+
+// AggregateFunc summarizes the values of a column
+type AggregateFunc func(values []interface{}) interface{}
+
+// Sum adds up the numeric values
+func Sum(values []interface{}) interface{} {
+	total := 0.0
+	for _, v := range values {
+		if n, ok := toFloat(v); ok {
+			total += n
+		}
+	}
+	return total
+}
+
+// Avg returns the mean of the numeric values, or nil when there are none
+func Avg(values []interface{}) interface{} {
+	total, count := 0.0, 0
+	for _, v := range values {
+		if n, ok := toFloat(v); ok {
+			total += n
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return total / float64(count)
+}
+
+// Min returns the smallest value
+func Min(values []interface{}) interface{} {
+	return extreme(values, -1)
+}
+
+// Max returns the largest value
+func Max(values []interface{}) interface{} {
+	return extreme(values, 1)
+}
+
+// Count returns the number of values that are not nil
+func Count(values []interface{}) interface{} {
+	count := 0
+	for _, v := range values {
+		if v != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// extreme returns the value that compares furthest in direction sign
+func extreme(values []interface{}, sign int) interface{} {
+	var result interface{}
+	for _, v := range values {
+		if v != nil && (result == nil || compareValues(v, result)*sign > 0) {
+			result = v
+		}
+	}
+	return result
+}
+
+// rowLevel places a row in a grouped or tree-structured table
+type rowLevel struct {
+	depth     int      // Indentation depth
+	ancestors []string // Branches the row is nested in, outermost first
+	branch    string   // Branch of the row's own sub-rows ("" for leaf rows)
+}
+
+// indexedRow is a row with its index in the displayed data
+type indexedRow struct {
+	index int
+	row   interface{}
+}
+
+// rowGroup is the rows sharing a value of a grouped column
+type rowGroup struct {
+	value string
+	rows  []indexedRow
+}
+
+// hasAggregates reports whether the table has aggregate rows
+func hasAggregates(props Props) bool {
+	return slices.ContainsFunc(props.Columns, func(col Column) bool { return col.Aggregate != nil })
+}
+
+// isExpandable reports whether rows have lazily loaded detail rows
+func isExpandable(props Props) bool {
+	return props.DetailPath != "" && props.RowKey != "" && props.ID != ""
+}
+
+// hasExpansion reports whether the table needs the expansion script
+func hasExpansion(props Props) bool {
+	return len(groupColumns(props)) > 0 || props.SubRows != "" || isExpandable(props)
+}
+
+// groupColumns returns the columns named by GroupBy
+func groupColumns(props Props) []Column {
+	var columns []Column
+	for _, id := range props.GroupBy {
+		if i := slices.IndexFunc(props.Columns, func(col Column) bool { return col.ID == id }); i >= 0 {
+			columns = append(columns, props.Columns[i])
+		}
+	}
+	return columns
+}
+
+// groupRows splits rows by the value of col, in order of first appearance
+func groupRows(col Column, rows []indexedRow) []rowGroup {
+	var groups []rowGroup
+	for _, r := range rows {
+		value := exportText(getFieldValue(r.row, col.Accessor))
+		i := slices.IndexFunc(groups, func(group rowGroup) bool { return group.value == value })
+		if i < 0 {
+			groups = append(groups, rowGroup{value: value})
+			i = len(groups) - 1
+		}
+		groups[i].rows = append(groups[i].rows, r)
+	}
+	return groups
+}
+
+// subRows returns the child rows of a tree row
+func subRows(props Props, row interface{}) []interface{} {
+	switch children := getFieldValue(row, props.SubRows).(type) {
+	case []interface{}:
+		return children
+	case []map[string]interface{}:
+		rows := make([]interface{}, len(children))
+		for i, child := range children {
+			rows[i] = child
+		}
+		return rows
+	}
+	return nil
+}
+
+// branchID returns the token that identifies a group or a tree row's
+// sub-rows within the table
+func branchID(props Props, path string) string {
+	prefix := props.ID
+	if prefix == "" {
+		prefix = "datatable"
+	}
+	return prefix + "-branch-" + path
+}
+
+// detailID returns the element id of a row's detail row
+func detailID(props Props, key string) string {
+	return props.ID + "-detail-" + keyID(key)
+}
+
+// levelAttrs returns the attributes nesting a row under its ancestors
+func levelAttrs(props Props, level rowLevel) []g.Node {
+	if len(level.ancestors) == 0 {
+		return nil
+	}
+	return []g.Node{
+		g.Attr("data-datatable-ancestors", strings.Join(level.ancestors, " ")),
+		g.If(props.Collapsed, g.Attr("hidden")),
+	}
+}
+
+// rowVisitor receives the rows of a table in display order. group is called
+// before the rows of each group and groupEnd after them; row receives each
+// row with its level, including the branch of its own sub-rows.
+type rowVisitor struct {
+	group    func(col Column, group rowGroup, level rowLevel, branch string)
+	groupEnd func(group rowGroup, level rowLevel)
+	row      func(index int, row interface{}, level rowLevel)
+}
+
+// walkRows visits data grouped by GroupBy, with the sub-rows of tree rows
+// following their parent
+func walkRows(props Props, data []interface{}, visit rowVisitor) {
+	rows := make([]indexedRow, len(data))
+	for i, row := range data {
+		rows[i] = indexedRow{index: i, row: row}
+	}
+	walkGroups(props, groupColumns(props), rows, rowLevel{}, "", visit)
+}
+
+// walkGroups visits the groups of the first column in groupBy, nesting the
+// remaining columns
+func walkGroups(props Props, groupBy []Column, rows []indexedRow, level rowLevel, path string, visit rowVisitor) {
+	if len(groupBy) == 0 {
+		for i, r := range rows {
+			walkTree(props, r.index, r.row, level, fmt.Sprintf("%s%d", path, i), visit)
+		}
+		return
+	}
+
+	col := groupBy[0]
+	for i, group := range groupRows(col, rows) {
+		branch := branchID(props, fmt.Sprintf("%s%d", path, i))
+		if visit.group != nil {
+			visit.group(col, group, level, branch)
+		}
+		nested := rowLevel{depth: level.depth + 1, ancestors: append(slices.Clone(level.ancestors), branch)}
+		walkGroups(props, groupBy[1:], group.rows, nested, fmt.Sprintf("%s%d-", path, i), visit)
+		if visit.groupEnd != nil {
+			visit.groupEnd(group, level)
+		}
+	}
+}
+
+// walkTree visits a row and then its sub-rows. Sub-rows have no index.
+func walkTree(props Props, index int, row interface{}, level rowLevel, path string, visit rowVisitor) {
+	var children []interface{}
+	if props.SubRows != "" {
+		children = subRows(props, row)
+	}
+	own := level
+	if len(children) > 0 {
+		own.branch = branchID(props, path)
+	}
+	visit.row(index, row, own)
+
+	nested := rowLevel{depth: level.depth + 1, ancestors: append(slices.Clone(level.ancestors), own.branch)}
+	for i, child := range children {
+		walkTree(props, -1, child, nested, fmt.Sprintf("%s-%d", path, i), visit)
+	}
+}
+
+// renderDataRows renders rows grouped by GroupBy, with a header and a
+// subtotal row for each group, and each row followed by its detail row and
+// its sub-rows. Sub-rows are only selectable with a RowKey.
+func renderDataRows(props Props, data []interface{}) []g.Node {
+	var nodes []g.Node
+	walkRows(props, data, rowVisitor{
+		group: func(col Column, group rowGroup, level rowLevel, branch string) {
+			nodes = append(nodes, renderGroupHeader(props, col, group, level, branch))
+		},
+		groupEnd: func(group rowGroup, level rowLevel) {
+			if !hasAggregates(props) {
+				return
+			}
+			groupData := make([]interface{}, len(group.rows))
+			for j, r := range group.rows {
+				groupData[j] = r.row
+			}
+			nodes = append(nodes, renderAggregateRow(props, groupData, "Subtotal", levelAttrs(props, level)...))
+		},
+		row: func(index int, row interface{}, level rowLevel) {
+			isSelected := index >= 0 && slices.Contains(props.SelectedRows, index)
+			nodes = append(nodes, renderRow(props, index, row, isSelected, level))
+			if isExpandable(props) {
+				nodes = append(nodes, renderDetailRow(props, rowKey(props, row), level))
+			}
+		},
+	})
+	return nodes
+}
+
+// findRow returns the index and level of the row of data identified by key
+func findRow(props Props, data []interface{}, key string) (int, rowLevel, bool) {
+	index, level, found := -1, rowLevel{}, false
+	walkRows(props, data, rowVisitor{
+		row: func(i int, row interface{}, l rowLevel) {
+			if !found && rowKey(props, row) == key {
+				index, level, found = i, l, true
+			}
+		},
+	})
+	return index, level, found
+}
+
+// renderGroupHeader renders the collapsible header row of a group
+func renderGroupHeader(props Props, col Column, group rowGroup, level rowLevel, branch string) g.Node {
+	return table.Row(
+		table.RowProps{
+			Class: "bg-muted/50 hover:bg-muted/50",
+			Attrs: append(levelAttrs(props, level), g.Attr("data-datatable-group", col.ID)),
+		},
+		table.Cell(
+			table.CellProps{ColSpan: totalColumnCount(props), Class: "font-medium"},
+			html.Div(
+				html.Class("flex items-center gap-2"),
+				g.If(level.depth > 0, html.Style(fmt.Sprintf("padding-left: %.1frem", float64(level.depth)*1.5))),
+				expandButton(branch, fmt.Sprintf("Toggle %s %s", col.Header, group.value), !props.Collapsed),
+				html.Span(html.Class("text-muted-foreground"), g.Text(col.Header+":")),
+				renderCellContent(col, group.rows[0].row),
+				html.Span(
+					html.Class("text-xs text-muted-foreground"),
+					g.Text(fmt.Sprintf("(%d)", len(group.rows))),
+				),
+			),
+		),
+	)
+}
+
+// levelCell wraps the content of a row's first column with its indentation
+// and the toggle of its sub-rows
+func levelCell(props Props, level rowLevel, content g.Node) g.Node {
+	if level.depth == 0 && props.SubRows == "" {
+		return content
+	}
+	return html.Div(
+		html.Class("flex items-center gap-1"),
+		g.If(level.depth > 0, html.Style(fmt.Sprintf("padding-left: %.1frem", float64(level.depth)*1.5))),
+		g.If(level.branch != "", expandButton(level.branch, "Toggle sub-rows", !props.Collapsed)),
+		g.If(level.branch == "" && props.SubRows != "", html.Span(html.Class("inline-block size-6 shrink-0"), g.Attr("aria-hidden", "true"))),
+		content,
+	)
+}
+
+// expandButton renders the toggle that collapses the rows of a branch
+func expandButton(branch, label string, expanded bool) g.Node {
+	return html.Button(
+		html.Type("button"),
+		html.Class("inline-flex size-6 shrink-0 items-center justify-center rounded-sm hover:bg-accent focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring [&>svg]:transition-transform [&[aria-expanded=false]>svg]:-rotate-90"),
+		g.Attr("data-datatable-expand", branch),
+		g.Attr("aria-expanded", strconv.FormatBool(expanded)),
+		g.Attr("aria-label", label),
+		icons.ChevronDown(html.Class("h-4 w-4")),
+	)
+}
+
+// detailButton renders the toggle that loads and shows a row's detail row
+func detailButton(props Props, key string) g.Node {
+	id := detailID(props, key)
+	return html.Button(
+		html.Type("button"),
+		html.Class("inline-flex size-6 items-center justify-center rounded-sm hover:bg-accent focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring [&>svg]:transition-transform [&[aria-expanded=true]>svg]:rotate-90"),
+		g.Attr("data-datatable-detail", ""),
+		g.Attr("aria-expanded", "false"),
+		g.Attr("aria-controls", id),
+		g.Attr("aria-label", "Show details"),
+		hx.Get(props.DetailPath+"?"+url.Values{"row": {key}}.Encode()),
+		hx.Trigger("click once"),
+		hx.Target("#"+id+" > td"),
+		hx.Swap("innerHTML"),
+		icons.ChevronRight(html.Class("h-4 w-4")),
+	)
+}
+
+// renderDetailRow renders the hidden row a row's details are loaded into
+func renderDetailRow(props Props, key string, level rowLevel) g.Node {
+	return table.Row(
+		table.RowProps{
+			ID:    detailID(props, key),
+			Class: "hover:bg-transparent",
+			Attrs: []g.Node{
+				g.Attr("data-datatable-detail-row", ""),
+				g.Attr("data-datatable-ancestors", strings.Join(level.ancestors, " ")),
+				g.Attr("hidden"),
+			},
+		},
+		table.Cell(
+			table.CellProps{ColSpan: totalColumnCount(props), Class: "bg-muted/30"},
+			html.Div(
+				html.Class("flex items-center justify-center gap-2 py-2 text-muted-foreground"),
+				icons.Loader(html.Class("h-4 w-4 animate-spin")),
+				g.Text("Loading..."),
+			),
+		),
+	)
+}
+
+// renderAggregateRow renders the aggregates of rows, with label in the
+// leading cells or else in the first visible column without an aggregate
+func renderAggregateRow(props Props, rows []interface{}, label string, attrs ...g.Node) g.Node {
+	leading := leadingColumnCount(props)
+	labelColumn := ""
+	if leading == 0 {
+		for _, col := range props.Columns {
+			if col.Aggregate == nil && !isHidden(props, col) {
+				labelColumn = col.ID
+				break
+			}
+		}
+	}
+
+	return table.Row(
+		table.RowProps{
+			Class: "font-medium",
+			Attrs: append(attrs, g.Attr("data-datatable-aggregate", "")),
+		},
+		g.If(leading > 0,
+			table.Cell(table.CellProps{ColSpan: leading}, g.Text(label)),
+		),
+		g.Group(g.Map(props.Columns, func(col Column) g.Node {
+			return table.Cell(
+				table.CellProps{
+					Class: lib.CN(alignClass(col), columnClasses(props, col)),
+					Attrs: columnCellAttrs(props, col),
+				},
+				g.Iff(col.Aggregate != nil, func() g.Node { return renderAggregate(col, rows) }),
+				g.If(col.ID == labelColumn, g.Text(label)),
+			)
+		})),
+	)
+}
+
+// renderAggregate renders the aggregate of a column over rows
+func renderAggregate(col Column, rows []interface{}) g.Node {
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		values[i] = getFieldValue(row, col.Accessor)
+	}
+	value := col.Aggregate(values)
+	if col.AggregateCell != nil {
+		return col.AggregateCell(value, rows)
+	}
+	switch v := value.(type) {
+	case nil:
+		return g.Text("-")
+	case float64:
+		return g.Text(strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64))
+	}
+	return g.Text(exportText(value))
+}
+
+// renderAggregateFooter renders the grand totals of the table
+func renderAggregateFooter(props Props) g.Node {
+	return table.FooterComponent(
+		table.Props{},
+		renderAggregateRow(props, props.Data, "Total"),
+	)
+}
+
+// DetailFunc renders the expanded details of a row
+type DetailFunc func(r *http.Request, row interface{}) g.Node
+
+// serveDetail renders the details of the requested row
+func (h Handlers) serveDetail(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("row")
+	if key == "" {
+		http.Error(w, "Row is required", http.StatusBadRequest)
+		return
+	}
+	row, err := h.Rows.Row(r.Context(), key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	h.Detail(r, row).Render(w)
+}
+
+// expansionScript collapses the rows of groups and tree rows and shows
+// loaded detail rows
+const expansionScript = `
+(function() {
+	if (window.shadcnDataTableExpansion) return;
+	window.shadcnDataTableExpansion = true;
+	function collapsed(table, token) {
+		const button = table.querySelector('[data-datatable-expand="' + token + '"]');
+		return button !== null && button.getAttribute('aria-expanded') === 'false';
+	}
+	function update(table) {
+		table.querySelectorAll('tr[data-datatable-ancestors]').forEach(function(row) {
+			let hidden = row.getAttribute('data-datatable-ancestors').split(' ').some(function(token) {
+				return token !== '' && collapsed(table, token);
+			});
+			if (row.hasAttribute('data-datatable-detail-row')) {
+				const button = table.querySelector('[aria-controls="' + row.id + '"]');
+				hidden = hidden || button === null || button.getAttribute('aria-expanded') !== 'true';
+			}
+			row.hidden = hidden;
+		});
+	}
+	document.addEventListener('click', function(e) {
+		const button = e.target.closest('[data-datatable-expand], [data-datatable-detail]');
+		if (!button) return;
+		button.setAttribute('aria-expanded', button.getAttribute('aria-expanded') === 'true' ? 'false' : 'true');
+		update(button.closest('table'));
+	});
+})();
+`
@@ -0,0 +1,189 @@
+package datatable
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	g "maragu.dev/gomponents"
+)
+
+func TestAggregateFuncs(t *testing.T) {
+	values := []interface{}{3, 1.5, nil, 4}
+
+	tests := []struct {
+		name string
+		fn   AggregateFunc
+		want interface{}
+	}{
+		{"sum", Sum, 8.5},
+		{"avg", Avg, 8.5 / 3},
+		{"min", Min, 1.5},
+		{"max", Max, 4},
+		{"count", Count, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(values); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if got := Avg(nil); got != nil {
+		t.Errorf("expected nil average of no values, got %v", got)
+	}
+}
+
+func TestGroupedTable(t *testing.T) {
+	t.Run("groups and aggregates", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := New(testTable("sales")).Render(&buf); err != nil {
+			t.Fatal(err)
+		}
+		html := buf.String()
+
+		for _, want := range []string{
+			`data-datatable-group="region"`,
+			`data-datatable-expand="sales-branch-0"`,
+			`aria-label="Toggle Region North"`,
+			`(2)`,
+			`data-datatable-ancestors="sales-branch-0"`,
+			`data-datatable-ancestors="sales-branch-1"`,
+			`>125.5</td>`,
+			`>Subtotal</td>`,
+			`<tfoot`,
+			`>Total</td>`,
+			`>175.5</td>`,
+			`shadcnDataTableExpansion`,
+		} {
+			if !strings.Contains(html, want) {
+				t.Errorf("expected HTML to contain %q", want)
+			}
+		}
+		if strings.Index(html, "Cid") > strings.Index(html, "Bob") {
+			t.Error("expected rows of a group to be rendered together")
+		}
+	})
+
+	t.Run("nested and collapsed", func(t *testing.T) {
+		props := testTable("sales")
+		props.GroupBy = []string{"region", "rep"}
+		props.Collapsed = true
+
+		var buf bytes.Buffer
+		if err := New(props).Render(&buf); err != nil {
+			t.Fatal(err)
+		}
+		html := buf.String()
+
+		for _, want := range []string{
+			`data-datatable-expand="sales-branch-0-1"`,
+			`data-datatable-ancestors="sales-branch-0 sales-branch-0-1" hidden`,
+			`aria-expanded="false"`,
+			`padding-left: 1.5rem`,
+		} {
+			if !strings.Contains(html, want) {
+				t.Errorf("expected HTML to contain %q", want)
+			}
+		}
+	})
+}
+
+func TestTreeTable(t *testing.T) {
+	props := Props{
+		ID: "files",
+		Columns: []Column{
+			{ID: "name", Header: "Name", Accessor: "name"},
+			{ID: "size", Header: "Size", Accessor: "size"},
+		},
+		Data: []interface{}{
+			map[string]interface{}{"name": "src", "size": 3, "children": []interface{}{
+				map[string]interface{}{"name": "main.go", "size": 1},
+				map[string]interface{}{"name": "lib", "size": 2, "children": []map[string]interface{}{
+					{"name": "util.go", "size": 2},
+				}},
+			}},
+			map[string]interface{}{"name": "README.md", "size": 1},
+		},
+		SubRows:    "children",
+		Selectable: true,
+	}
+
+	var buf bytes.Buffer
+	if err := New(props).Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+
+	for _, want := range []string{
+		`data-datatable-expand="files-branch-0"`,
+		`aria-label="Toggle sub-rows"`,
+		`data-datatable-expand="files-branch-0-1"`,
+		`data-datatable-ancestors="files-branch-0 files-branch-0-1"`,
+		`padding-left: 3.0rem`,
+		`util.go`,
+		`inline-block size-6`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected HTML to contain %q", want)
+		}
+	}
+	if !strings.Contains(html, `id="select-1"`) || strings.Contains(html, `id="select--1"`) {
+		t.Error("expected only top-level rows to be selectable without a RowKey")
+	}
+}
+
+func TestDetailRows(t *testing.T) {
+	store := &testRows{props: testTable("users")}
+	props := testTable("users")
+	props.DetailPath = "/users/detail"
+	props.Data[1].(map[string]interface{})["id"] = "jane.smith@example.com"
+
+	var buf bytes.Buffer
+	if err := New(props).Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+
+	for _, want := range []string{
+		`<span class="sr-only">Details</span>`,
+		`aria-controls="users-detail-1"`,
+		`hx-get="/users/detail?row=1"`,
+		`hx-trigger="click once"`,
+		`hx-target="#users-detail-1 &gt; td"`,
+		`id="users-detail-jane_2esmith_40example_2ecom"`,
+		`hx-target="#users-detail-jane_2esmith_40example_2ecom &gt; td"`,
+		`data-datatable-detail-row`,
+		`colspan="5"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected HTML to contain %q", want)
+		}
+	}
+
+	mux := http.NewServeMux()
+	Handlers{
+		DetailPath: "/users/detail",
+		Rows:       store,
+		Detail: func(r *http.Request, row interface{}) g.Node {
+			return g.Text("Role: " + row.(map[string]interface{})["role"].(string))
+		},
+	}.Register(router.ServeMux(mux))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/detail?row=2", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "Role: user" {
+		t.Errorf("unexpected detail response %d %q", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/detail?row=9", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected not found for an unknown row, got %d", rec.Code)
+	}
+}
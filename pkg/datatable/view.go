@@ -0,0 +1,271 @@
+package datatable
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+)
+
+// ViewParam is the URL query parameter a view is restored from. Its value is
+// the name of a saved view or a view encoded with EncodeView.
+const ViewParam = "view"
+
+// View is a named table configuration
+type View struct {
+	Name          string            `json:"name"`
+	SortColumn    string            `json:"sort,omitempty"`
+	SortDirection string            `json:"direction,omitempty"`
+	FilterValue   string            `json:"filter,omitempty"`
+	HiddenColumns []string          `json:"hidden,omitempty"`
+	ColumnOrder   []string          `json:"order,omitempty"`
+	ColumnWidths  map[string]string `json:"widths,omitempty"`
+	PageSize      int               `json:"pageSize,omitempty"`
+}
+
+// ViewFromProps captures the view state of props under name
+func ViewFromProps(name string, props Props) View {
+	return View{
+		Name:          name,
+		SortColumn:    props.SortColumn,
+		SortDirection: props.SortDirection,
+		FilterValue:   props.FilterValue,
+		HiddenColumns: props.HiddenColumns,
+		ColumnOrder:   props.ColumnOrder,
+		ColumnWidths:  props.ColumnWidths,
+		PageSize:      props.PageSize,
+	}
+}
+
+// Apply returns props configured by the view
+func (v View) Apply(props Props) Props {
+	props.SortColumn = v.SortColumn
+	props.SortDirection = v.SortDirection
+	props.FilterValue = v.FilterValue
+	props.HiddenColumns = v.HiddenColumns
+	props.ColumnOrder = v.ColumnOrder
+	props.ColumnWidths = v.ColumnWidths
+	if v.PageSize > 0 {
+		props.PageSize = v.PageSize
+	}
+	props.ActiveView = v.Name
+	return props
+}
+
+// EncodeView encodes a view for a shareable URL parameter
+func EncodeView(v View) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("datatable: encode view: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeView decodes a view produced by EncodeView
+func DecodeView(s string) (View, error) {
+	var v View
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return View{}, fmt.Errorf("datatable: decode view: %w", err)
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return View{}, fmt.Errorf("datatable: decode view: %w", err)
+	}
+	return v, nil
+}
+
+// ViewStore persists the saved views of each user
+type ViewStore interface {
+	Views(ctx context.Context, user string) ([]View, error)
+	SaveView(ctx context.Context, user string, v View) error
+	DeleteView(ctx context.Context, user, name string) error
+}
+
+// MemoryViewStore is a ViewStore for a single process
+type MemoryViewStore struct {
+	mu    sync.Mutex
+	views map[string][]View
+}
+
+// NewMemoryViewStore creates an empty MemoryViewStore
+func NewMemoryViewStore() *MemoryViewStore {
+	return &MemoryViewStore{views: make(map[string][]View)}
+}
+
+// Views returns the views saved by user
+func (s *MemoryViewStore) Views(ctx context.Context, user string) ([]View, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.views[user]), nil
+}
+
+// SaveView stores v for user, replacing a view with the same name
+func (s *MemoryViewStore) SaveView(ctx context.Context, user string, v View) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	views := s.views[user]
+	if i := slices.IndexFunc(views, func(e View) bool { return e.Name == v.Name }); i >= 0 {
+		views[i] = v
+	} else {
+		views = append(views, v)
+	}
+	s.views[user] = views
+	return nil
+}
+
+// DeleteView removes the view called name for user
+func (s *MemoryViewStore) DeleteView(ctx context.Context, user, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.views[user] = slices.DeleteFunc(s.views[user], func(e View) bool { return e.Name == name })
+	return nil
+}
+
+// RestoreView returns the view named by the ViewParam query parameter,
+// looking it up in the user's saved views first and decoding it as an
+// encoded view otherwise. It reports false when the parameter is absent.
+func RestoreView(r *http.Request, store ViewStore, user string) (View, bool, error) {
+	value := r.URL.Query().Get(ViewParam)
+	if value == "" {
+		return View{}, false, nil
+	}
+	if store != nil {
+		views, err := store.Views(r.Context(), user)
+		if err != nil {
+			return View{}, false, err
+		}
+		if i := slices.IndexFunc(views, func(v View) bool { return v.Name == value }); i >= 0 {
+			return views[i], true, nil
+		}
+	}
+	v, err := DecodeView(value)
+	if err != nil {
+		return View{}, false, err
+	}
+	return v, true, nil
+}
+
+// Handlers serves the endpoints of data tables
+type Handlers struct {
+	ViewsPath  string                       // Path the "Save view" form posts to
+	Store      ViewStore                    // Saved views (required with ViewsPath)
+	User       func(r *http.Request) string // Identifies the user views belong to (default: a single shared user)
+	ExportPath string                       // Path exports are downloaded from
+	Export     ExportHandler                // Export of the table (required with ExportPath)
+	EditPath   string                       // Path of the inline edit endpoint
+	BulkPath   string                       // Path bulk actions post to
+	Rows       RowStore                     // Rows to edit or expand (required with EditPath, BulkPath or DetailPath)
+	Table      func(r *http.Request) Props  // Props of the table being edited (required with EditPath or BulkPath)
+	DetailPath string                       // Path detail rows are loaded from
+	Detail     DetailFunc                   // Renders the details of a row (required with DetailPath)
+}
+
+// Register registers the data table routes on rt
+func (h Handlers) Register(rt router.Router) {
+	if h.ViewsPath != "" {
+		if h.Store == nil {
+			panic("datatable.Handlers: Store is required")
+		}
+		rt.Handle(http.MethodPost, h.ViewsPath, http.HandlerFunc(h.saveView))
+	}
+	if h.ExportPath != "" {
+		if h.Export.Rows == nil {
+			panic("datatable.Handlers: Export.Rows is required")
+		}
+		export := h.Export
+		if export.Store == nil {
+			export.Store = h.Store
+		}
+		if export.User == nil {
+			export.User = h.User
+		}
+		rt.Handle(http.MethodGet, h.ExportPath, export)
+	}
+	if h.EditPath != "" || h.BulkPath != "" || h.DetailPath != "" {
+		if h.Rows == nil {
+			panic("datatable.Handlers: Rows is required")
+		}
+	}
+	if h.EditPath != "" || h.BulkPath != "" {
+		if h.Table == nil {
+			panic("datatable.Handlers: Table is required")
+		}
+	}
+	if h.EditPath != "" {
+		rt.Handle(http.MethodGet, h.EditPath, http.HandlerFunc(h.serveEdit))
+		rt.Handle(http.MethodPost, h.EditPath, http.HandlerFunc(h.serveEdit))
+	}
+	if h.BulkPath != "" {
+		rt.Handle(http.MethodPost, h.BulkPath, http.HandlerFunc(h.serveBulk))
+	}
+	if h.DetailPath != "" {
+		if h.Detail == nil {
+			panic("datatable.Handlers: Detail is required")
+		}
+		rt.Handle(http.MethodGet, h.DetailPath, http.HandlerFunc(h.serveDetail))
+	}
+}
+
+// saveView stores the posted view and redirects back to the table with the
+// view applied. Posting with a "delete" field removes the view instead.
+func (h Handlers) saveView(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form", http.StatusBadRequest)
+		return
+	}
+	name := r.PostForm.Get("name")
+	if name == "" {
+		http.Error(w, "View name is required", http.StatusBadRequest)
+		return
+	}
+	user := ""
+	if h.User != nil {
+		user = h.User(r)
+	}
+
+	if r.PostForm.Has("delete") {
+		if err := h.Store.DeleteView(r.Context(), user, name); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http.Redirect(w, r, backURL(r, ""), http.StatusSeeOther)
+		return
+	}
+
+	var v View
+	if state := r.PostForm.Get("state"); state != "" {
+		if err := json.Unmarshal([]byte(state), &v); err != nil {
+			http.Error(w, "Invalid view state", http.StatusBadRequest)
+			return
+		}
+	}
+	v.Name = name
+	if err := h.Store.SaveView(r.Context(), user, v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, backURL(r, name), http.StatusSeeOther)
+}
+
+// backURL returns the referring page with the ViewParam set to view, or
+// removed when view is empty
+func backURL(r *http.Request, view string) string {
+	u, err := url.Parse(r.Referer())
+	if err != nil || r.Referer() == "" || (u.Host != "" && u.Host != r.Host) {
+		u = &url.URL{Path: "/"}
+	}
+	q := u.Query()
+	if view == "" {
+		q.Del(ViewParam)
+	} else {
+		q.Set(ViewParam, view)
+	}
+	u.RawQuery = q.Encode()
+	return u.RequestURI()
+}
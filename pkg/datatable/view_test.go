@@ -0,0 +1,103 @@
+package datatable
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+)
+
+func TestViewApply(t *testing.T) {
+	v := View{
+		Name:          "Compact",
+		SortColumn:    "name",
+		SortDirection: "desc",
+		HiddenColumns: []string{"email"},
+		PageSize:      25,
+	}
+	props := v.Apply(Props{PageSize: 10})
+	if props.ActiveView != "Compact" || props.SortColumn != "name" || props.PageSize != 25 || len(props.HiddenColumns) != 1 {
+		t.Errorf("unexpected props %+v", props)
+	}
+
+	got := ViewFromProps("Compact", props)
+	if got.Name != v.Name || got.SortDirection != v.SortDirection || got.PageSize != v.PageSize {
+		t.Errorf("expected %+v, got %+v", v, got)
+	}
+}
+
+func TestRestoreView(t *testing.T) {
+	store := NewMemoryViewStore()
+	store.SaveView(context.Background(), "ada", View{Name: "Mine", PageSize: 50})
+	encoded, err := EncodeView(View{Name: "Shared", SortColumn: "email"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		found   bool
+		wantErr bool
+	}{
+		{name: "no parameter", query: ""},
+		{name: "saved view", query: "view=Mine", want: "Mine", found: true},
+		{name: "encoded view", query: "view=" + encoded, want: "Shared", found: true},
+		{name: "unknown view", query: "view=Missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/table?"+tt.query, nil)
+			v, found, err := RestoreView(r, store, "ada")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if found != tt.found || v.Name != tt.want {
+				t.Errorf("expected %q (%v), got %q (%v)", tt.want, tt.found, v.Name, found)
+			}
+		})
+	}
+}
+
+func TestHandlersSaveView(t *testing.T) {
+	store := NewMemoryViewStore()
+	mux := http.NewServeMux()
+	Handlers{
+		ViewsPath: "/views",
+		Store:     store,
+		User:      func(r *http.Request) string { return "ada" },
+	}.Register(router.ServeMux(mux))
+
+	post := func(form url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/views", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set("Referer", "http://example.com/users?page=2")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, r)
+		return rec
+	}
+
+	rec := post(url.Values{"name": {"Compact"}, "state": {`{"hidden":["email"],"pageSize":5}`}})
+	if rec.Code != http.StatusSeeOther || rec.Header().Get("Location") != "/users?page=2&view=Compact" {
+		t.Fatalf("unexpected response %d %q", rec.Code, rec.Header().Get("Location"))
+	}
+	views, _ := store.Views(context.Background(), "ada")
+	if len(views) != 1 || views[0].Name != "Compact" || views[0].PageSize != 5 || views[0].HiddenColumns[0] != "email" {
+		t.Fatalf("unexpected views %+v", views)
+	}
+
+	if rec := post(url.Values{"state": {"{}"}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected bad request without a name, got %d", rec.Code)
+	}
+
+	post(url.Values{"name": {"Compact"}, "delete": {"1"}})
+	if views, _ := store.Views(context.Background(), "ada"); len(views) != 0 {
+		t.Errorf("expected view to be deleted, got %+v", views)
+	}
+}
@@ -27,6 +27,7 @@ type Props struct {
 	Open        bool      // Whether the popover is open
 	Class       string    // Additional CSS classes
 	OnSelect    string    // JavaScript to run on date selection
+	Attrs       []g.Node  // Additional attributes to pass through
 }
 
 // New creates a new DatePicker component
@@ -53,6 +54,7 @@ func New(props Props) g.Node {
 		popover.Props{
 			Open:  props.Open,
 			Class: props.Class,
+			Attrs: props.Attrs,
 		},
 		// Trigger button
 		popover.Trigger(
@@ -94,7 +96,7 @@ func New(props Props) g.Node {
 					if !props.Value.IsZero() {
 						return props.Value
 					}
-					return time.Now()
+					return lib.Now()
 				}(),
 				MinDate: props.MinDate,
 				MaxDate: props.MaxDate,
@@ -132,6 +134,7 @@ func WithRange(props RangeProps) g.Node {
 		popover.Props{
 			Open:  props.Open,
 			Class: props.Class,
+			Attrs: props.Attrs,
 		},
 		// Trigger button
 		popover.Trigger(
@@ -173,7 +176,7 @@ func WithRange(props RangeProps) g.Node {
 						if !props.StartDate.IsZero() {
 							return props.StartDate
 						}
-						return time.Now()
+						return lib.Now()
 					}(),
 					MinDate: props.MinDate,
 					MaxDate: props.MaxDate,
@@ -184,7 +187,7 @@ func WithRange(props RangeProps) g.Node {
 						if !props.StartDate.IsZero() {
 							return props.StartDate.AddDate(0, 1, 0)
 						}
-						return time.Now().AddDate(0, 1, 0)
+						return lib.Now().AddDate(0, 1, 0)
 					}(),
 					MinDate: props.MinDate,
 					MaxDate: props.MaxDate,
@@ -207,6 +210,7 @@ type RangeProps struct {
 	Open        bool      // Whether the popover is open
 	Class       string    // Additional CSS classes
 	OnSelect    string    // JavaScript to run on selection
+	Attrs       []g.Node  // Additional attributes to pass through
 }
 
 // WithPresets creates a date picker with preset date options
@@ -224,7 +228,7 @@ func WithPresets(props PresetsProps) g.Node {
 
 	// Set default presets if none provided
 	if len(props.Presets) == 0 {
-		now := time.Now()
+		now := lib.Now()
 		props.Presets = []Preset{
 			{Label: "Today", Date: now},
 			{Label: "Tomorrow", Date: now.AddDate(0, 0, 1)},
@@ -244,6 +248,7 @@ func WithPresets(props PresetsProps) g.Node {
 		popover.Props{
 			Open:  props.Open,
 			Class: props.Class,
+			Attrs: props.Attrs,
 		},
 		// Trigger button
 		popover.Trigger(
@@ -309,7 +314,7 @@ func WithPresets(props PresetsProps) g.Node {
 					if !props.Value.IsZero() {
 						return props.Value
 					}
-					return time.Now()
+					return lib.Now()
 				}(),
 				MinDate: props.MinDate,
 				MaxDate: props.MaxDate,
@@ -332,6 +337,7 @@ type PresetsProps struct {
 	Class       string    // Additional CSS classes
 	OnSelect    string    // JavaScript to run on selection
 	Presets     []Preset  // Preset date options
+	Attrs       []g.Node  // Additional attributes to pass through
 }
 
 // Preset defines a preset date option
@@ -363,7 +369,7 @@ func WithInput(props InputProps) g.Node {
 		inputValue = props.Value.Format(props.Format)
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(lib.CN("grid gap-2", props.Class)),
 		// Label if provided
 		g.If(props.Label != "", html.Label(
@@ -423,7 +429,7 @@ func WithInput(props InputProps) g.Node {
 						if !props.Value.IsZero() {
 							return props.Value
 						}
-						return time.Now()
+						return lib.Now()
 					}(),
 					MinDate: props.MinDate,
 					MaxDate: props.MaxDate,
@@ -435,7 +441,7 @@ func WithInput(props InputProps) g.Node {
 			html.Class("text-sm text-muted-foreground"),
 			g.Text(props.HelperText),
 		)),
-	)
+	}, props.Attrs)...)
 }
 
 // InputProps defines properties for a date picker with input field
@@ -456,6 +462,7 @@ type InputProps struct {
 	Class         string    // Additional CSS classes
 	OnSelect      string    // JavaScript to run on selection
 	OnChange      string    // JavaScript to run on input change
+	Attrs         []g.Node  // Additional attributes to pass through
 }
 
 // Simple creates a simple date picker button
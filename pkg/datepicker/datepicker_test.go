@@ -7,6 +7,7 @@ import (
 	"time"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -436,4 +437,12 @@ func renderToString(component g.Node) (string, error) {
 		return "", err
 	}
 	return buf.String(), nil
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.FixTime(t, time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC))
+
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
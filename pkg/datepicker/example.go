@@ -5,11 +5,13 @@ import (
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
 )
 
 // Example demonstrates various DatePicker configurations
 func Example() g.Node {
-	now := time.Now()
+	now := lib.Now()
 	selectedDate := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
 
 	return html.Div(
@@ -278,7 +280,7 @@ func Example() g.Node {
 			html.Pre(html.Class("text-xs bg-muted p-4 rounded-lg overflow-x-auto"),
 				html.Code(g.Raw(`// Basic date picker
 datepicker.New(datepicker.Props{
-    Value:       time.Now(),
+    Value:       lib.Now(),
     Placeholder: "Select date",
     Format:      "Jan 2, 2006",
 })
@@ -293,8 +295,8 @@ datepicker.WithRange(datepicker.RangeProps{
 datepicker.WithPresets(datepicker.PresetsProps{
     Value: selectedDate,
     Presets: []datepicker.Preset{
-        {Label: "Today", Date: time.Now()},
-        {Label: "Tomorrow", Date: time.Now().AddDate(0, 0, 1)},
+        {Label: "Today", Date: lib.Now()},
+        {Label: "Tomorrow", Date: lib.Now().AddDate(0, 0, 1)},
     },
 })
 
@@ -5,48 +5,62 @@ import (
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
 )
 
 // Props defines the properties for the Dialog component
 type Props struct {
-	Open  bool   // Whether the dialog is open (for server-side rendering)
-	Class string // Additional custom classes
+	Open  bool        // Whether the dialog is open (for server-side rendering)
+	Class string      // Additional custom classes
+	Modal modal.Props // Focus, Escape and overlay dismissal behaviour
+	Attrs []g.Node    // Additional attributes to pass through
 }
 
 // ContentProps defines the properties for the DialogContent
 type ContentProps struct {
-	Class            string
-	ShowCloseButton  bool // Whether to show the close button
+	Class           string
+	ShowCloseButton bool     // Whether to show the close button
+	LabelledBy      string   // ID of the title (found automatically when empty)
+	DescribedBy     string   // ID of the description (found automatically when empty)
+	Attrs           []g.Node // Additional attributes to pass through
 }
 
 // HeaderProps defines the properties for the DialogHeader
 type HeaderProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // FooterProps defines the properties for the DialogFooter
 type FooterProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TitleProps defines the properties for the DialogTitle
 type TitleProps struct {
+	ID    string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // DescriptionProps defines the properties for the DialogDescription
 type DescriptionProps struct {
+	ID    string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines the properties for the DialogTrigger
 type TriggerProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // CloseProps defines the properties for the DialogClose
 type CloseProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Dialog component
@@ -63,9 +77,12 @@ func New(props Props, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
-		append([]g.Node{html.Class(classes)}, children...)...,
-	)
+	return html.Div(lib.MergeAttrs([]g.Node{
+		html.Class(classes),
+		modal.Root(props.Modal),
+		g.Group(children),
+		modal.Script(),
+	}, props.Attrs)...)
 }
 
 // Overlay creates the Dialog overlay
@@ -75,7 +92,7 @@ func Overlay(class ...string) g.Node {
 		lib.CN(class...),
 	)
 
-	return html.Div(html.Class(classes))
+	return html.Div(html.Class(classes), modal.Overlay())
 }
 
 // DialogContent creates the Dialog content container
@@ -90,16 +107,18 @@ func DialogContent(props ContentProps, children ...g.Node) g.Node {
 		closeButton := html.Button(
 			html.Type("button"),
 			html.Class("absolute right-4 top-4 rounded-sm opacity-70 ring-offset-background transition-opacity hover:opacity-100 focus:outline-none focus:ring-2 focus:ring-ring focus:ring-offset-2 disabled:pointer-events-none"),
+			modal.Close(),
 			icons.X(html.Class("h-4 w-4")),
 			html.Span(html.Class("sr-only"), g.Text("Close")),
 		)
 		contentChildren = append([]g.Node{closeButton}, children...)
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
+		modal.Content(modal.ContentProps{LabelledBy: props.LabelledBy, DescribedBy: props.DescribedBy}),
 		g.Group(contentChildren),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogHeader creates the Dialog header
@@ -109,10 +128,10 @@ func DialogHeader(props HeaderProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogFooter creates the Dialog footer
@@ -122,10 +141,10 @@ func DialogFooter(props FooterProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DialogTitle creates the Dialog title
@@ -135,10 +154,12 @@ func DialogTitle(props TitleProps, text string) g.Node {
 		props.Class,
 	)
 
-	return html.H2(
+	return html.H2(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Title(),
 		g.Text(text),
-	)
+	}, props.Attrs)...)
 }
 
 // Description creates the Dialog description
@@ -148,10 +169,12 @@ func Description(props DescriptionProps, text string) g.Node {
 		props.Class,
 	)
 
-	return html.P(
+	return html.P(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Description(),
 		g.Text(text),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a trigger button for the dialog (requires JavaScript)
@@ -160,11 +183,11 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Close creates a close button
@@ -173,11 +196,12 @@ func Close(props CloseProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
+		modal.Close(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Example creates a basic dialog example
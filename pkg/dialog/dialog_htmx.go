@@ -9,6 +9,8 @@ import (
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the Dialog
@@ -23,28 +25,34 @@ type HTMXProps struct {
 func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	if props.Open {
 		classes := lib.CN("fixed inset-0 z-50", props.Class)
-		return html.Div(
+		modalProps := props.Modal
+		if modalProps.ClosePath == "" {
+			modalProps.ClosePath = htmxProps.ClosePath
+		}
+		return html.Div(lib.MergeAttrs([]g.Node{
 			html.ID(htmxProps.ID),
 			html.Class(classes),
+			modal.Root(modalProps),
 			g.Group(children),
-		)
+			modal.Script(),
+		}, props.Attrs)...)
 	}
 	// Return empty div that can be replaced by HTMX
-	return html.Div(html.ID(htmxProps.ID))
+	return html.Div(lib.MergeAttrs([]g.Node{html.ID(htmxProps.ID)}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger
 func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		hx.Get(htmxProps.TriggerPath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // OverlayHTMX creates an HTMX-enhanced overlay with close functionality
@@ -56,10 +64,12 @@ func OverlayHTMX(htmxProps HTMXProps, class ...string) g.Node {
 
 	return html.Div(
 		html.Class(classes),
+		modal.Overlay(),
 		hx.Get(htmxProps.ClosePath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
-		hx.Trigger("click"),
+		// Only clicks on the overlay itself close, not ones bubbling from content
+		hx.Trigger("click[target === this]"),
 	)
 }
 
@@ -76,7 +86,7 @@ func DialogContentHTMX(props ContentProps, htmxProps HTMXProps, children ...g.No
 			html.Type("button"),
 			html.Class("absolute right-4 top-4 rounded-sm opacity-70 ring-offset-background transition-opacity hover:opacity-100 focus:outline-none focus:ring-2 focus:ring-ring focus:ring-offset-2 disabled:pointer-events-none"),
 			hx.Get(htmxProps.ClosePath),
-			html.Target("#" + htmxProps.ID),
+			hx.Target("#" + htmxProps.ID),
 			hx.Swap("outerHTML"),
 			icons.X(html.Class("h-4 w-4")),
 			html.Span(html.Class("sr-only"), g.Text("Close")),
@@ -84,26 +94,25 @@ func DialogContentHTMX(props ContentProps, htmxProps HTMXProps, children ...g.No
 		contentChildren = append([]g.Node{closeButton}, children...)
 	}
 
-	// Prevent clicks inside content from closing dialog
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
-		hx.On("click", "event.stopPropagation()"),
+		modal.Content(modal.ContentProps{LabelledBy: props.LabelledBy, DescribedBy: props.DescribedBy}),
 		g.Group(contentChildren),
-	)
+	}, props.Attrs)...)
 }
 
 // CloseHTMX creates an HTMX-enhanced close button
 func CloseHTMX(props CloseProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		hx.Get(htmxProps.ClosePath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // FormDialogHTMX creates a dialog with an HTMX-enhanced form
@@ -117,7 +126,7 @@ func FormDialogHTMX(htmxProps HTMXProps, formAction string, title string, childr
 			htmxProps,
 			html.Form(
 				hx.Post(formAction),
-				html.Target("#" + htmxProps.ID),
+				hx.Target("#" + htmxProps.ID),
 				hx.Swap("outerHTML"),
 				DialogHeader(
 					HeaderProps{},
@@ -188,7 +197,7 @@ func RenderOpenDialog(htmxProps HTMXProps) g.Node {
 					html.Type("submit"),
 					html.Class("bg-primary text-primary-foreground hover:bg-primary/90"),
 					hx.Post("/api/dialog/save-profile"),
-					html.Target("#" + htmxProps.ID),
+					hx.Target("#" + htmxProps.ID),
 					hx.Swap("outerHTML"),
 					g.Text("Save changes"),
 				),
@@ -238,7 +247,7 @@ func RenderLoginDialog(htmxProps HTMXProps) g.Node {
 					html.Required(),
 					hx.Trigger("keyup changed delay:500ms"),
 					hx.Post("/api/validate/email"),
-					html.Target("#email-error"),
+					hx.Target("#email-error"),
 					hx.Swap("innerHTML"),
 				),
 				html.Div(html.ID("email-error"), html.Class("text-sm text-destructive")),
@@ -291,7 +300,7 @@ func SearchDialogExampleHTMX() g.Node {
 			html.Type("button"),
 			html.Class("inline-flex items-center gap-2 border rounded-md px-3 py-2 text-sm"),
 			hx.Get(htmxProps.TriggerPath),
-			html.Target("#" + htmxProps.ID),
+			hx.Target("#" + htmxProps.ID),
 			hx.Swap("outerHTML"),
 			icons.Search(html.Class("h-4 w-4")),
 			g.Text("Searchtml..."),
@@ -318,7 +327,7 @@ func RenderSearchDialog(htmxProps HTMXProps) g.Node {
 					html.Placeholder("Type a command or searchtml..."),
 					hx.Post("/api/search"),
 					hx.Trigger("keyup changed delay:300ms"),
-					html.Target("#search-results"),
+					hx.Target("#search-results"),
 					hx.Swap("innerHTML"),
 					hx.Indicator("#search-spinner"),
 				),
@@ -336,16 +345,19 @@ func RenderSearchDialog(htmxProps HTMXProps) g.Node {
 	)
 }
 
-// DialogHandlers creates HTTP handlers for dialog components
-func DialogHandlers(mux *http.ServeMux) {
+// Handlers serves the dialog demo endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Demo dialog handlers
 	demoProps := HTMXProps{
 		ID:          "demo-dialog-htmx",
-		TriggerPath: "/htmx/dialog/demo/open",
-		ClosePath:   "/htmx/dialog/demo/close",
+		TriggerPath: rt.Path("/htmx/dialog/demo/open"),
+		ClosePath:   rt.Path("/htmx/dialog/demo/close"),
 	}
 	
-	mux.HandleFunc("/htmx/dialog/demo/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/htmx/dialog/demo/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := NewHTMX(
 			Props{Open: true},
 			demoProps,
@@ -376,22 +388,22 @@ func DialogHandlers(mux *http.ServeMux) {
 						html.Type("button"),
 						html.Class("bg-primary text-primary-foreground hover:bg-primary/90"),
 						g.Text("Save changes"),
-						hx.Post("/htmx/dialog/demo/save"),
-						html.Target("#demo-dialog-htmx"),
+						hx.Post(rt.Path("/htmx/dialog/demo/save")),
+						hx.Target("#demo-dialog-htmx"),
 						hx.Swap("outerHTML"),
 					),
 				),
 			),
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/htmx/dialog/demo/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/htmx/dialog/demo/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedDialog(demoProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/htmx/dialog/demo/save", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/htmx/dialog/demo/save", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Return success message
 		node := html.Div(
 			html.ID(demoProps.ID),
@@ -402,26 +414,26 @@ func DialogHandlers(mux *http.ServeMux) {
 			g.Attr("x-init", "setTimeout(() => $el.remove(), 3000)"),
 		)
 		node.Render(w)
-	})
+	}))
 	
 	// Basic dialog handlers
 	htmxProps := HTMXProps{
 		ID:          "dialog-example",
-		TriggerPath: "/api/dialog/open",
-		ClosePath:   "/api/dialog/close",
+		TriggerPath: rt.Path("/api/dialog/open"),
+		ClosePath:   rt.Path("/api/dialog/close"),
 	}
 	
-	mux.HandleFunc("/api/dialog/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/dialog/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderOpenDialog(htmxProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/dialog/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/dialog/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedDialog(htmxProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/dialog/save-profile", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/dialog/save-profile", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		name := r.FormValue("name")
 		username := r.FormValue("username")
@@ -438,26 +450,26 @@ func DialogHandlers(mux *http.ServeMux) {
 			g.Attr("x-init", "setTimeout(() => $el.remove(), 3000)"),
 		)
 		node.Render(w)
-	})
+	}))
 	
 	// Login dialog handlers
 	loginProps := HTMXProps{
 		ID:          "login-dialog",
-		TriggerPath: "/api/dialog/login/open",
-		ClosePath:   "/api/dialog/login/close",
+		TriggerPath: rt.Path("/api/dialog/login/open"),
+		ClosePath:   rt.Path("/api/dialog/login/close"),
 	}
 	
-	mux.HandleFunc("/api/dialog/login/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/dialog/login/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderLoginDialog(loginProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/dialog/login/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/dialog/login/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedDialog(loginProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/validate/email", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/validate/email", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		email := r.FormValue("email")
 		
@@ -478,9 +490,9 @@ func DialogHandlers(mux *http.ServeMux) {
 			node.Render(w)
 			return
 		}
-	})
+	}))
 	
-	mux.HandleFunc("/api/auth/login", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/auth/login", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		email := r.FormValue("email")
 		password := r.FormValue("password")
@@ -505,26 +517,26 @@ func DialogHandlers(mux *http.ServeMux) {
 			)
 			node.Render(w)
 		}
-	})
+	}))
 	
 	// Search dialog handlers
 	searchProps := HTMXProps{
 		ID:          "search-dialog",
-		TriggerPath: "/api/dialog/search/open",
-		ClosePath:   "/api/dialog/search/close",
+		TriggerPath: rt.Path("/api/dialog/search/open"),
+		ClosePath:   rt.Path("/api/dialog/search/close"),
 	}
 	
-	mux.HandleFunc("/api/dialog/search/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/dialog/search/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderSearchDialog(searchProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/dialog/search/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/dialog/search/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedDialog(searchProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/search", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/search", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		query := r.FormValue("value")
 		
@@ -589,7 +601,14 @@ func DialogHandlers(mux *http.ServeMux) {
 			})),
 		)
 		node.Render(w)
-	})
+	}))
+}
+
+// DialogHandlers creates HTTP handlers for dialog components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func DialogHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
 
 // ExampleWithHTMX creates a dialog example with HTMX demo
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/dialog"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestDialog(t *testing.T) {
@@ -444,4 +446,82 @@ func TestExampleCustom(t *testing.T) {
 			t.Error("expected checkmark icon path")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestModalBehaviour(t *testing.T) {
+	t.Run("static dialog wires ARIA and behaviour", func(t *testing.T) {
+		d := dialog.New(
+			dialog.Props{Open: true, Modal: modal.Props{InitialFocus: "#name"}},
+			dialog.Overlay(),
+			dialog.DialogContent(
+				dialog.ContentProps{LabelledBy: "edit-title", DescribedBy: "edit-desc"},
+				dialog.DialogTitle(dialog.TitleProps{ID: "edit-title"}, "Edit"),
+				dialog.Description(dialog.DescriptionProps{ID: "edit-desc"}, "Change things"),
+			),
+		)
+
+		var buf bytes.Buffer
+		if err := d.Render(&buf); err != nil {
+			t.Fatalf("Failed to render: %v", err)
+		}
+
+		html := buf.String()
+		for _, expected := range []string{
+			`data-modal=""`,
+			`data-modal-initial-focus="#name"`,
+			`data-modal-overlay=""`,
+			`role="dialog"`,
+			`aria-modal="true"`,
+			`aria-labelledby="edit-title"`,
+			`aria-describedby="edit-desc"`,
+			`id="edit-title"`,
+			`id="edit-desc"`,
+			"window.shadcnModal",
+		} {
+			if !strings.Contains(html, expected) {
+				t.Errorf("Expected output to contain %q, got %s", expected, html)
+			}
+		}
+	})
+
+	t.Run("htmx dialog dismisses through the close path", func(t *testing.T) {
+		htmxProps := dialog.HTMXProps{ID: "d", TriggerPath: "/open", ClosePath: "/close"}
+		d := dialog.NewHTMX(dialog.Props{Open: true}, htmxProps,
+			dialog.OverlayHTMX(htmxProps),
+			dialog.DialogContentHTMX(dialog.ContentProps{}, htmxProps,
+				dialog.Close(dialog.CloseProps{}, g.Text("Cancel")),
+			),
+		)
+
+		var buf bytes.Buffer
+		if err := d.Render(&buf); err != nil {
+			t.Fatalf("Failed to render: %v", err)
+		}
+
+		html := buf.String()
+		for _, expected := range []string{
+			`data-modal-close-path="/close"`,
+			`hx-target="#d"`,
+			`hx-trigger="click[target === this]"`,
+			`data-modal-close=""`,
+		} {
+			if !strings.Contains(html, expected) {
+				t.Errorf("Expected output to contain %q, got %s", expected, html)
+			}
+		}
+		// Clicks inside the content must reach the document-level close handler
+		if strings.Contains(html, "stopPropagation") {
+			t.Errorf("Expected content not to stop click propagation, got %s", html)
+		}
+	})
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":           dialog.Example,
+		"ExampleCustom":     dialog.ExampleCustom,
+		"ExampleHTMX":       dialog.ExampleHTMX,
+		"ExampleScrollable": dialog.ExampleScrollable,
+		"ExampleWithHTMX":   dialog.ExampleWithHTMX,
+	})
+}
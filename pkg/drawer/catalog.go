@@ -0,0 +1,26 @@
+package drawer
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Drawer",
+		Category:    "Feedback",
+		Description: "Sliding panel overlay",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+			{Name: "With Form", Func: "ExampleWithForm", Render: ExampleWithForm},
+			{Name: "Scrollable", Func: "ExampleScrollable", Render: ExampleScrollable},
+			{Name: "Without Overlay", Func: "ExampleWithoutOverlay", Render: ExampleWithoutOverlay},
+			{Name: "Custom Styling", Func: "ExampleCustomStyling", Render: ExampleCustomStyling},
+		},
+	})
+}
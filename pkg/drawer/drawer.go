@@ -4,6 +4,7 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
 )
 
 // Props defines the properties for the Drawer component
@@ -11,46 +12,60 @@ type Props struct {
 	Open  bool
 	Side  string // "left" | "right" | "top" | "bottom"
 	Class string
+	Modal modal.Props // Focus, Escape and overlay dismissal behaviour
+	Attrs []g.Node    // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for the drawer trigger
 type TriggerProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for the drawer content
 type ContentProps struct {
-	Class string
+	Class       string
+	LabelledBy  string   // ID of the title (found automatically when empty)
+	DescribedBy string   // ID of the description (found automatically when empty)
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // HeaderProps defines properties for the drawer header
 type HeaderProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TitleProps defines properties for the drawer title
 type TitleProps struct {
+	ID    string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // DescriptionProps defines properties for the drawer description
 type DescriptionProps struct {
+	ID    string
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // FooterProps defines properties for the drawer footer
 type FooterProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // CloseProps defines properties for the drawer close button
 type CloseProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // OverlayProps defines properties for the drawer overlay
 type OverlayProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // drawerVariants defines the variant configuration for drawers
@@ -73,25 +88,27 @@ var drawerVariants = lib.VariantConfig{
 func New(props Props, children ...g.Node) g.Node {
 	if props.Open {
 		classes := lib.CN("fixed inset-0 z-50", props.Class)
-		return html.Div(
+		return html.Div(lib.MergeAttrs([]g.Node{
 			html.Class(classes),
 			g.Attr("data-state", "open"),
+			modal.Root(props.Modal),
 			g.Group(children),
-		)
+			modal.Script(),
+		}, props.Attrs)...)
 	}
 	// Return empty div when closed
-	return html.Div(g.Attr("data-state", "closed"))
+	return html.Div(lib.MergeAttrs([]g.Node{g.Attr("data-state", "closed")}, props.Attrs)...)
 }
 
 // Trigger creates a drawer trigger
 func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Overlay creates a drawer overlay
@@ -102,10 +119,11 @@ func Overlay(props OverlayProps) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-state", "open"),
-	)
+		modal.Overlay(),
+	}, props.Attrs)...)
 }
 
 // Content creates the drawer content container
@@ -116,12 +134,13 @@ func ContentComponent(props ContentProps, side string, children ...g.Node) g.Nod
 		Class:   props.Class,
 	})
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
+		modal.Content(modal.ContentProps{LabelledBy: props.LabelledBy, DescribedBy: props.DescribedBy}),
 		g.Attr("data-state", "open"),
 		g.Attr("data-side", side),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DrawerHeader creates a drawer header section
@@ -131,10 +150,10 @@ func DrawerHeader(props HeaderProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DrawerTitle creates a drawer title
@@ -144,10 +163,12 @@ func DrawerTitle(props TitleProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.H2(
+	return html.H2(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Title(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DrawerDescription creates a drawer description
@@ -157,10 +178,12 @@ func DrawerDescription(props DescriptionProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.P(
+	return html.P(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Description(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DrawerFooter creates a drawer footer section
@@ -170,21 +193,22 @@ func DrawerFooter(props FooterProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Close creates a drawer close button
 func Close(props CloseProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
+		modal.Close(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // BasicExample creates a basic drawer example
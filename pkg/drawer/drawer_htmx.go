@@ -10,6 +10,8 @@ import (
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the Drawer
@@ -24,29 +26,35 @@ type HTMXProps struct {
 func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	if props.Open {
 		classes := lib.CN("fixed inset-0 z-50", props.Class)
-		return html.Div(
+		modalProps := props.Modal
+		if modalProps.ClosePath == "" {
+			modalProps.ClosePath = htmxProps.ClosePath
+		}
+		return html.Div(lib.MergeAttrs([]g.Node{
 			html.ID(htmxProps.ID),
 			html.Class(classes),
 			g.Attr("data-state", "open"),
+			modal.Root(modalProps),
 			g.Group(children),
-		)
+			modal.Script(),
+		}, props.Attrs)...)
 	}
 	// Return empty div that can be replaced by HTMX
-	return html.Div(html.ID(htmxProps.ID), g.Attr("data-state", "closed"))
+	return html.Div(lib.MergeAttrs([]g.Node{html.ID(htmxProps.ID), g.Attr("data-state", "closed")}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger
 func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		hx.Get(htmxProps.TriggerPath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // OverlayHTMX creates an HTMX-enhanced overlay with close functionality
@@ -60,10 +68,11 @@ func OverlayHTMX(htmxProps HTMXProps, class ...string) g.Node {
 	return html.Div(
 		html.Class(classes),
 		g.Attr("data-state", "open"),
+		modal.Overlay(),
 		hx.Get(htmxProps.ClosePath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
-		hx.Trigger("click"),
+		hx.Trigger("click[target === this]"),
 	)
 }
 
@@ -78,28 +87,27 @@ func ContentHTMX(props ContentProps, htmxProps HTMXProps, side string, children
 	// Add animation classes
 	classes = lib.CN(classes, "animate-in")
 
-	// Prevent clicks inside content from closing drawer
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
+		modal.Content(modal.ContentProps{LabelledBy: props.LabelledBy, DescribedBy: props.DescribedBy}),
 		g.Attr("data-state", "open"),
 		g.Attr("data-side", side),
-		hx.On("click", "event.stopPropagation()"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // CloseHTMX creates an HTMX-enhanced close button
 func CloseHTMX(props CloseProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		hx.Get(htmxProps.ClosePath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // CloseButtonHTMX creates a standard close button with X icon
@@ -108,7 +116,7 @@ func CloseButtonHTMX(htmxProps HTMXProps) g.Node {
 		html.Type("button"),
 		html.Class("absolute right-4 top-4 rounded-sm opacity-70 ring-offset-background transition-opacity hover:opacity-100 focus:outline-none focus:ring-2 focus:ring-ring focus:ring-offset-2 disabled:pointer-events-none"),
 		hx.Get(htmxProps.ClosePath),
-		html.Target("#" + htmxProps.ID),
+		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		icons.X(html.Class("h-4 w-4")),
 		html.Span(html.Class("sr-only"), g.Text("Close")),
@@ -128,7 +136,7 @@ func FormDrawerHTMX(htmxProps HTMXProps, side string, formAction string, title s
 			CloseButtonHTMX(htmxProps),
 			html.Form(
 				hx.Post(formAction),
-				html.Target("#" + htmxProps.ID),
+				hx.Target("#" + htmxProps.ID),
 				hx.Swap("outerHTML"),
 				DrawerHeader(
 					HeaderProps{},
@@ -208,7 +216,7 @@ func RenderOpenDrawer(htmxProps HTMXProps, side string) g.Node {
 					html.Type("submit"),
 					html.Class("bg-primary text-primary-foreground hover:bg-primary/90 px-4 py-2 rounded-md"),
 					hx.Post("/api/drawer/save-profile"),
-					html.Target("#" + htmxProps.ID),
+					hx.Target("#" + htmxProps.ID),
 					hx.Swap("outerHTML"),
 					g.Text("Save changes"),
 				),
@@ -235,7 +243,7 @@ func NavigationDrawerExampleHTMX() g.Node {
 			html.Type("button"),
 			html.Class("fixed left-4 top-4 z-40 rounded-md border p-2"),
 			hx.Get(htmxProps.TriggerPath),
-			html.Target("#" + htmxProps.ID),
+			hx.Target("#" + htmxProps.ID),
 			hx.Swap("outerHTML"),
 			icons.Menu(html.Class("h-4 w-4")),
 			html.Span(html.Class("sr-only"), g.Text("Open navigation")),
@@ -272,7 +280,7 @@ func RenderNavigationDrawer(htmxProps HTMXProps) g.Node {
 						html.Type("button"),
 						html.Class("rounded-sm opacity-70 ring-offset-background transition-opacity hover:opacity-100"),
 						hx.Get(htmxProps.ClosePath),
-						html.Target("#" + htmxProps.ID),
+						hx.Target("#" + htmxProps.ID),
 						hx.Swap("outerHTML"),
 						icons.X(html.Class("h-4 w-4")),
 						html.Span(html.Class("sr-only"), g.Text("Close")),
@@ -297,30 +305,33 @@ func RenderNavigationDrawer(htmxProps HTMXProps) g.Node {
 	)
 }
 
-// DrawerHandlers creates HTTP handlers for drawer components
-func DrawerHandlers(mux *http.ServeMux) {
+// Handlers serves the drawer demo endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Basic drawer handlers
 	htmxProps := HTMXProps{
 		ID:          "drawer-example",
-		TriggerPath: "/api/drawer/open",
-		ClosePath:   "/api/drawer/close",
+		TriggerPath: rt.Path("/api/drawer/open"),
+		ClosePath:   rt.Path("/api/drawer/close"),
 	}
 	
-	mux.HandleFunc("/api/drawer/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/drawer/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		side := r.URL.Query().Get("side")
 		if side == "" {
 			side = "right"
 		}
 		node := RenderOpenDrawer(htmxProps, side)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/drawer/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/drawer/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedDrawer(htmxProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/drawer/save-profile", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/drawer/save-profile", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		name := r.FormValue("name")
 		username := r.FormValue("username")
@@ -337,33 +348,33 @@ func DrawerHandlers(mux *http.ServeMux) {
 			g.Attr("x-init", "setTimeout(() => $el.remove(), 3000)"),
 		)
 		node.Render(w)
-	})
+	}))
 	
 	// Navigation drawer handlers
 	navProps := HTMXProps{
 		ID:          "nav-drawer",
-		TriggerPath: "/api/drawer/nav/open",
-		ClosePath:   "/api/drawer/nav/close",
+		TriggerPath: rt.Path("/api/drawer/nav/open"),
+		ClosePath:   rt.Path("/api/drawer/nav/close"),
 	}
 	
-	mux.HandleFunc("/api/drawer/nav/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/drawer/nav/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderNavigationDrawer(navProps)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/drawer/nav/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/drawer/nav/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedDrawer(navProps)
 		node.Render(w)
-	})
+	}))
 	
 	// Multiple drawers example
 	for _, side := range []string{"left", "right", "top", "bottom"} {
 		s := side // capture loop variable
-		mux.HandleFunc(fmt.Sprintf("/api/drawer/%s/open", s), func(w http.ResponseWriter, r *http.Request) {
+		rt.Handle(http.MethodGet, fmt.Sprintf("/api/drawer/%s/open", s), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			props := HTMXProps{
 				ID:          fmt.Sprintf("%s-drawer", s),
-				TriggerPath: fmt.Sprintf("/api/drawer/%s/open", s),
-				ClosePath:   fmt.Sprintf("/api/drawer/%s/close", s),
+				TriggerPath: rt.Path(fmt.Sprintf("/api/drawer/%s/open", s)),
+				ClosePath:   rt.Path(fmt.Sprintf("/api/drawer/%s/close", s)),
 			}
 			
 			node := NewHTMX(
@@ -399,14 +410,21 @@ func DrawerHandlers(mux *http.ServeMux) {
 				),
 			)
 			node.Render(w)
-		})
+		}))
 		
-		mux.HandleFunc(fmt.Sprintf("/api/drawer/%s/close", s), func(w http.ResponseWriter, r *http.Request) {
+		rt.Handle(http.MethodGet, fmt.Sprintf("/api/drawer/%s/close", s), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			props := HTMXProps{
 				ID: fmt.Sprintf("%s-drawer", s),
 			}
 			node := RenderClosedDrawer(props)
 			node.Render(w)
-		})
+		}))
 	}
+}
+
+// DrawerHandlers creates HTTP handlers for drawer components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func DrawerHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
\ No newline at end of file
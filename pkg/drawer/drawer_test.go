@@ -7,6 +7,7 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -37,12 +38,12 @@ func TestNew(t *testing.T) {
 				Overlay(OverlayProps{}),
 				ContentComponent(ContentProps{}, "right", g.Text("Content")),
 			},
-			want: `<div class="fixed inset-0 z-50" data-state="open">`,
+			want: `<div class="fixed inset-0 z-50" data-state="open" data-modal="">`,
 		},
 		{
 			name:  "with custom class",
 			props: Props{Open: true, Class: "custom-drawer"},
-			want:  `<div class="fixed inset-0 z-50 custom-drawer" data-state="open">`,
+			want:  `<div class="fixed inset-0 z-50 custom-drawer" data-state="open" data-modal="">`,
 		},
 	}
 
@@ -186,12 +187,12 @@ func TestDrawerTitle(t *testing.T) {
 		{
 			name:  "basic title",
 			props: TitleProps{},
-			want:  `<h2 class="text-lg font-semibold text-foreground">`,
+			want:  `<h2 class="text-lg font-semibold text-foreground" data-modal-title="">`,
 		},
 		{
 			name:  "with custom class",
 			props: TitleProps{Class: "custom-title"},
-			want:  `<h2 class="text-lg font-semibold text-foreground custom-title">`,
+			want:  `<h2 class="text-lg font-semibold text-foreground custom-title" data-modal-title="">`,
 		},
 	}
 
@@ -214,12 +215,12 @@ func TestDrawerDescription(t *testing.T) {
 		{
 			name:  "basic description",
 			props: DescriptionProps{},
-			want:  `<p class="text-sm text-muted-foreground">`,
+			want:  `<p class="text-sm text-muted-foreground" data-modal-description="">`,
 		},
 		{
 			name:  "with custom class",
 			props: DescriptionProps{Class: "custom-desc"},
-			want:  `<p class="text-sm text-muted-foreground custom-desc">`,
+			want:  `<p class="text-sm text-muted-foreground custom-desc" data-modal-description="">`,
 		},
 	}
 
@@ -270,12 +271,12 @@ func TestClose(t *testing.T) {
 		{
 			name:  "basic close",
 			props: CloseProps{},
-			want:  `<button type="button">`,
+			want:  `<button type="button" data-modal-close="">`,
 		},
 		{
 			name:  "with custom class",
 			props: CloseProps{Class: "custom-close"},
-			want:  `<button type="button" class="custom-close">`,
+			want:  `<button type="button" class="custom-close" data-modal-close="">`,
 		},
 	}
 
@@ -347,4 +348,16 @@ func TestCompleteDrawer(t *testing.T) {
 			t.Errorf("Complete drawer missing: %v", want)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":               Example,
+		"ExampleCustomStyling":  ExampleCustomStyling,
+		"ExampleHTMX":           ExampleHTMX,
+		"ExampleScrollable":     ExampleScrollable,
+		"ExampleSides":          ExampleSides,
+		"ExampleWithForm":       ExampleWithForm,
+		"ExampleWithoutOverlay": ExampleWithoutOverlay,
+	})
+}
@@ -0,0 +1,29 @@
+package dropdownmenu
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Dropdown Menu",
+		Category:    "Navigation",
+		Description: "Dropdown menu component",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Basic", Func: "DemoBasic", Render: DemoBasic},
+			{Name: "Checkboxes", Func: "DemoCheckboxes", Render: DemoCheckboxes},
+			{Name: "Radio Group", Func: "DemoRadioGroup", Render: DemoRadioGroup},
+			{Name: "With Submenu", Func: "DemoWithSubmenu", Render: DemoWithSubmenu},
+			{Name: "With Custom Trigger", Func: "DemoWithCustomTrigger", Render: DemoWithCustomTrigger},
+			{Name: "Alignment", Func: "DemoAlignment", Render: DemoAlignment},
+			{Name: "States", Func: "DemoStates", Render: DemoStates},
+			{Name: "Complex", Func: "DemoComplex", Render: DemoComplex},
+		},
+	})
+}
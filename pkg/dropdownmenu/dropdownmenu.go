@@ -4,13 +4,16 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
 )
 
 // Props defines the properties for the DropdownMenu component
 type Props struct {
-	Open  bool   // Whether the dropdown is open (for server-side rendering)
-	Class string // Additional custom classes
+	Open  bool     // Whether the dropdown is open (for server-side rendering)
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines the properties for the Trigger
@@ -18,14 +21,17 @@ type TriggerProps struct {
 	Class    string
 	AsChild  bool // Whether to render as child element
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines the properties for the Content
 type ContentProps struct {
 	Class      string
-	SideOffset int    // Offset from the trigger
-	Align      string // Alignment: "start", "center", "end"
-	Side       string // Side: "top", "right", "bottom", "left"
+	SideOffset int                // Offset from the trigger
+	Align      string             // Alignment: "start", "center", "end"
+	Side       string             // Side: "top", "right", "bottom", "left"
+	Collision  floating.Collision // Viewport collision handling
+	Attrs      []g.Node           // Additional attributes to pass through
 }
 
 // ItemProps defines the properties for menu items
@@ -33,6 +39,7 @@ type ItemProps struct {
 	Class    string
 	Inset    bool // Whether to add inset padding
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // CheckboxItemProps defines the properties for checkbox items
@@ -40,6 +47,7 @@ type CheckboxItemProps struct {
 	Class    string
 	Checked  bool
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // RadioItemProps defines the properties for radio items
@@ -47,33 +55,39 @@ type RadioItemProps struct {
 	Class    string
 	Value    string
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // LabelProps defines the properties for labels
 type LabelProps struct {
 	Class string
 	Inset bool
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SeparatorProps defines the properties for separators
 type SeparatorProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ShortcutProps defines the properties for shortcuts
 type ShortcutProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // GroupProps defines the properties for groups
 type GroupProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SubProps defines the properties for submenus
 type SubProps struct {
 	Open  bool
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SubTriggerProps defines the properties for submenu triggers
@@ -81,17 +95,21 @@ type SubTriggerProps struct {
 	Class    string
 	Inset    bool
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // SubContentProps defines the properties for submenu content
 type SubContentProps struct {
-	Class string
+	Class     string
+	Collision floating.Collision // Viewport collision handling
+	Attrs     []g.Node           // Additional attributes to pass through
 }
 
 // RadioGroupProps defines the properties for radio groups
 type RadioGroupProps struct {
 	Class string
-	Value string // Selected value
+	Value string   // Selected value
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new DropdownMenu component
@@ -104,7 +122,7 @@ func New(props Props, children ...g.Node) g.Node {
 	)
 
 	return html.Div(
-		append([]g.Node{html.Class(classes)}, children...)...,
+		lib.MergeAttrs(append([]g.Node{html.Class(classes)}, children...), props.Attrs)...,
 	)
 }
 
@@ -129,7 +147,7 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	}
 
 	return html.Button(
-		append(attrs, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -142,12 +160,25 @@ func DropdownContent(props ContentProps, children ...g.Node) g.Node {
 
 	// For server-side rendering, we'll just render the content as visible
 	// In a real implementation, this would be controlled by JavaScript
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("role", "menu"),
 		g.Attr("aria-orientation", "vertical"),
+		roving.Group(roving.Props{}),
+		floating.Attrs(props.floatingProps()),
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
+}
+
+// floatingProps returns the placement handed to the positioning script
+func (props ContentProps) floatingProps() floating.Props {
+	return floating.Props{
+		Side:       props.Side,
+		Align:      props.Align,
+		SideOffset: props.SideOffset,
+		Collision:  props.Collision,
+	}
 }
 
 // Item creates a menu item
@@ -161,7 +192,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		g.Attr("role", "menuitem"),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -170,7 +201,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -190,7 +221,7 @@ func CheckboxItem(props CheckboxItemProps, children ...g.Node) g.Node {
 		html.Class(classes),
 		g.Attr("role", "menuitemcheckbox"),
 		g.Attr("aria-checked", checkedValue),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -206,7 +237,7 @@ func CheckboxItem(props CheckboxItemProps, children ...g.Node) g.Node {
 	)
 
 	return html.Div(
-		append(attrs, checkmark, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, checkmark, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -220,7 +251,7 @@ func RadioItem(props RadioItemProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		g.Attr("role", "menuitemradio"),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 		g.Attr("data-value", props.Value),
 	}
 
@@ -236,7 +267,7 @@ func RadioItem(props RadioItemProps, children ...g.Node) g.Node {
 	)
 
 	return html.Div(
-		append(attrs, indicator, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, indicator, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -248,10 +279,10 @@ func DropdownLabel(props LabelProps, text string) g.Node {
 	}
 	classes := lib.CN(baseClasses, props.Class)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Text(text),
-	)
+	}, props.Attrs)...)
 }
 
 // Separator creates a menu separator
@@ -261,10 +292,10 @@ func Separator(props SeparatorProps) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("role", "separator"),
-	)
+	}, props.Attrs)...)
 }
 
 // Shortcut creates a keyboard shortcut indicator
@@ -274,21 +305,21 @@ func Shortcut(props ShortcutProps, text string) g.Node {
 		props.Class,
 	)
 
-	return html.Span(
+	return html.Span(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Text(text),
-	)
+	}, props.Attrs)...)
 }
 
 // Group creates a menu group
 func Group(props GroupProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("role", "group"),
 		g.If(classes != "", html.Class(classes)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // DropdownSub creates a submenu container
@@ -298,10 +329,10 @@ func DropdownSub(props SubProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // SubTrigger creates a submenu trigger
@@ -317,7 +348,7 @@ func SubTrigger(props SubTriggerProps, children ...g.Node) g.Node {
 		g.Attr("role", "menuitem"),
 		g.Attr("aria-haspopup", "menu"),
 		g.Attr("aria-expanded", "false"),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -329,7 +360,7 @@ func SubTrigger(props SubTriggerProps, children ...g.Node) g.Node {
 	chevron := icons.ChevronRight(g.Attr("class", "ml-auto h-4 w-4"))
 
 	return html.Div(
-		append(attrs, g.Group(children), chevron)...,
+		lib.MergeAttrs(append(attrs, g.Group(children), chevron), props.Attrs)...,
 	)
 }
 
@@ -340,12 +371,20 @@ func SubContent(props SubContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("role", "menu"),
 		g.Attr("aria-orientation", "vertical"),
+		roving.Group(roving.Props{}),
+		floating.Attrs(floating.Props{
+			Side:        floating.SideRight,
+			Align:       floating.AlignStart,
+			AlignOffset: -5,
+			Collision:   props.Collision,
+		}),
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // RadioGroup creates a radio group container
@@ -361,11 +400,11 @@ func RadioGroup(props RadioGroupProps, children ...g.Node) g.Node {
 		processedChildren = append(processedChildren, child)
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("role", "radiogroup"),
 		g.If(classes != "", html.Class(classes)),
 		g.Group(processedChildren),
-	)
+	}, props.Attrs)...)
 }
 
 // RadioItemWithSelection creates a radio item with selection state
@@ -384,7 +423,7 @@ func RadioItemWithSelection(props RadioItemProps, selected bool, children ...g.N
 		html.Class(classes),
 		g.Attr("role", "menuitemradio"),
 		g.Attr("aria-checked", checkedValue),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 		g.Attr("data-value", props.Value),
 	}
 
@@ -402,7 +441,7 @@ func RadioItemWithSelection(props RadioItemProps, selected bool, children ...g.N
 	)
 
 	return html.Div(
-		append(attrs, indicator, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, indicator, g.Group(children)), props.Attrs)...,
 	)
 }
 
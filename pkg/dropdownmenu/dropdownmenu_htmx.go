@@ -9,7 +9,10 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the DropdownMenu
@@ -26,12 +29,12 @@ func NewHTMX(props Props, htmxProps HTMXProps, trigger g.Node, content g.Node) g
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID),
 		html.Class(classes),
 		trigger,
 		g.If(props.Open, content),
-	)
+	}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger
@@ -59,7 +62,7 @@ func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, isOpen bool, children
 	}
 
 	return html.Button(
-		append(attrs, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -91,14 +94,17 @@ func DropdownContentHTMX(props ContentProps, htmxProps HTMXProps, children ...g.
 		alignment = "left-1/2 -translate-x-1/2"
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(lib.CN(classes, position, alignment)),
 		g.Attr("role", "menu"),
 		g.Attr("aria-orientation", "vertical"),
+		floating.Attrs(props.floatingProps()),
+		roving.Group(roving.Props{AutoFocus: true}),
 		// Close on click outside
 		hx.On("click", "event.stopPropagation()"),
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ItemHTMX creates an HTMX-enhanced menu item
@@ -112,7 +118,7 @@ func ItemHTMX(props ItemProps, htmxProps HTMXProps, action string, children ...g
 	attrs := []g.Node{
 		html.Class(classes),
 		g.Attr("role", "menuitem"),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 	}
 
 	if !props.Disabled && action != "" {
@@ -129,7 +135,7 @@ func ItemHTMX(props ItemProps, htmxProps HTMXProps, action string, children ...g
 	}
 
 	return html.Div(
-		append(attrs, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -149,7 +155,7 @@ func CheckboxItemHTMX(props CheckboxItemProps, htmxProps HTMXProps, name string,
 		html.Class(classes),
 		g.Attr("role", "menuitemcheckbox"),
 		g.Attr("aria-checked", checkedValue),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 	}
 
 	if !props.Disabled {
@@ -174,7 +180,7 @@ func CheckboxItemHTMX(props CheckboxItemProps, htmxProps HTMXProps, name string,
 	)
 
 	return html.Div(
-		append(attrs, checkmark, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, checkmark, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -194,7 +200,7 @@ func RadioItemHTMX(props RadioItemProps, htmxProps HTMXProps, groupName string,
 		html.Class(classes),
 		g.Attr("role", "menuitemradio"),
 		g.Attr("aria-checked", checkedValue),
-		g.Attr("tabindex", "-1"),
+		roving.Item(false),
 		g.Attr("data-value", props.Value),
 	}
 
@@ -221,7 +227,7 @@ func RadioItemHTMX(props RadioItemProps, htmxProps HTMXProps, groupName string,
 	)
 
 	return html.Div(
-		append(attrs, indicator, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, indicator, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -477,19 +483,26 @@ func RenderCommandDropdown(htmxProps HTMXProps, isOpen bool, query string) g.Nod
 	)
 }
 
-// DropdownMenuHandlers creates HTTP handlers for dropdown menu components
-func DropdownMenuHandlers(mux *http.ServeMux) {
+// Handlers serves the endpoints of ExampleHTMX and CommandPaletteExampleHTMX.
+// The routes are relative to the router; the examples expect them under
+// /api/dropdown:
+//
+//	router.Mount(router.WithPrefix(router.ServeMux(mux), "/api/dropdown"), dropdownmenu.Handlers{})
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// State storage (in production, use a proper session store)
 	menuStates := make(map[string]*DropdownMenuState)
 
 	// Basic dropdown handlers
 	htmxProps := HTMXProps{
 		ID:         "dropdown-example",
-		TogglePath: "/api/dropdown/toggle",
-		ItemPath:   "/api/dropdown/item",
+		TogglePath: rt.Path("/toggle"),
+		ItemPath:   rt.Path("/item"),
 	}
 
-	mux.HandleFunc("/api/dropdown/toggle", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		open := r.FormValue("open") == "true"
 
@@ -511,9 +524,9 @@ func DropdownMenuHandlers(mux *http.ServeMux) {
 
 		node := RenderDropdownMenu(htmxProps, open, state)
 		node.Render(w)
-	})
+	}))
 
-	mux.HandleFunc("/api/dropdown/item/checkbox", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/item/checkbox", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		name := r.FormValue("name")
 		checked := r.FormValue("checked") == "true"
@@ -532,9 +545,9 @@ func DropdownMenuHandlers(mux *http.ServeMux) {
 		// Re-render menu
 		node := RenderDropdownMenu(htmxProps, true, state)
 		node.Render(w)
-	})
+	}))
 
-	mux.HandleFunc("/api/dropdown/item/radio", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/item/radio", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		group := r.FormValue("group")
 		value := r.FormValue("value")
@@ -553,11 +566,11 @@ func DropdownMenuHandlers(mux *http.ServeMux) {
 		// Re-render menu
 		node := RenderDropdownMenu(htmxProps, true, state)
 		node.Render(w)
-	})
+	}))
 
 	// Handle menu item actions
-	mux.HandleFunc("/api/dropdown/item/", func(w http.ResponseWriter, r *http.Request) {
-		action := strings.TrimPrefix(r.URL.Path, "/api/dropdown/item/")
+	rt.Handle(http.MethodPost, "/item/{action}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.PathValue("action")
 
 		// Close menu and show notification
 		var message string
@@ -585,34 +598,34 @@ func DropdownMenuHandlers(mux *http.ServeMux) {
 			),
 		)
 		node.Render(w)
-	})
+	}))
 
 	// Command palette handlers
 	cmdProps := HTMXProps{
 		ID:         "command-dropdown",
-		TogglePath: "/api/dropdown/command/toggle",
-		ItemPath:   "/api/dropdown/command/item",
+		TogglePath: rt.Path("/command/toggle"),
+		ItemPath:   rt.Path("/command/item"),
 	}
 
-	mux.HandleFunc("/api/dropdown/command/toggle", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/command/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		open := r.FormValue("open") == "true"
 
 		node := RenderCommandDropdown(cmdProps, open, "")
 		node.Render(w)
-	})
+	}))
 
-	mux.HandleFunc("/api/dropdown/command/item/search", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/command/item/search", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		query := r.FormValue("value")
 
 		node := RenderCommandDropdown(cmdProps, true, query)
 		node.Render(w)
-	})
+	}))
 
 	// Command actions
-	mux.HandleFunc("/api/dropdown/command/item/", func(w http.ResponseWriter, r *http.Request) {
-		action := strings.TrimPrefix(r.URL.Path, "/api/dropdown/command/item/")
+	rt.Handle(http.MethodPost, "/command/item/{action}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.PathValue("action")
 
 		// Close menu and show action
 		node := html.Div(
@@ -626,7 +639,14 @@ func DropdownMenuHandlers(mux *http.ServeMux) {
 			),
 		)
 		node.Render(w)
-	})
+	}))
+}
+
+// DropdownMenuHandlers creates HTTP handlers for dropdown menu components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func DropdownMenuHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.WithPrefix(router.ServeMux(mux), "/api/dropdown"))
 }
 
 // Define missing icons for the command example
@@ -2,11 +2,17 @@ package dropdownmenu_test
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/dropdownmenu"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestDropdownMenu(t *testing.T) {
@@ -670,4 +676,57 @@ func TestExampleWithSubmenu(t *testing.T) {
 			t.Error("expected new team shortcut")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	menu := dropdownmenu.DropdownContent(
+		dropdownmenu.ContentProps{},
+		dropdownmenu.Item(dropdownmenu.ItemProps{}, g.Text("Profile")),
+		dropdownmenu.DropdownSub(dropdownmenu.SubProps{},
+			dropdownmenu.SubTrigger(dropdownmenu.SubTriggerProps{}, g.Text("Invite")),
+			dropdownmenu.SubContent(dropdownmenu.SubContentProps{},
+				dropdownmenu.Item(dropdownmenu.ItemProps{}, g.Text("Email")),
+			),
+		),
+	)
+
+	output := a11ytest.Conformance(t, menu, a11y.Options{Fragment: true}, []string{
+		`role="menu"`,
+		`role="menuitem"`,
+		`aria-haspopup="menu"`,
+		`data-roving-orientation="vertical"`,
+		`tabindex="-1" data-roving-item=""`,
+		"window.shadcnRoving",
+	}, nil)
+	if strings.Count(output, `data-roving=""`) != 2 {
+		t.Errorf("expected the menu and its submenu to be separate roving groups, got %s", output)
+	}
+}
+
+func TestHandlersPrefix(t *testing.T) {
+	mux := http.NewServeMux()
+	router.Mount(router.WithPrefix(router.ServeMux(mux), "/ui/menu"), dropdownmenu.Handlers{})
+
+	for _, path := range []string{"/ui/menu/toggle", "/ui/menu/command/toggle"} {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader("open=true"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d", path, rec.Code)
+		}
+		if body := rec.Body.String(); !strings.Contains(body, `hx-post="/ui/menu/`) || strings.Contains(body, "/api/dropdown") {
+			t.Errorf("%s: expected paths under the prefix, got %s", path, body)
+		}
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":            dropdownmenu.Example,
+		"ExampleCheckboxes":  dropdownmenu.ExampleCheckboxes,
+		"ExampleHTMX":        dropdownmenu.ExampleHTMX,
+		"ExampleRadioGroup":  dropdownmenu.ExampleRadioGroup,
+		"ExampleWithSubmenu": dropdownmenu.ExampleWithSubmenu,
+	})
+}
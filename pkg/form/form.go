@@ -8,15 +8,17 @@ import (
 
 // Props defines the properties for the Form component
 type Props struct {
-	Method  string // "get" | "post"
-	Action  string
-	Class   string
-	OnSubmit string // JavaScript onsubmit handler
+	Method   string // "get" | "post"
+	Action   string
+	Class    string
+	OnSubmit string   // JavaScript onsubmit handler
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // ItemProps defines properties for form items
 type ItemProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // LabelProps defines properties for form labels
@@ -24,33 +26,39 @@ type LabelProps struct {
 	For      string
 	Required bool
 	Class    string
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // ControlProps defines properties for form controls
 type ControlProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // DescriptionProps defines properties for form descriptions
 type DescriptionProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // MessageProps defines properties for form messages
 type MessageProps struct {
 	Class string
-	Error bool // If true, styles as error message
+	Error bool     // If true, styles as error message
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // FieldsetProps defines properties for fieldsets
 type FieldsetProps struct {
 	Class    string
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // LegendProps defines properties for legends
 type LegendProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Form component
@@ -73,17 +81,17 @@ func New(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, g.Attr("onsubmit", props.OnSubmit))
 	}
 	
-	return html.Form(append(attrs, children...)...)
+	return html.Form(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // FormItem creates a form item container
 func FormItem(props ItemProps, children ...g.Node) g.Node {
 	classes := lib.CN("space-y-2", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // FormLabel creates a form label
@@ -108,17 +116,17 @@ func FormLabel(props LabelProps, children ...g.Node) g.Node {
 		)
 	}
 	
-	return html.Label(append(attrs, labelChildren...)...)
+	return html.Label(lib.MergeAttrs(append(attrs, labelChildren...), props.Attrs)...)
 }
 
 // FormControl creates a form control wrapper
 func FormControl(props ControlProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.If(classes != "", html.Class(classes)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // FormDescription creates a form field description
@@ -128,10 +136,10 @@ func FormDescription(props DescriptionProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.P(
+	return html.P(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // FormMessage creates a form validation message
@@ -142,10 +150,10 @@ func FormMessage(props MessageProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.P(
+	return html.P(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // FormFieldset creates a fieldset
@@ -160,7 +168,7 @@ func FormFieldset(props FieldsetProps, children ...g.Node) g.Node {
 		attrs = append(attrs, html.Disabled())
 	}
 	
-	return html.FieldSet(append(attrs, children...)...)
+	return html.FieldSet(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // FormLegend creates a legend for a fieldset
@@ -170,10 +178,10 @@ func FormLegend(props LegendProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Legend(
+	return html.Legend(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 
@@ -222,8 +230,8 @@ func FormActions(props ItemProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
\ No newline at end of file
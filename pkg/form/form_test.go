@@ -6,6 +6,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -425,4 +426,14 @@ func TestCompleteForm(t *testing.T) {
 			t.Errorf("Complete form missing: %v", want)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":             Example,
+		"ExampleInline":       ExampleInline,
+		"ExampleInputGroups":  ExampleInputGroups,
+		"ExampleRegistration": ExampleRegistration,
+		"ExampleSettings":     ExampleSettings,
+	})
+}
@@ -0,0 +1,24 @@
+package hovercard
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Hover Card",
+		Category:    "Navigation",
+		Description: "Card shown on hover",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+			{Name: "With Custom Content", Func: "ExampleWithCustomContent", Render: ExampleWithCustomContent},
+			{Name: "With Positioning", Func: "ExampleWithPositioning", Render: ExampleWithPositioning},
+		},
+	})
+}
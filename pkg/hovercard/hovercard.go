@@ -4,52 +4,57 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 )
 
 // Props defines the properties for the HoverCard component
 type Props struct {
 	Open  bool
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for the hover card trigger
 type TriggerProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for the hover card content
 type ContentProps struct {
-	Class    string
-	Side     string // "top" | "bottom" | "left" | "right"
-	Align    string // "start" | "center" | "end"
-	SideOffset int  // Offset from the trigger
-	AlignOffset int // Offset along the alignment axis
+	Class       string
+	Side        string             // "top" | "bottom" | "left" | "right"
+	Align       string             // "start" | "center" | "end"
+	SideOffset  int                // Offset from the trigger
+	AlignOffset int                // Offset along the alignment axis
+	Collision   floating.Collision // Viewport collision handling
+	Attrs       []g.Node           // Additional attributes to pass through
 }
 
 // New creates a new HoverCard component
 func New(props Props, children ...g.Node) g.Node {
 	classes := lib.CN("relative inline-block", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-hover-card", "root"),
 		g.If(props.Open, g.Attr("data-state", "open")),
 		g.If(!props.Open, g.Attr("data-state", "closed")),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a hover card trigger
 func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	classes := lib.CN("cursor-pointer", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-hover-card", "trigger"),
 		g.Attr("aria-haspopup", "dialog"),
 		g.Attr("aria-expanded", "false"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Content creates the hover card content
@@ -61,32 +66,31 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	// Set default values
-	side := props.Side
-	if side == "" {
-		side = "bottom"
-	}
-	
-	align := props.Align
-	if align == "" {
-		align = "center"
-	}
-	
-	sideOffset := props.SideOffset
-	if sideOffset == 0 {
-		sideOffset = 4
-	}
-	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-hover-card", "content"),
 		g.Attr("data-state", "closed"),
-		g.Attr("data-side", side),
-		g.Attr("data-align", align),
+		floating.Attrs(props.floatingProps()),
 		html.Style("position: absolute; display: none;"),
 		g.Attr("role", "dialog"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
+}
+
+// floatingProps returns the placement handed to the positioning script
+func (props ContentProps) floatingProps() floating.Props {
+	sideOffset := props.SideOffset
+	if sideOffset == 0 {
+		sideOffset = 4
+	}
+
+	return floating.Props{
+		Side:        props.Side,
+		Align:       props.Align,
+		SideOffset:  sideOffset,
+		AlignOffset: props.AlignOffset,
+		Collision:   props.Collision,
+	}
 }
 
 // BasicExample creates a basic hover card example
@@ -9,6 +9,8 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the HoverCard
@@ -16,6 +18,7 @@ type HTMXProps struct {
 	ID          string // Unique ID for the hover card
 	ContentPath string // Server path for loading content
 	Delay       int    // Delay in ms before showing (default 200)
+	Content     ContentProps // Placement of the content container
 }
 
 // NewHTMX creates an HTMX-enhanced HoverCard component
@@ -27,7 +30,7 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 		delay = 200
 	}
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID),
 		html.Class(classes),
 		g.Attr("data-hover-card", "root"),
@@ -41,11 +44,8 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 					const content = document.querySelector('#%s-content');
 					if (content) {
 						content.style.display = 'block';
-						// Position the content
-						const trigger = event.currentTarget.querySelector('[data-hover-card="trigger"]');
-						const rect = trigger.getBoundingClientRect();
-						content.style.left = rect.left + 'px';
-						content.style.top = (rect.bottom + 4) + 'px';
+						content.dataset.state = 'open';
+						if (window.shadcnFloating) window.shadcnFloating.position(content);
 					}
 				});
 			}, %d);
@@ -56,6 +56,7 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 				const content = document.querySelector('#%s-content');
 				if (content && !content.matches(':hover')) {
 					content.style.display = 'none';
+					content.dataset.state = 'closed';
 					content.innerHTML = '';
 				}
 			}, 100);
@@ -65,28 +66,38 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 		html.Div(
 			html.ID(htmxProps.ID+"-content"),
 			html.Class("fixed z-50 w-64 rounded-md border bg-popover p-4 text-popover-foreground shadow-md outline-none"),
+			g.Attr("data-state", "closed"),
+			floating.Attrs(htmxContentPlacement(htmxProps)),
 			html.Style("display: none;"),
 			hx.On("mouseleave", fmt.Sprintf(`
 				setTimeout(() => {
 					if (!document.querySelector('#%s').matches(':hover')) {
 						this.style.display = 'none';
+						this.dataset.state = 'closed';
 						this.innerHTML = '';
 					}
 				}, 100);
 			`, htmxProps.ID)),
 		),
-	)
+	}, props.Attrs)...)
+}
+
+// htmxContentPlacement anchors the content container to the card's trigger
+func htmxContentPlacement(htmxProps HTMXProps) floating.Props {
+	placement := htmxProps.Content.floatingProps()
+	placement.Anchor = "#" + htmxProps.ID + ` [data-hover-card="trigger"]`
+	return placement
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger
 func TriggerHTMX(props TriggerProps, children ...g.Node) g.Node {
 	classes := lib.CN("cursor-pointer", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-hover-card", "trigger"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ExampleHTMX creates an HTMX hover card example
@@ -208,11 +219,14 @@ func RenderLinkContent(url string, title string, description string, imageUrl st
 	)
 }
 
-// HoverCardHandlers creates HTTP handlers for hover card components
-func HoverCardHandlers(mux *http.ServeMux) {
+// Handlers serves the hover card content endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// User hover card handler
-	mux.HandleFunc("/api/hovercard/user/", func(w http.ResponseWriter, r *http.Request) {
-		username := r.URL.Path[len("/api/hovercard/user/"):]
+	rt.Handle(http.MethodGet, "/api/hovercard/user/{username}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username := r.PathValue("username")
 		
 		// Mock user data
 		users := map[string]struct {
@@ -249,11 +263,11 @@ func HoverCardHandlers(mux *http.ServeMux) {
 			node := RenderUserContent(username, username, "User not found", 0, 0)
 			node.Render(w)
 		}
-	})
+	}))
 	
 	// Profile hover card handler
-	mux.HandleFunc("/api/hovercard/profile/", func(w http.ResponseWriter, r *http.Request) {
-		username := r.URL.Path[len("/api/hovercard/profile/"):]
+	rt.Handle(http.MethodGet, "/api/hovercard/profile/{username}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username := r.PathValue("username")
 		
 		// Mock profile data
 		profiles := map[string]struct {
@@ -283,10 +297,10 @@ func HoverCardHandlers(mux *http.ServeMux) {
 			node := RenderUserContent(username, username, "Profile not found", 0, 0)
 			node.Render(w)
 		}
-	})
+	}))
 	
 	// Link preview handler
-	mux.HandleFunc("/api/hovercard/link", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/hovercard/link", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		url := r.URL.Query().Get("url")
 		
 		// Mock link previews
@@ -298,12 +312,12 @@ func HoverCardHandlers(mux *http.ServeMux) {
 			"https://nextjs.org": {
 				Title:       "Next.js by Vercel - The React Framework",
 				Description: "Next.js gives you the best developer experience with all the features you need for production.",
-				ImageUrl:    "/api/placeholder/640/320",
+				ImageUrl:    rt.Path("/api/placeholder/640/320"),
 			},
 			"https://tailwindcss.com": {
 				Title:       "Tailwind CSS - Rapidly build modern websites",
 				Description: "Tailwind CSS is a utility-first CSS framework for rapidly building modern websites without leaving your HTML.",
-				ImageUrl:    "/api/placeholder/640/320",
+				ImageUrl:    rt.Path("/api/placeholder/640/320"),
 			},
 		}
 		
@@ -315,15 +329,22 @@ func HoverCardHandlers(mux *http.ServeMux) {
 			node := RenderLinkContent(url, url, "Preview not available", "")
 			node.Render(w)
 		}
-	})
+	}))
 	
 	// Placeholder image handler
-	mux.HandleFunc("/api/placeholder/", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/placeholder/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Return a simple SVG placeholder
 		w.Header().Set("Content-Type", "image/svg+xml")
 		fmt.Fprintf(w, `<svg width="640" height="320" xmlns="http://www.w3.org/2000/svg">
 			<rect width="640" height="320" fill="#e5e7eb"/>
 			<text x="320" y="160" text-anchor="middle" fill="#9ca3af" font-family="sans-serif" font-size="20">Image Placeholder</text>
 		</svg>`)
-	})
+	}))
+}
+
+// HoverCardHandlers creates HTTP handlers for hover card components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func HoverCardHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
\ No newline at end of file
@@ -6,6 +6,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -302,4 +303,13 @@ func TestHTMXHoverCard(t *testing.T) {
 			t.Errorf("HTMX hover card missing: %v", want)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":                  Example,
+		"ExampleHTMX":              ExampleHTMX,
+		"ExampleWithCustomContent": ExampleWithCustomContent,
+		"ExampleWithPositioning":   ExampleWithPositioning,
+	})
+}
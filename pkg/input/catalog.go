@@ -0,0 +1,40 @@
+package input
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Input",
+		Category:    "Forms",
+		Description: "Text input fields",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Examples", Render: Examples},
+		},
+		Props: []catalog.Prop{
+			{Name: "Type", Type: catalog.Enum, Default: "text", Options: []string{"text", "email", "password", "number", "search"}},
+			{Name: "Placeholder", Default: "Email"},
+			{Name: "Disabled", Type: catalog.Bool, Default: "false"},
+			{Name: "AriaInvalid", Type: catalog.Bool, Default: "false", Description: "Marks the value as invalid"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return New(Props{
+				Type:        v.String("Type"),
+				Placeholder: v.String("Placeholder"),
+				Disabled:    v.Bool("Disabled"),
+				AriaInvalid: v.Bool("AriaInvalid"),
+				Class:       "max-w-sm",
+				Attrs:       []g.Node{html.Aria("label", "Playground input")},
+			})
+		},
+	})
+}
@@ -17,7 +17,8 @@ type Props struct {
 	Required     bool
 	AriaInvalid  bool
 	AutoComplete string
-	Class        string // Additional custom classes
+	Class        string   // Additional custom classes
+	Attrs        []g.Node // Additional attributes to pass through
 }
 
 // inputClasses defines the base classes for the input component
@@ -65,7 +66,7 @@ func New(props Props) g.Node {
 		attrs = append(attrs, html.AutoComplete(props.AutoComplete))
 	}
 
-	return html.Input(attrs...)
+	return html.Input(lib.MergeAttrs(attrs, props.Attrs)...)
 }
 
 // Text creates a text input
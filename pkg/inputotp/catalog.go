@@ -0,0 +1,22 @@
+package inputotp
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Input OTP",
+		Category:    "Forms",
+		Description: "One-time password input",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Examples", Render: Examples},
+		},
+	})
+}
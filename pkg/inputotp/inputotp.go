@@ -9,35 +9,39 @@ import (
 
 // Props defines the properties for the InputOTP component
 type Props struct {
-	ID           string   // Unique ID for the input group
-	Length       int      // Number of input fields (default: 6)
-	Type         string   // "numeric" | "alphanumeric" (default: "numeric")
-	Pattern      string   // Custom pattern for validation
-	Name         string   // Name attribute for form submission
-	Value        string   // Initial value
-	Disabled     bool     // Whether the input is disabled
-	AutoFocus    bool     // Whether to auto-focus the first input
-	OnComplete   string   // JavaScript function to call when all inputs are filled
-	Class        string   // Additional custom classes
-	Placeholder  string   // Placeholder for each input (default: "○")
+	ID          string   // Unique ID for the input group
+	Length      int      // Number of input fields (default: 6)
+	Type        string   // "numeric" | "alphanumeric" (default: "numeric")
+	Pattern     string   // Custom pattern for validation
+	Name        string   // Name attribute for form submission
+	Value       string   // Initial value
+	Disabled    bool     // Whether the input is disabled
+	AutoFocus   bool     // Whether to auto-focus the first input
+	OnComplete  string   // JavaScript function to call when all inputs are filled
+	Class       string   // Additional custom classes
+	Placeholder string   // Placeholder for each input (default: "○")
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // GroupProps defines properties for the OTP input group container
 type GroupProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SlotProps defines properties for individual OTP slots
 type SlotProps struct {
-	Index       int
-	IsActive    bool
-	HasValue    bool
-	Class       string
+	Index    int
+	IsActive bool
+	HasValue bool
+	Class    string
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // SeparatorProps defines properties for the separator between slots
 type SeparatorProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new InputOTP component
@@ -192,7 +196,7 @@ func New(props Props, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, g.Group(inputs), g.Group(children))...,
+		lib.MergeAttrs(append(attrs, g.Group(inputs), g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -203,10 +207,10 @@ func Group(props GroupProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Slot creates a single OTP input slot with enhanced styling
@@ -225,7 +229,7 @@ func Slot(props SlotProps) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Input(
 			html.Type("text"),
@@ -233,7 +237,7 @@ func Slot(props SlotProps) g.Node {
 			g.Attr("maxlength", "1"),
 			g.Attr("data-otp-input", fmt.Sprintf("%d", props.Index)),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // Separator creates a visual separator between OTP input groups
@@ -248,10 +252,10 @@ func Separator(props ...SeparatorProps) g.Node {
 		p.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Text("-"),
-	)
+	}, p.Attrs)...)
 }
 
 // Default creates a default 6-digit numeric OTP input
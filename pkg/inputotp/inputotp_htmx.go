@@ -1,11 +1,19 @@
 package inputotp
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/otp"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the InputOTP
@@ -211,14 +219,14 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 		)
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Div(
 			append(containerAttrs, g.Group(inputs))...,
 		),
 		feedbackContainer,
 		g.If(htmxProps.Indicator != "", loadingIndicator),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // VerificationFeedback creates a feedback message for OTP verification
@@ -248,19 +256,25 @@ func VerificationFeedback(success bool, message string, htmxProps HTMXProps) g.N
 	)
 }
 
-// ResendButton creates a button to resend OTP code
+// ResendButton creates a button to resend OTP code. The countdown only
+// reflects the cooldown; Handlers enforces it on the server.
 func ResendButton(htmxProps HTMXProps, resendPath string, cooldownSeconds int) g.Node {
-	return html.Button(
-		html.Type("button"),
-		html.Class("text-sm text-primary hover:underline disabled:opacity-50 disabled:cursor-not-allowed"),
-		hx.Post(resendPath),
-		hx.Target("#"+htmxProps.ID+"-feedback"),
-		hx.Swap("innerHTML"),
-		g.Attr("onclick", fmt.Sprintf(`
+	return resendButton(htmxProps, resendPath, cooldownSeconds, false)
+}
+
+// ResendButtonWait renders the resend button disabled for wait, the cooldown
+// the server reported. Handlers returns it out of band after every resend.
+func ResendButtonWait(htmxProps HTMXProps, resendPath string, wait time.Duration) g.Node {
+	seconds := int(math.Ceil(wait.Seconds()))
+	return resendButton(htmxProps, resendPath, seconds, true)
+}
+
+func resendButton(htmxProps HTMXProps, resendPath string, cooldownSeconds int, waiting bool) g.Node {
+	countdown := fmt.Sprintf(`
 			const btn = this;
 			btn.disabled = true;
 			let seconds = %d;
-			const originalText = btn.innerText;
+			const originalText = 'Resend code';
 			
 			const timer = setInterval(() => {
 				btn.innerText = 'Resend in ' + seconds + 's';
@@ -272,9 +286,31 @@ func ResendButton(htmxProps HTMXProps, resendPath string, cooldownSeconds int) g
 					btn.innerText = originalText;
 				}
 			}, 1000);
-		`, cooldownSeconds)),
-		g.Text("Resend code"),
-	)
+		`, cooldownSeconds)
+
+	attrs := []g.Node{
+		html.ID(htmxProps.ID + "-resend"),
+		html.Type("button"),
+		html.Class("text-sm text-primary hover:underline disabled:opacity-50 disabled:cursor-not-allowed"),
+		hx.Post(resendPath),
+		hx.Target("#" + htmxProps.ID + "-feedback"),
+		hx.Swap("innerHTML"),
+	}
+
+	if !waiting {
+		return html.Button(append(attrs, g.Attr("onclick", countdown), g.Text("Resend code"))...)
+	}
+
+	// Swapped in out of band, counting down from the server's cooldown
+	attrs = append(attrs, hx.SwapOOB("true"))
+	if cooldownSeconds <= 0 {
+		return html.Button(append(attrs, g.Text("Resend code"))...)
+	}
+	return html.Button(append(attrs,
+		html.Disabled(),
+		g.Attr("hx-on::load", countdown),
+		g.Textf("Resend in %ds", cooldownSeconds),
+	)...)
 }
 
 // ExampleHTMX creates an HTMX-enhanced OTP input example
@@ -373,4 +409,150 @@ func RenderVerificationError(htmxProps HTMXProps, errorMessage string) g.Node {
 		</svg>`),
 		g.Text(errorMessage),
 	)
-}
\ No newline at end of file
+}
+
+// Code returns the code submitted by NewHTMX, joining the per-digit fields
+// name[0] to name[length-1]. A single field called name is also accepted.
+func Code(r *http.Request, name string, length int) string {
+	if code := r.FormValue(name); code != "" {
+		return code
+	}
+	var code strings.Builder
+	for i := 0; i < length; i++ {
+		code.WriteString(r.FormValue(fmt.Sprintf("%s[%d]", name, i)))
+	}
+	return code.String()
+}
+
+// ErrorMessage returns the message shown to the user for an error from the
+// otp package
+func ErrorMessage(err error) string {
+	var cooldown *otp.CooldownError
+	switch {
+	case errors.As(err, &cooldown):
+		return fmt.Sprintf("Please wait %d seconds before requesting a new code.", int(math.Ceil(cooldown.RetryAfter.Seconds())))
+	case errors.Is(err, otp.ErrTooManyAttempts):
+		return "Too many attempts. Please request a new code later."
+	case errors.Is(err, otp.ErrExpired), errors.Is(err, otp.ErrNoCode):
+		return "This code has expired. Please request a new one."
+	default:
+		return "Invalid verification code."
+	}
+}
+
+// Handlers serves the verify and resend endpoints of an HTMX OTP input.
+// Verify and Resend connect them to a backend, usually an otp.Issuer:
+//
+//	inputotp.Handlers{
+//		HTMX:       htmxProps,
+//		ResendPath: "/otp/resend",
+//		Verify: func(r *http.Request, code string) error {
+//			return issuer.Verify(r.Context(), sessionID(r), code)
+//		},
+//		Resend: func(r *http.Request) (time.Duration, error) {
+//			err := issuer.Issue(r.Context(), sessionID(r), email(r))
+//			return issuer.Cooldown, err
+//		},
+//	}
+//
+// The paths are relative to the router the handlers are registered on.
+type Handlers struct {
+	HTMX       HTMXProps                                    // Input ID and VerifyPath
+	ResendPath string                                       // Resend endpoint; empty disables resending
+	Name       string                                       // Input name (default: "otp")
+	Length     int                                          // Code length (default: 6)
+	Verify     func(r *http.Request, code string) error     // Checks a submitted code (required)
+	Resend     func(r *http.Request) (time.Duration, error) // Sends a new code and returns the cooldown until the next
+	Verified   http.Handler                                 // Response after a successful check (default: RenderVerificationSuccess)
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
+	if h.HTMX.VerifyPath == "" {
+		panic("inputotp.Handlers: VerifyPath is required")
+	}
+	if h.Verify == nil {
+		panic("inputotp.Handlers: Verify is required")
+	}
+	if h.Name == "" {
+		h.Name = "otp"
+	}
+	if h.Length == 0 {
+		h.Length = 6
+	}
+
+	htmxProps := h.HTMX
+	htmxProps.VerifyPath = rt.Path(h.HTMX.VerifyPath)
+	resendPath := rt.Path(h.ResendPath)
+
+	rt.Handle(http.MethodPost, h.HTMX.VerifyPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := h.Verify(r, Code(r, h.Name, h.Length)); err != nil {
+			RenderVerificationError(htmxProps, ErrorMessage(err)).Render(w)
+			return
+		}
+		if h.Verified != nil {
+			h.Verified.ServeHTTP(w, r)
+			return
+		}
+		RenderVerificationSuccess(htmxProps).Render(w)
+	}))
+
+	if h.ResendPath == "" || h.Resend == nil {
+		return
+	}
+	rt.Handle(http.MethodPost, h.ResendPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wait, err := h.Resend(r)
+		var cooldown *otp.CooldownError
+		switch {
+		case errors.As(err, &cooldown):
+			wait = cooldown.RetryAfter
+		case err != nil:
+			wait = 0
+		}
+
+		feedback := VerificationFeedback(true, "A new code has been sent.", htmxProps)
+		if err != nil {
+			feedback = VerificationFeedback(false, ErrorMessage(err), htmxProps)
+		}
+		g.Group([]g.Node{
+			feedback,
+			ResendButtonWait(htmxProps, resendPath, wait),
+		}).Render(w)
+	}))
+}
+
+// Enrollment renders the steps to add key to an authenticator app: a QR code
+// of its otpauth URI, the secret for manual entry, and an input to confirm the
+// first code with
+func Enrollment(key otp.Key, props Props, htmxProps HTMXProps) g.Node {
+	secret := otp.EncodeSecret(key.Secret)
+	var groups []string
+	for i := 0; i < len(secret); i += 4 {
+		groups = append(groups, secret[i:min(i+4, len(secret))])
+	}
+
+	return html.Div(
+		html.Class("space-y-4"),
+		html.Div(
+			html.Class("flex justify-center rounded-lg border bg-white p-4"),
+			otp.QRCode(key.URI(), otp.QRProps{
+				Label: "QR code to add " + key.Account + " to an authenticator app",
+				Class: "size-48",
+			}),
+		),
+		html.Div(
+			html.Class("space-y-1 text-center"),
+			html.P(html.Class("text-sm text-muted-foreground"),
+				g.Text("Can't scan the code? Enter this key instead:"),
+			),
+			html.Code(
+				html.Class("rounded bg-muted px-2 py-1 font-mono text-sm tracking-wider select-all"),
+				g.Text(strings.Join(groups, " ")),
+			),
+		),
+		html.P(html.Class("text-sm font-medium"),
+			g.Text("Enter the code from your app"),
+		),
+		NewHTMX(props, htmxProps),
+	)
+}
@@ -2,10 +2,19 @@ package inputotp
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/otp"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -398,4 +407,104 @@ func TestAutocompleteAttribute(t *testing.T) {
 	if !strings.Contains(result, `autocomplete="one-time-code"`) {
 		t.Errorf("Default() = %v, want to contain autocomplete attribute", result)
 	}
-}
\ No newline at end of file
+}
+
+func TestHandlers(t *testing.T) {
+	var resends int
+	mux := http.NewServeMux()
+	router.Mount(router.ServeMux(mux), Handlers{
+		HTMX:       HTMXProps{ID: "otp", VerifyPath: "/otp/verify"},
+		ResendPath: "/otp/resend",
+		Verify: func(r *http.Request, code string) error {
+			if code != "123456" {
+				return otp.ErrInvalidCode
+			}
+			return nil
+		},
+		Resend: func(r *http.Request) (time.Duration, error) {
+			resends++
+			if resends > 1 {
+				return 0, &otp.CooldownError{RetryAfter: 12 * time.Second}
+			}
+			return 30 * time.Second, nil
+		},
+	})
+
+	post := func(path string, form url.Values) string {
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w.Body.String()
+	}
+
+	digits := url.Values{}
+	for i, d := range "123456" {
+		digits.Set(fmt.Sprintf("otp[%d]", i), string(d))
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		form     url.Values
+		contains []string
+	}{
+		{
+			name:     "valid code",
+			path:     "/otp/verify",
+			form:     digits,
+			contains: []string{`id="otp-feedback"`, "Verification successful!"},
+		},
+		{
+			name:     "invalid code",
+			path:     "/otp/verify",
+			form:     url.Values{"otp": {"000000"}},
+			contains: []string{`id="otp-feedback"`, "Invalid verification code."},
+		},
+		{
+			name:     "resend",
+			path:     "/otp/resend",
+			contains: []string{"A new code has been sent.", `id="otp-resend"`, `hx-swap-oob="true"`, "disabled", "Resend in 30s"},
+		},
+		{
+			name:     "resend during cooldown",
+			path:     "/otp/resend",
+			contains: []string{"Please wait 12 seconds before requesting a new code.", "Resend in 12s"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := post(tt.path, tt.form)
+			for _, expected := range tt.contains {
+				if !strings.Contains(result, expected) {
+					t.Errorf("expected result to contain %q, but it didn't.\nGot: %s", expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestEnrollment(t *testing.T) {
+	key := otp.Key{Secret: []byte("12345678901234567890"), Issuer: "Acme", Account: "ada@example.com"}
+	node := Enrollment(key, Props{Name: "otp"}, HTMXProps{ID: "enroll", VerifyPath: "/otp/enroll"})
+
+	result := renderToString(node)
+	for _, expected := range []string{
+		`aria-label="QR code to add ada@example.com to an authenticator app"`,
+		"GEZD GNBV GY3T QOJQ GEZD GNBV GY3T QOJQ",
+		`hx-post="/otp/enroll"`,
+	} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expected result to contain %q, but it didn't.\nGot: %s", expected, result)
+		}
+	}
+	a11ytest.Assert(t, node, a11y.Options{})
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"ExampleHTMX": ExampleHTMX,
+		"Examples":    Examples,
+	})
+}
@@ -0,0 +1,22 @@
+package label
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Label",
+		Category:    "Forms",
+		Description: "Accessible label for inputs",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Examples", Render: Examples},
+		},
+	})
+}
@@ -8,9 +8,10 @@ import (
 
 // Props defines the properties for the Label component
 type Props struct {
-	For      string // The ID of the input this label is for
-	Required bool   // Whether to show a required indicator
-	Class    string // Additional custom classes
+	For      string   // The ID of the input this label is for
+	Required bool     // Whether to show a required indicator
+	Class    string   // Additional custom classes
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // labelClasses defines the base classes for the label component
@@ -42,7 +43,7 @@ func New(props Props, children ...g.Node) g.Node {
 	}
 
 	// Combine attributes and children
-	return html.Label(append(attrs, children...)...)
+	return html.Label(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Default creates a basic label
@@ -0,0 +1,22 @@
+package menubar
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Menubar",
+		Category:    "Navigation",
+		Description: "Application menubar",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Examples", Render: Examples},
+		},
+	})
+}
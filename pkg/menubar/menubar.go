@@ -4,31 +4,38 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 )
 
 // Props defines the properties for the Menubar component
 type Props struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // MenuProps defines properties for a menu within the menubar
 type MenuProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for a menu trigger
 type TriggerProps struct {
 	Class    string
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for menu content
 type ContentProps struct {
-	Class     string
-	Align     string // "start" | "center" | "end"
-	Side      string // "top" | "bottom"
-	SideOffset int
+	Class       string
+	Align       string // "start" | "center" | "end"
+	Side        string // "top" | "bottom"
+	SideOffset  int
 	AlignOffset int
+	Collision   floating.Collision // Viewport collision handling
+	Attrs       []g.Node           // Additional attributes to pass through
 }
 
 // ItemProps defines properties for menu items
@@ -36,17 +43,20 @@ type ItemProps struct {
 	Class    string
 	Disabled bool
 	Inset    bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // SeparatorProps defines properties for menu separators
 type SeparatorProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // LabelProps defines properties for menu labels
 type LabelProps struct {
 	Class string
 	Inset bool
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // CheckboxItemProps defines properties for checkbox menu items
@@ -56,12 +66,14 @@ type CheckboxItemProps struct {
 	Disabled bool
 	Name     string
 	Value    string
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // RadioGroupProps defines properties for radio group
 type RadioGroupProps struct {
 	Value string
 	Name  string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // RadioItemProps defines properties for radio items
@@ -69,27 +81,33 @@ type RadioItemProps struct {
 	Class    string
 	Value    string
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // SubMenuProps defines properties for submenus
 type SubMenuProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // SubTriggerProps defines properties for submenu triggers
 type SubTriggerProps struct {
 	Class    string
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // SubContentProps defines properties for submenu content
 type SubContentProps struct {
-	Class string
+	Class     string
+	Collision floating.Collision // Viewport collision handling
+	Attrs     []g.Node           // Additional attributes to pass through
 }
 
 // ShortcutProps defines properties for keyboard shortcuts
 type ShortcutProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Menubar component
@@ -99,11 +117,14 @@ func New(props Props, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Role("menubar"),
+		g.Attr("aria-orientation", "horizontal"),
+		roving.Group(roving.Props{Orientation: roving.OrientationHorizontal}),
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Menu creates a menu within the menubar
@@ -113,10 +134,10 @@ func Menu(props MenuProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a menu trigger button
@@ -137,7 +158,9 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 		html.Class(classes),
 		html.Role("menuitem"),
 		g.Attr("aria-haspopup", "menu"),
+		g.Attr("aria-expanded", "false"),
 		g.Attr("data-state", "closed"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -145,7 +168,7 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	}
 
 	return html.Button(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -189,15 +212,28 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 		positionClasses += "left-0"
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(lib.CN(classes, positionClasses)),
 		html.Role("menu"),
 		g.Attr("aria-orientation", "vertical"),
 		g.Attr("data-state", "closed"),
-		g.Attr("data-side", props.Side),
+		floating.Attrs(props.floatingProps()),
+		roving.Group(roving.Props{}),
 		html.Style("display: none;"), // Hidden by default, shown via JavaScript
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
+}
+
+// floatingProps returns the placement handed to the positioning script
+func (props ContentProps) floatingProps() floating.Props {
+	return floating.Props{
+		Side:        props.Side,
+		Align:       props.Align,
+		SideOffset:  props.SideOffset,
+		AlignOffset: props.AlignOffset,
+		Collision:   props.Collision,
+	}
 }
 
 // Item creates a menu item
@@ -213,7 +249,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		html.Role("menuitem"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -221,7 +257,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -238,7 +274,7 @@ func CheckboxItem(props CheckboxItemProps, children ...g.Node) g.Node {
 		html.Class(classes),
 		html.Role("menuitemcheckbox"),
 		g.Attr("aria-checked", lib.CNIf(props.Checked, "true", "false")),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -255,17 +291,17 @@ func CheckboxItem(props CheckboxItemProps, children ...g.Node) g.Node {
 	)
 
 	return html.Div(
-		append(attrs, checkmark, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, checkmark, g.Group(children)), props.Attrs)...,
 	)
 }
 
 // RadioGroup creates a radio button group
 func RadioGroup(props RadioGroupProps, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Role("group"),
 		g.Attr("aria-orientation", "vertical"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // RadioItem creates a radio menu item
@@ -280,7 +316,7 @@ func RadioItem(props RadioItemProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		html.Role("menuitemradio"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -295,7 +331,7 @@ func RadioItem(props RadioItemProps, children ...g.Node) g.Node {
 	)
 
 	return html.Div(
-		append(attrs, indicator, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, indicator, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -307,10 +343,10 @@ func LabelComponent(props LabelProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Separator creates a menu separator
@@ -320,10 +356,10 @@ func Separator(props SeparatorProps) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Role("separator"),
-	)
+	}, props.Attrs)...)
 }
 
 // SubMenu creates a submenu
@@ -333,10 +369,10 @@ func SubMenu(props SubMenuProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // SubTrigger creates a submenu trigger
@@ -357,7 +393,7 @@ func SubTrigger(props SubTriggerProps, children ...g.Node) g.Node {
 		html.Role("menuitem"),
 		g.Attr("aria-haspopup", "menu"),
 		g.Attr("aria-expanded", "false"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 
 	if props.Disabled {
@@ -370,7 +406,7 @@ func SubTrigger(props SubTriggerProps, children ...g.Node) g.Node {
 	</svg>`)
 
 	return html.Div(
-		append(attrs, g.Group(children), chevron)...,
+		lib.MergeAttrs(append(attrs, g.Group(children), chevron), props.Attrs)...,
 	)
 }
 
@@ -389,14 +425,22 @@ func SubContent(props SubContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Role("menu"),
 		g.Attr("aria-orientation", "vertical"),
 		g.Attr("data-state", "closed"),
+		floating.Attrs(floating.Props{
+			Side:        floating.SideRight,
+			Align:       floating.AlignStart,
+			AlignOffset: -5,
+			Collision:   props.Collision,
+		}),
+		roving.Group(roving.Props{}),
 		html.Style("display: none;"), // Hidden by default
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Shortcut creates a keyboard shortcut indicator
@@ -406,10 +450,10 @@ func Shortcut(props ShortcutProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Span(
+	return html.Span(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Default creates a default menubar
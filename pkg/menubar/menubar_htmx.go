@@ -5,7 +5,10 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 	"fmt"
+	"strings"
 )
 
 // HTMXProps defines HTMX-specific properties for the Menubar
@@ -33,8 +36,11 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		html.Role("menubar"),
+		g.Attr("aria-orientation", "horizontal"),
 		g.If(htmxProps.ID != "", html.ID(htmxProps.ID)),
 		g.Attr("data-menubar", "true"),
+		roving.Group(roving.Props{Orientation: roving.OrientationHorizontal}),
+		roving.Script(),
 	}
 
 	// Add global click handler to close menus
@@ -43,7 +49,7 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -54,12 +60,12 @@ func MenuHTMX(props MenuProps, htmxProps MenuHTMXProps, children ...g.Node) g.No
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID),
 		html.Class(classes),
 		g.Attr("data-menu", "true"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced menu trigger
@@ -86,8 +92,10 @@ func TriggerHTMX(props TriggerProps, htmxProps MenuHTMXProps, children ...g.Node
 		html.Role("menuitem"),
 		g.Attr("aria-haspopup", "menu"),
 		g.Attr("aria-expanded", "false"),
+		g.Attr("aria-controls", strings.TrimPrefix(target, "#")),
 		g.Attr("data-state", "closed"),
 		g.Attr("data-menu-trigger", htmxProps.ID),
+		roving.Item(false),
 		
 		// HTMX attributes
 		hx.Get(htmxProps.ContentPath),
@@ -135,7 +143,7 @@ func TriggerHTMX(props TriggerProps, htmxProps MenuHTMXProps, children ...g.Node
 	}
 
 	return html.Button(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -182,13 +190,14 @@ func ContentHTMX(props ContentProps, htmxProps MenuHTMXProps, children ...g.Node
 		target = htmxProps.ID + "-content"
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(target),
 		html.Class(lib.CN(classes, positionClasses)),
 		html.Role("menu"),
 		g.Attr("aria-orientation", "vertical"),
 		g.Attr("data-state", "open"),
-		g.Attr("data-side", props.Side),
+		floating.Attrs(props.floatingProps()),
+		roving.Group(roving.Props{AutoFocus: true}),
 		g.Attr("data-menu-content", htmxProps.ID),
 		
 		// Click outside to close
@@ -200,38 +209,10 @@ func ContentHTMX(props ContentProps, htmxProps MenuHTMXProps, children ...g.Node
 			}
 			this.style.display = 'none';
 		`, htmxProps.ID)),
-		
-		// Keyboard navigation
-		g.Attr("onkeydown", `
-			const content = event.currentTarget;
-			const items = Array.from(content.querySelectorAll('[role="menuitem"]:not([data-disabled="true"])'));
-			const currentIndex = items.findIndex(item => item === document.activeElement);
-			
-			switch(event.key) {
-				case 'ArrowDown':
-					event.preventDefault();
-					const nextIndex = currentIndex < items.length - 1 ? currentIndex + 1 : 0;
-					items[nextIndex]?.focus();
-					break;
-				case 'ArrowUp':
-					event.preventDefault();
-					const prevIndex = currentIndex > 0 ? currentIndex - 1 : items.length - 1;
-					items[prevIndex]?.focus();
-					break;
-				case 'Escape':
-					const trigger = document.querySelector('[data-menu-trigger="${htmxProps.ID}"]');
-					if (trigger) {
-						trigger.setAttribute('data-state', 'closed');
-						trigger.setAttribute('aria-expanded', 'false');
-						trigger.focus();
-					}
-					content.style.display = 'none';
-					break;
-			}
-		`),
-		
+
+		roving.Script(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ItemHTMX creates an HTMX-enhanced menu item
@@ -247,7 +228,7 @@ func ItemHTMX(props ItemProps, actionPath string, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		html.Role("menuitem"),
-		html.TabIndex("-1"),
+		roving.Item(false),
 		
 		// HTMX action if provided
 		g.If(actionPath != "",
@@ -277,7 +258,7 @@ func ItemHTMX(props ItemProps, actionPath string, children ...g.Node) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -294,7 +275,7 @@ func CheckboxItemHTMX(props CheckboxItemProps, togglePath string, children ...g.
 		html.Class(classes),
 		html.Role("menuitemcheckbox"),
 		g.Attr("aria-checked", lib.CNIf(props.Checked, "true", "false")),
-		html.TabIndex("-1"),
+		roving.Item(false),
 		g.Attr("data-checked", lib.CNIf(props.Checked, "true", "false")),
 		
 		// HTMX toggle
@@ -320,7 +301,7 @@ func CheckboxItemHTMX(props CheckboxItemProps, togglePath string, children ...g.
 	)
 
 	return html.Div(
-		append(attrs, checkmark, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, checkmark, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -337,7 +318,7 @@ func RadioItemHTMX(props RadioItemProps, groupName string, selectPath string, is
 		html.Class(classes),
 		html.Role("menuitemradio"),
 		g.Attr("aria-checked", lib.CNIf(isSelected, "true", "false")),
-		html.TabIndex("-1"),
+		roving.Item(false),
 		
 		// HTMX selection
 		hx.Post(selectPath),
@@ -361,7 +342,7 @@ func RadioItemHTMX(props RadioItemProps, groupName string, selectPath string, is
 	)
 
 	return html.Div(
-		append(attrs, indicator, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, indicator, g.Group(children)), props.Attrs)...,
 	)
 }
 
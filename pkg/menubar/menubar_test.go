@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -555,4 +558,32 @@ func TestCompleteMenubar(t *testing.T) {
 			t.Errorf("Complete menubar = %v, want to contain %v", result, elem)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	menubar := New(Props{},
+		Menu(MenuProps{},
+			Trigger(TriggerProps{}, g.Text("File")),
+			ContentComponent(ContentProps{},
+				Item(ItemProps{}, g.Text("New Tab")),
+			),
+		),
+	)
+	a11ytest.Conformance(t, menubar, a11y.Options{Fragment: true}, []string{
+		`role="menubar"`,
+		`aria-orientation="horizontal"`,
+		`data-roving-orientation="horizontal"`,
+		`aria-haspopup="menu"`,
+		`aria-expanded="false"`,
+		`role="menu"`,
+		`data-roving-orientation="vertical"`,
+		`data-roving-item=""`,
+	}, nil)
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"ExampleHTMX": ExampleHTMX,
+		"Examples":    Examples,
+	})
+}
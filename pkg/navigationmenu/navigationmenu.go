@@ -8,30 +8,35 @@ import (
 
 // Props defines the properties for the NavigationMenu component
 type Props struct {
-	Class       string // Additional custom classes
-	Orientation string // "horizontal" | "vertical" (default: "horizontal")
+	Class       string   // Additional custom classes
+	Orientation string   // "horizontal" | "vertical" (default: "horizontal")
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // ListProps defines properties for the navigation menu list
 type ListProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ItemProps defines properties for navigation menu items
 type ItemProps struct {
 	Class string
-	Value string // Unique value for the item
+	Value string   // Unique value for the item
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for navigation triggers
 type TriggerProps struct {
 	Class    string
 	Disabled bool
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for navigation content
 type ContentProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // LinkProps defines properties for navigation links
@@ -40,16 +45,19 @@ type LinkProps struct {
 	Active   bool
 	Disabled bool
 	Href     string
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // ViewportProps defines properties for the viewport
 type ViewportProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // IndicatorProps defines properties for the active indicator
 type IndicatorProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new NavigationMenu component
@@ -64,27 +72,29 @@ func New(props Props, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Nav(
+	return html.Nav(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Role("navigation"),
 		g.Attr("data-orientation", props.Orientation),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
-// List creates a navigation menu list
+// List creates a navigation menu list. Triggers in the list disclose their
+// content on click and Escape closes it again, following the disclosure
+// navigation pattern rather than menu roles, since the items are site links.
 func ListComponent(props ListProps, children ...g.Node) g.Node {
 	classes := lib.CN(
 		"group flex flex-1 list-none items-center justify-center space-x-1",
 		props.Class,
 	)
 
-	return html.Ul(
+	return html.Ul(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
-		html.Role("none"),
 		g.Attr("data-orientation", "horizontal"),
+		html.Script(g.Raw(disclosureScript)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Item creates a navigation menu item
@@ -96,7 +106,6 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 
 	attrs := []g.Node{
 		html.Class(classes),
-		html.Role("none"),
 	}
 
 	if props.Value != "" {
@@ -104,7 +113,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	}
 
 	return html.Li(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -122,10 +131,9 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Type("button"),
 		html.Class(classes),
-		html.Role("menuitem"),
-		g.Attr("aria-haspopup", "menu"),
 		g.Attr("aria-expanded", "false"),
 		g.Attr("data-state", "closed"),
+		g.Attr("data-navigation-toggle", ""),
 	}
 
 	if props.Disabled {
@@ -138,7 +146,7 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	</svg>`)
 
 	return html.Button(
-		append(attrs, g.Group(children), chevron)...,
+		lib.MergeAttrs(append(attrs, g.Group(children), chevron), props.Attrs)...,
 	)
 }
 
@@ -155,12 +163,13 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-state", "closed"),
+		g.Attr("data-navigation-panel", ""),
 		html.Style("display: none;"), // Hidden by default
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Link creates a navigation link
@@ -183,7 +192,6 @@ func LinkComponent(props LinkProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		html.Href(props.Href),
-		html.Role("menuitem"),
 	}
 
 	if props.Active {
@@ -195,7 +203,7 @@ func LinkComponent(props LinkProps, children ...g.Node) g.Node {
 	}
 
 	return html.A(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -209,12 +217,12 @@ func Viewport(props ViewportProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-state", "closed"),
 		html.Style("display: none;"), // Hidden by default
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Indicator creates an active indicator
@@ -226,13 +234,13 @@ func Indicator(props IndicatorProps) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-state", "hidden"),
 		html.Div(
 			html.Class("relative top-[60%] h-2 w-2 rotate-45 rounded-tl-sm bg-border shadow-md"),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // ListItem creates a styled list item for content
@@ -344,4 +352,46 @@ func WithDropdowns() g.Node {
 			),
 		),
 	)
-}
\ No newline at end of file
+}
+// disclosureScript toggles the content next to each data-navigation-toggle
+// trigger, closes it on Escape or an outside click and keeps one open per list
+const disclosureScript = `
+(function() {
+	if (window.shadcnNavigationMenu) return;
+	window.shadcnNavigationMenu = true;
+
+	function panelOf(trigger) {
+		return trigger.parentElement.querySelector(':scope > [data-navigation-panel]');
+	}
+	function setOpen(trigger, open) {
+		const panel = panelOf(trigger);
+		trigger.setAttribute('aria-expanded', open ? 'true' : 'false');
+		trigger.setAttribute('data-state', open ? 'open' : 'closed');
+		if (panel) {
+			panel.style.display = open ? 'block' : 'none';
+			panel.setAttribute('data-state', open ? 'open' : 'closed');
+		}
+	}
+	function openTriggers(root) {
+		return Array.from(root.querySelectorAll('[data-navigation-toggle][aria-expanded="true"]'));
+	}
+
+	document.addEventListener('click', function(e) {
+		const trigger = e.target.closest('[data-navigation-toggle]');
+		openTriggers(document).forEach(function(t) {
+			if (t !== trigger && !t.parentElement.contains(e.target)) setOpen(t, false);
+		});
+		if (trigger && !trigger.disabled) {
+			setOpen(trigger, trigger.getAttribute('aria-expanded') !== 'true');
+		}
+	});
+	document.addEventListener('keydown', function(e) {
+		if (e.key !== 'Escape') return;
+		openTriggers(document).forEach(function(t) {
+			if (!t.parentElement.contains(document.activeElement)) return;
+			setOpen(t, false);
+			t.focus();
+		});
+	});
+})();
+`
@@ -6,6 +6,7 @@ import (
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"fmt"
+	"strings"
 )
 
 // HTMXProps defines HTMX-specific properties for the NavigationMenu
@@ -68,7 +69,7 @@ func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	)
 
 	return html.Nav(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -91,9 +92,8 @@ func TriggerHTMX(props TriggerProps, itemValue string, htmxProps ItemHTMXProps,
 	attrs := []g.Node{
 		html.Type("button"),
 		html.Class(classes),
-		html.Role("menuitem"),
-		g.Attr("aria-haspopup", "menu"),
 		g.Attr("aria-expanded", "false"),
+		g.Attr("aria-controls", strings.TrimPrefix(target, "#")),
 		g.Attr("data-state", "closed"),
 		g.Attr("data-navigation-trigger", itemValue),
 		
@@ -154,7 +154,7 @@ func TriggerHTMX(props TriggerProps, itemValue string, htmxProps ItemHTMXProps,
 	</svg>`)
 
 	return html.Button(
-		append(attrs, g.Group(children), chevron)...,
+		lib.MergeAttrs(append(attrs, g.Group(children), chevron), props.Attrs)...,
 	)
 }
 
@@ -168,7 +168,7 @@ func ContentHTMX(props ContentProps, itemValue string, children ...g.Node) g.Nod
 
 	contentID := fmt.Sprintf("nav-content-%s", itemValue)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(contentID),
 		html.Class(classes),
 		g.Attr("data-state", "open"),
@@ -200,7 +200,7 @@ func ContentHTMX(props ContentProps, itemValue string, children ...g.Node) g.Nod
 		`, itemValue)),
 		
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // LinkHTMX creates an HTMX-enhanced navigation link
@@ -223,7 +223,6 @@ func LinkHTMX(props LinkProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Class(classes),
 		html.Href(props.Href),
-		html.Role("menuitem"),
 	}
 
 	if props.Active {
@@ -262,7 +261,7 @@ func LinkHTMX(props LinkProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	)
 
 	return html.A(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -280,12 +279,12 @@ func ViewportHTMX(props ViewportProps, htmxProps HTMXProps, children ...g.Node)
 		viewportID = "navigation-viewport"
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(viewportID),
 		html.Class(classes),
 		g.Attr("data-state", "open"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // ExampleHTMX creates an HTMX-enhanced navigation menu example
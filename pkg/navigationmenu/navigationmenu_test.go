@@ -6,6 +6,9 @@ import (
 
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -74,8 +77,8 @@ func TestList(t *testing.T) {
 			name:  "renders basic list",
 			props: ListProps{},
 			want: []string{
-				`role="none"`,
 				`data-orientation="horizontal"`,
+				"window.shadcnNavigationMenu",
 				`group flex flex-1 list-none items-center justify-center space-x-1`,
 			},
 		},
@@ -114,8 +117,7 @@ func TestItem(t *testing.T) {
 			name:  "renders basic item",
 			props: ItemProps{},
 			want: []string{
-				`role="none"`,
-				`class="relative"`,
+				`<li class="relative">`,
 			},
 		},
 		{
@@ -163,9 +165,8 @@ func TestTrigger(t *testing.T) {
 			props: TriggerProps{},
 			want: []string{
 				`type="button"`,
-				`role="menuitem"`,
-				`aria-haspopup="menu"`,
 				`aria-expanded="false"`,
+				`data-navigation-toggle=""`,
 				`data-state="closed"`,
 				`<svg`, // chevron icon
 			},
@@ -216,6 +217,7 @@ func TestContent(t *testing.T) {
 			props: ContentProps{},
 			want: []string{
 				`data-state="closed"`,
+				`data-navigation-panel=""`,
 				`style="display: none;"`,
 				`md:absolute md:w-auto`,
 			},
@@ -259,9 +261,9 @@ func TestLink(t *testing.T) {
 			},
 			want: []string{
 				`href="/home"`,
-				`role="menuitem"`,
 			},
 			notWant: []string{
+				`role=`,
 				`aria-current`,
 				`aria-disabled`,
 			},
@@ -536,4 +538,35 @@ func TestCompleteNavigation(t *testing.T) {
 			t.Errorf("Complete navigation = %v, want to contain %v", result, elem)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"ExampleHTMX": ExampleHTMX,
+		"Examples":    Examples,
+	})
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	a11ytest.Conformance(t, WithDropdowns(), a11y.Options{Fragment: true}, []string{
+		`<nav`,
+		`aria-expanded="false"`,
+		`data-navigation-toggle=""`,
+		`data-navigation-panel=""`,
+		"window.shadcnNavigationMenu",
+	}, []string{
+		// Site navigation is a disclosure, not an application menu
+		`role="menubar"`,
+		`role="menu"`,
+		`role="menuitem"`,
+		`aria-haspopup`,
+		`data-roving`,
+	})
+	a11ytest.Conformance(t, ExampleHTMX(), a11y.Options{Fragment: true}, []string{
+		`aria-controls="nav-content-components"`,
+		`aria-expanded="false"`,
+	}, []string{
+		`role="menuitem"`,
+		`data-roving`,
+	})
+}
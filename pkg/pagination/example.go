@@ -4,6 +4,8 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // Examples demonstrates various Pagination usage patterns
@@ -268,4 +270,50 @@ func Examples() g.Node {
 			),
 		),
 	)
-}
\ No newline at end of file
+}
+
+// ExampleResults demonstrates a Model read from r: a paged list of invoices
+// whose links keep the search query and load each page with HTMX
+func ExampleResults(r *http.Request) g.Node {
+	query := strings.ToLower(r.URL.Query().Get("q"))
+	var invoices []string
+	for i := 1; i <= 240; i++ {
+		invoice := fmt.Sprintf("INV-%04d", i)
+		if strings.Contains(strings.ToLower(invoice), query) {
+			invoices = append(invoices, invoice)
+		}
+	}
+
+	m := FromRequest(r, Options{DefaultPerPage: 10, Siblings: 2})
+	m = m.WithTotal(len(invoices))
+	page := invoices[min(m.Offset(), len(invoices)):min(m.Offset()+m.Limit(), len(invoices))]
+
+	return html.Div(
+		html.Class("space-y-2 p-8"),
+		html.H3(html.Class("text-lg font-semibold"), g.Text("Query-String Pagination")),
+		html.Div(
+			html.ID("pagination-results"),
+			html.Class("space-y-4 rounded-lg border p-4"),
+			html.Form(
+				html.Method("get"),
+				html.Action(r.URL.Path),
+				html.Label(html.For("pagination-search"), html.Class("sr-only"), g.Text("Search invoices")),
+				html.Input(
+					html.ID("pagination-search"),
+					html.Type("search"),
+					html.Name("q"),
+					html.Value(query),
+					html.Placeholder("Search invoices..."),
+					html.Class("flex h-9 w-full max-w-xs rounded-md border border-input bg-transparent px-3 py-1 text-sm"),
+				),
+			),
+			html.Ul(
+				html.Class("grid grid-cols-2 gap-2 text-sm sm:grid-cols-5"),
+				g.Group(g.Map(page, func(invoice string) g.Node {
+					return html.Li(html.Class("rounded-md bg-muted px-2 py-1 font-mono"), g.Text(invoice))
+				})),
+			),
+			NewHTMX(m, HTMXProps{Target: "#pagination-results", Swap: "outerHTML", Select: "#pagination-results"}),
+		),
+	)
+}
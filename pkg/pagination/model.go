@@ -0,0 +1,357 @@
+package pagination
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/rizome-dev/shadcn-gomponents/pkg/selector"
+	g "maragu.dev/gomponents"
+	hx "maragu.dev/gomponents-htmx"
+	html "maragu.dev/gomponents/html"
+)
+
+// Options configures how a Model is read from a request and rendered
+type Options struct {
+	PageParam      string // Query parameter of the page number (default: "page")
+	PerPageParam   string // Query parameter of the page size (default: "per_page")
+	CursorParam    string // Query parameter of the cursor (default: "cursor")
+	DefaultPerPage int    // Page size when the request has none (default: 20)
+	PerPageOptions []int  // Page sizes offered by the selector; other sizes are ignored (default: 10, 20, 50, 100)
+	Siblings       int    // Pages shown on each side of the current page (default: 1)
+	Boundaries     int    // Pages always shown at the start and end (default: 1)
+	CursorMode     bool   // Page with opaque cursors and previous/next links instead of page numbers
+}
+
+// Model is the pagination state of a request, created with FromRequest. It
+// builds page links that keep the request's other query parameters, so
+// filters and sorting survive paging.
+type Model struct {
+	Options
+	Page       int    // Current page (1-based)
+	PerPage    int    // Items per page
+	Total      int    // Total number of items; set with WithTotal
+	Cursor     string // Cursor of the current page ("" on the first page)
+	NextCursor string // Cursor of the next page ("" on the last page)
+	PrevCursor string // Cursor of the previous page ("" links to the first page)
+
+	path  string
+	query url.Values
+}
+
+// FromRequest reads the page, page size and cursor of r
+func FromRequest(r *http.Request, opts Options) Model {
+	opts = opts.withDefaults()
+	query := r.URL.Query()
+
+	m := Model{
+		Options: opts,
+		Page:    1,
+		PerPage: opts.DefaultPerPage,
+		Cursor:  query.Get(opts.CursorParam),
+		path:    r.URL.Path,
+		query:   query,
+	}
+	if page, err := strconv.Atoi(query.Get(opts.PageParam)); err == nil && page > 1 {
+		m.Page = page
+	}
+	if perPage, err := strconv.Atoi(query.Get(opts.PerPageParam)); err == nil && slices.Contains(opts.PerPageOptions, perPage) {
+		m.PerPage = perPage
+	}
+	return m
+}
+
+// withDefaults fills in the default options
+func (o Options) withDefaults() Options {
+	if o.PageParam == "" {
+		o.PageParam = "page"
+	}
+	if o.PerPageParam == "" {
+		o.PerPageParam = "per_page"
+	}
+	if o.CursorParam == "" {
+		o.CursorParam = "cursor"
+	}
+	if o.DefaultPerPage == 0 {
+		o.DefaultPerPage = 20
+	}
+	if len(o.PerPageOptions) == 0 {
+		o.PerPageOptions = []int{10, 20, 50, 100}
+	}
+	if !slices.Contains(o.PerPageOptions, o.DefaultPerPage) {
+		o.PerPageOptions = append(slices.Clone(o.PerPageOptions), o.DefaultPerPage)
+		slices.Sort(o.PerPageOptions)
+	}
+	if o.Siblings == 0 {
+		o.Siblings = 1
+	}
+	if o.Boundaries == 0 {
+		o.Boundaries = 1
+	}
+	return o
+}
+
+// WithTotal sets the total number of items, moving past-the-end requests
+// to the last page
+func (m Model) WithTotal(total int) Model {
+	m.Total = total
+	if pages := m.TotalPages(); m.Page > pages {
+		m.Page = pages
+	}
+	return m
+}
+
+// TotalPages returns the number of pages, at least 1
+func (m Model) TotalPages() int {
+	if m.Total <= 0 || m.PerPage <= 0 {
+		return 1
+	}
+	return (m.Total + m.PerPage - 1) / m.PerPage
+}
+
+// Offset returns the number of items before the current page
+func (m Model) Offset() int {
+	return (m.Page - 1) * m.PerPage
+}
+
+// Limit returns the number of items on a page
+func (m Model) Limit() int {
+	return m.PerPage
+}
+
+// URL returns the link to page, keeping the other query parameters
+func (m Model) URL(page int) string {
+	return m.link(func(query url.Values) {
+		query.Del(m.CursorParam)
+		if page > 1 {
+			query.Set(m.PageParam, strconv.Itoa(page))
+		} else {
+			query.Del(m.PageParam)
+		}
+	})
+}
+
+// CursorURL returns the link to the page at cursor, keeping the other query
+// parameters. An empty cursor links to the first page.
+func (m Model) CursorURL(cursor string) string {
+	return m.link(func(query url.Values) {
+		query.Del(m.PageParam)
+		if cursor != "" {
+			query.Set(m.CursorParam, cursor)
+		} else {
+			query.Del(m.CursorParam)
+		}
+	})
+}
+
+// PerPageURL returns the link to the first page with perPage items per page
+func (m Model) PerPageURL(perPage int) string {
+	return m.link(func(query url.Values) {
+		query.Del(m.PageParam)
+		query.Del(m.CursorParam)
+		query.Set(m.PerPageParam, strconv.Itoa(perPage))
+	})
+}
+
+// link returns the request path with the query changed by update
+func (m Model) link(update func(url.Values)) string {
+	query := url.Values{}
+	for key, values := range m.query {
+		query[key] = slices.Clone(values)
+	}
+	update(query)
+	if len(query) == 0 {
+		return m.path
+	}
+	return m.path + "?" + query.Encode()
+}
+
+// Window returns the page numbers to link to, with -1 in place of each gap.
+// The boundary pages and the siblings of the current page are always shown,
+// and the window keeps the same length on every page so the links don't
+// shift under the pointer.
+func (m Model) Window() []int {
+	return pageWindow(m.Page, m.TotalPages(), m.Siblings, m.Boundaries)
+}
+
+// pageWindow returns the pages around current, with -1 for each ellipsis
+func pageWindow(current, total, siblings, boundaries int) []int {
+	// Boundaries, siblings, the current page and two ellipses
+	if total <= 2*boundaries+2*siblings+3 {
+		pages := make([]int, total)
+		for i := range pages {
+			pages[i] = i + 1
+		}
+		return pages
+	}
+
+	start := max(min(current-siblings, total-boundaries-2*siblings-1), boundaries+2)
+	end := min(max(current+siblings, boundaries+2*siblings+2), total-boundaries-1)
+
+	var pages []int
+	for page := 1; page <= boundaries; page++ {
+		pages = append(pages, page)
+	}
+	if start > boundaries+2 {
+		pages = append(pages, -1)
+	} else {
+		pages = append(pages, boundaries+1)
+	}
+	for page := start; page <= end; page++ {
+		pages = append(pages, page)
+	}
+	if end < total-boundaries-1 {
+		pages = append(pages, -1)
+	} else {
+		pages = append(pages, total-boundaries)
+	}
+	for page := total - boundaries + 1; page <= total; page++ {
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// Nav renders the page links of m: numbered pages between previous and
+// next, or just previous and next in cursor mode
+func Nav(m Model) g.Node {
+	if m.CursorMode {
+		return New(Props{},
+			ContentComponent(ContentProps{},
+				PreviousButton(m.CursorURL(m.PrevCursor), m.Cursor == ""),
+				NextButton(m.CursorURL(m.NextCursor), m.NextCursor == ""),
+			),
+		)
+	}
+
+	total := m.TotalPages()
+	items := []g.Node{PreviousButton(m.URL(m.Page-1), m.Page <= 1)}
+	for _, page := range m.Window() {
+		if page == -1 {
+			items = append(items, Ellipsis())
+		} else {
+			items = append(items, PageButton(page, m.URL(page), page == m.Page))
+		}
+	}
+	items = append(items, NextButton(m.URL(m.Page+1), m.Page >= total))
+
+	return New(Props{CurrentPage: m.Page, TotalPages: total},
+		ContentComponent(ContentProps{}, g.Group(items)),
+	)
+}
+
+// Summary renders "Showing X–Y of Z" for the current page. It renders
+// nothing in cursor mode, where the position of the page is unknown.
+func Summary(m Model) g.Node {
+	if m.CursorMode {
+		return nil
+	}
+	text := "No results"
+	if m.Total > 0 {
+		first := m.Offset() + 1
+		last := min(m.Offset()+m.PerPage, m.Total)
+		text = fmt.Sprintf("Showing %d–%d of %d", first, last, m.Total)
+	}
+	return html.P(
+		html.Class("text-sm text-muted-foreground"),
+		g.Attr("aria-live", "polite"),
+		g.Text(text),
+	)
+}
+
+// PageSizeSelector renders a form that reloads the first page with the
+// chosen page size. The other query parameters are kept as hidden inputs.
+func PageSizeSelector(m Model) g.Node {
+	id := "pagination-" + m.PerPageParam
+	options := make([]selector.OptionType, 0, len(m.PerPageOptions))
+	for _, n := range m.PerPageOptions {
+		options = append(options, selector.OptionType{Value: strconv.Itoa(n), Label: strconv.Itoa(n)})
+	}
+	var hidden []g.Node
+	for _, key := range sortedKeys(m.query) {
+		if key == m.PageParam || key == m.PerPageParam || key == m.CursorParam {
+			continue
+		}
+		for _, value := range m.query[key] {
+			hidden = append(hidden, html.Input(html.Type("hidden"), html.Name(key), html.Value(value)))
+		}
+	}
+
+	return html.Form(
+		html.Class("flex items-center gap-2"),
+		html.Method("get"),
+		html.Action(m.path),
+		g.Group(hidden),
+		html.Label(
+			html.For(id),
+			html.Class("text-sm font-medium whitespace-nowrap"),
+			g.Text("Rows per page"),
+		),
+		selector.New(selector.Props{
+			ID:       id,
+			Name:     m.PerPageParam,
+			Value:    strconv.Itoa(m.PerPage),
+			Size:     "sm",
+			Class:    "w-[4.5rem]",
+			Options:  options,
+			OnChange: "this.form.requestSubmit()",
+		}),
+		html.NoScript(
+			html.Button(
+				html.Type("submit"),
+				html.Class("text-sm underline underline-offset-4"),
+				g.Text("Apply"),
+			),
+		),
+	)
+}
+
+// Controls renders the summary, page-size selector and page links of m
+func Controls(m Model, attrs ...g.Node) g.Node {
+	return html.Div(
+		html.Class("flex flex-wrap items-center justify-between gap-4"),
+		g.Group(attrs),
+		Summary(m),
+		html.Div(
+			html.Class("flex flex-wrap items-center gap-4"),
+			PageSizeSelector(m),
+			html.Div(Nav(m)),
+		),
+	)
+}
+
+// HTMXProps defines how HTMX pagination loads pages
+type HTMXProps struct {
+	Target string // CSS selector of the results container to swap
+	Swap   string // How the response is swapped into Target (default: "innerHTML")
+	Select string // CSS selector picking the swapped content out of the response (optional)
+}
+
+// NewHTMX renders the controls of m with the page links and page-size form
+// boosted by HTMX: each page is fetched into the Target container and its
+// URL pushed to the history, so the back button and reloads keep working.
+// The links still work as plain links without JavaScript.
+func NewHTMX(m Model, htmxProps HTMXProps) g.Node {
+	if htmxProps.Swap == "" {
+		htmxProps.Swap = "innerHTML"
+	}
+	return Controls(m,
+		hx.Boost("true"),
+		hx.Target(htmxProps.Target),
+		hx.Swap(htmxProps.Swap),
+		g.If(htmxProps.Select != "", hx.Select(htmxProps.Select)),
+		hx.PushURL("true"),
+	)
+}
+
+// sortedKeys returns the keys of query in order, so hidden inputs render
+// deterministically
+func sortedKeys(query url.Values) []string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
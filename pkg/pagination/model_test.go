@@ -0,0 +1,141 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		page    int
+		perPage int
+		cursor  string
+	}{
+		{"defaults", "/users", 1, 20, ""},
+		{"page and size", "/users?page=3&per_page=50", 3, 50, ""},
+		{"invalid values", "/users?page=-2&per_page=7", 1, 20, ""},
+		{"cursor", "/users?cursor=abc", 1, 20, "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := FromRequest(httptest.NewRequest("GET", tt.target, nil), Options{})
+			if m.Page != tt.page || m.PerPage != tt.perPage || m.Cursor != tt.cursor {
+				t.Errorf("got page %d, per page %d, cursor %q", m.Page, m.PerPage, m.Cursor)
+			}
+		})
+	}
+
+	m := FromRequest(httptest.NewRequest("GET", "/users?page=9&per_page=10", nil), Options{}).WithTotal(45)
+	if m.Page != 5 || m.TotalPages() != 5 || m.Offset() != 40 {
+		t.Errorf("expected past-the-end page to clamp to 5, got page %d of %d at offset %d", m.Page, m.TotalPages(), m.Offset())
+	}
+}
+
+func TestModelURLs(t *testing.T) {
+	m := FromRequest(httptest.NewRequest("GET", "/users?q=ann&sort=name&sort=-age&page=2&cursor=x", nil), Options{})
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"page", m.URL(3), "/users?page=3&q=ann&sort=name&sort=-age"},
+		{"first page", m.URL(1), "/users?q=ann&sort=name&sort=-age"},
+		{"cursor", m.CursorURL("next"), "/users?cursor=next&q=ann&sort=name&sort=-age"},
+		{"page size", m.PerPageURL(50), "/users?per_page=50&q=ann&sort=name&sort=-age"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, tt.got)
+			}
+		})
+	}
+}
+
+func TestPageWindow(t *testing.T) {
+	tests := []struct {
+		name                               string
+		current, total, siblings, boundary int
+		want                               []int
+	}{
+		{"all pages fit", 2, 5, 1, 1, []int{1, 2, 3, 4, 5}},
+		{"start", 1, 20, 1, 1, []int{1, 2, 3, 4, 5, -1, 20}},
+		{"middle", 10, 20, 1, 1, []int{1, -1, 9, 10, 11, -1, 20}},
+		{"end", 20, 20, 1, 1, []int{1, -1, 16, 17, 18, 19, 20}},
+		{"more siblings", 10, 20, 2, 1, []int{1, -1, 8, 9, 10, 11, 12, -1, 20}},
+		{"more boundaries", 10, 20, 1, 2, []int{1, 2, -1, 9, 10, 11, -1, 19, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pageWindow(tt.current, tt.total, tt.siblings, tt.boundary); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestControls(t *testing.T) {
+	m := FromRequest(httptest.NewRequest("GET", "/users?q=ann&page=2&per_page=10", nil), Options{}).WithTotal(95)
+	result := renderToString(Controls(m))
+
+	want := []string{
+		`Showing 11–20 of 95`,
+		`<form class="flex items-center gap-2" method="get" action="/users"><input type="hidden" name="q" value="ann">`,
+		`<label for="pagination-per_page"`,
+		`name="per_page"`,
+		`<option value="10" selected>10</option>`,
+		`onchange="this.form.requestSubmit()"`,
+		`href="/users?page=3&amp;per_page=10&amp;q=ann"`,
+		`aria-current="page"`,
+	}
+	for _, w := range want {
+		if !strings.Contains(result, w) {
+			t.Errorf("expected output to contain %q, got %s", w, result)
+		}
+	}
+	if strings.Contains(result, `name="page"`) {
+		t.Error("expected the page size form to drop the page parameter")
+	}
+}
+
+func TestCursorNav(t *testing.T) {
+	m := FromRequest(httptest.NewRequest("GET", "/events?type=login", nil), Options{CursorMode: true})
+	m.NextCursor = "c2"
+	result := renderToString(Controls(m))
+
+	if !strings.Contains(result, `href="/events?cursor=c2&amp;type=login"`) {
+		t.Errorf("expected a next link to the cursor, got %s", result)
+	}
+	if !strings.Contains(result, `aria-disabled="true"`) {
+		t.Error("expected the previous link to be disabled on the first page")
+	}
+	if strings.Contains(result, "Showing") {
+		t.Error("expected no summary in cursor mode")
+	}
+}
+
+func TestNewHTMX(t *testing.T) {
+	m := FromRequest(httptest.NewRequest("GET", "/users", nil), Options{}).WithTotal(100)
+	result := renderToString(NewHTMX(m, HTMXProps{Target: "#results", Select: "#results"}))
+
+	for _, w := range []string{
+		`hx-boost="true"`,
+		`hx-target="#results"`,
+		`hx-swap="innerHTML"`,
+		`hx-select="#results"`,
+		`hx-push-url="true"`,
+		`href="/users?page=2"`,
+	} {
+		if !strings.Contains(result, w) {
+			t.Errorf("expected output to contain %q", w)
+		}
+	}
+}
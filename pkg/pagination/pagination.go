@@ -10,39 +10,44 @@ import (
 
 // Props defines the properties for the Pagination component
 type Props struct {
-	CurrentPage  int    // Current active page (1-based)
-	TotalPages   int    // Total number of pages
-	ShowFirst    bool   // Show first page button
-	ShowLast     bool   // Show last page button
-	ShowPrevNext bool   // Show previous/next buttons (default: true)
-	MaxVisible   int    // Maximum number of page buttons to show (default: 7)
-	Class        string // Additional custom classes
+	CurrentPage  int      // Current active page (1-based)
+	TotalPages   int      // Total number of pages
+	ShowFirst    bool     // Show first page button
+	ShowLast     bool     // Show last page button
+	ShowPrevNext bool     // Show previous/next buttons (default: true)
+	MaxVisible   int      // Maximum number of page buttons to show (default: 7)
+	Class        string   // Additional custom classes
+	Attrs        []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for pagination content container
 type ContentProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ItemProps defines properties for pagination items
 type ItemProps struct {
 	Class    string
-	Active   bool // Whether this is the current page
-	Disabled bool // Whether this item is disabled
+	Active   bool     // Whether this is the current page
+	Disabled bool     // Whether this item is disabled
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // LinkProps defines properties for pagination links
 type LinkProps struct {
-	Href     string // URL for the page
-	Page     int    // Page number
-	Active   bool   // Whether this is the current page
-	Disabled bool   // Whether this link is disabled
-	Class    string // Additional custom classes
+	Href     string   // URL for the page
+	Page     int      // Page number
+	Active   bool     // Whether this is the current page
+	Disabled bool     // Whether this link is disabled
+	Class    string   // Additional custom classes
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // EllipsisProps defines properties for ellipsis indicators
 type EllipsisProps struct {
 	Class string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Pagination component
@@ -60,12 +65,12 @@ func New(props Props, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Nav(
+	return html.Nav(lib.MergeAttrs([]g.Node{
 		html.Role("navigation"),
 		g.Attr("aria-label", "pagination"),
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Content creates the pagination content container
@@ -75,10 +80,10 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Ul(
+	return html.Ul(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Item creates a pagination item
@@ -96,7 +101,7 @@ func Item(props ItemProps, children ...g.Node) g.Node {
 	}
 
 	return html.Li(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -142,7 +147,7 @@ func LinkComponent(props LinkProps, children ...g.Node) g.Node {
 	}
 
 	return html.A(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -230,7 +235,7 @@ func Ellipsis(props ...EllipsisProps) g.Node {
 		p.Class,
 	)
 
-	return html.Li(
+	return html.Li(lib.MergeAttrs([]g.Node{
 		g.Attr("aria-hidden", "true"),
 		html.Span(
 			html.Class(classes),
@@ -239,7 +244,7 @@ func Ellipsis(props ...EllipsisProps) g.Node {
 			</svg>`),
 			html.Span(html.Class("sr-only"), g.Text("More pages")),
 		),
-	)
+	}, p.Attrs)...)
 }
 
 // PageButton creates a numbered page button
@@ -0,0 +1,24 @@
+package popover
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Popover",
+		Category:    "Navigation",
+		Description: "Popover component",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+			{Name: "With Menu", Func: "ExampleWithMenu", Render: ExampleWithMenu},
+			{Name: "As Child", Func: "ExampleAsChild", Render: ExampleAsChild},
+		},
+	})
+}
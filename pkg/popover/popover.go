@@ -4,31 +4,36 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 )
 
 // Props defines the properties for the Popover component
 type Props struct {
-	Open      bool   // Whether the popover is open
-	Side      string // "top" | "right" | "bottom" | "left"
-	Align     string // "start" | "center" | "end"
-	Class     string // Additional custom classes
-	SideOffset int   // Offset from the side
-	AlignOffset int  // Offset from the alignment
+	Open        bool     // Whether the popover is open
+	Side        string   // "top" | "right" | "bottom" | "left"
+	Align       string   // "start" | "center" | "end"
+	Class       string   // Additional custom classes
+	SideOffset  int      // Offset from the side
+	AlignOffset int      // Offset from the alignment
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for the Popover trigger
 type TriggerProps struct {
-	AsChild bool   // Whether to render as child element
-	Class   string // Additional custom classes
+	AsChild bool     // Whether to render as child element
+	Class   string   // Additional custom classes
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for the Popover content
 type ContentProps struct {
-	Side        string // "top" | "right" | "bottom" | "left"
-	Align       string // "start" | "center" | "end"
-	SideOffset  int    // Offset from the side
-	AlignOffset int    // Offset from the alignment
-	Class       string // Additional custom classes
+	Side        string             // "top" | "right" | "bottom" | "left"
+	Align       string             // "start" | "center" | "end"
+	SideOffset  int                // Offset from the side
+	AlignOffset int                // Offset from the alignment
+	Class       string             // Additional custom classes
+	Collision   floating.Collision // Viewport collision handling
+	Attrs       []g.Node           // Additional attributes to pass through
 }
 
 // New creates a new Popover container
@@ -49,13 +54,13 @@ func New(props Props, children ...g.Node) g.Node {
 		}
 	}
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.If(props.Open, g.Attr("data-state", "open")),
 		g.If(!props.Open, g.Attr("data-state", "closed")),
 		trigger,
 		g.If(props.Open, content),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a Popover trigger element
@@ -67,13 +72,13 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 		return children[0]
 	}
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		g.Attr("aria-haspopup", "dialog"),
 		g.Attr("aria-expanded", "false"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Content creates the Popover content
@@ -101,13 +106,23 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Role("dialog"),
-		g.If(props.Side != "", g.Attr("data-side", props.Side)),
-		g.If(props.Align != "", g.Attr("data-align", props.Align)),
+		floating.Attrs(props.floatingProps()),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
+}
+
+// floatingProps returns the placement handed to the positioning script
+func (props ContentProps) floatingProps() floating.Props {
+	return floating.Props{
+		Side:        props.Side,
+		Align:       props.Align,
+		SideOffset:  props.SideOffset,
+		AlignOffset: props.AlignOffset,
+		Collision:   props.Collision,
+	}
 }
 
 // getPositionClasses returns position classes based on side and align
@@ -154,20 +169,22 @@ func getPositionClasses(side, align string) string {
 	return lib.CN(classes...)
 }
 
-// getAnimationClasses returns animation classes based on side
+// getAnimationClasses returns animation classes for side and for the opposite
+// side the content flips to when it would overflow the viewport
 func getAnimationClasses(side string) string {
-	switch side {
-	case "top":
-		return "data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[state=closed]:slide-out-to-bottom-2 data-[state=open]:slide-in-from-bottom-2"
-	case "right":
-		return "data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[state=closed]:slide-out-to-left-2 data-[state=open]:slide-in-from-left-2"
-	case "bottom":
-		return "data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[state=closed]:slide-out-to-top-2 data-[state=open]:slide-in-from-top-2"
-	case "left":
-		return "data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[state=closed]:slide-out-to-right-2 data-[state=open]:slide-in-from-right-2"
-	default:
-		return "data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95"
+	slides := map[string]string{
+		"top":    "data-[side=top]:slide-out-to-bottom-2 data-[side=top]:slide-in-from-bottom-2",
+		"right":  "data-[side=right]:slide-out-to-left-2 data-[side=right]:slide-in-from-left-2",
+		"bottom": "data-[side=bottom]:slide-out-to-top-2 data-[side=bottom]:slide-in-from-top-2",
+		"left":   "data-[side=left]:slide-out-to-right-2 data-[side=left]:slide-in-from-right-2",
+	}
+	opposite := map[string]string{"top": "bottom", "right": "left", "bottom": "top", "left": "right"}
+
+	base := "data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95"
+	if _, ok := slides[side]; !ok {
+		return base
 	}
+	return lib.CN(base, slides[side], slides[opposite[side]])
 }
 
 // WithArrow creates a popover content with an arrow
@@ -180,6 +197,7 @@ func WithArrow(props ContentProps, children ...g.Node) g.Node {
 			// Arrow element
 			html.Div(
 				html.Class(arrowClasses),
+				floating.Arrow(),
 			),
 		}, children...)...,
 	)
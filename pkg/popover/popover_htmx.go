@@ -9,7 +9,9 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the Popover
@@ -24,13 +26,13 @@ type HTMXProps struct {
 func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN("relative inline-block", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID + "-container"),
 		html.Class(classes),
 		g.If(props.Open, g.Attr("data-state", "open")),
 		g.If(!props.Open, g.Attr("data-state", "closed")),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger
@@ -52,7 +54,7 @@ func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, children ...g.Node) g.
 		return children[0]
 	}
 	
-	return html.Button(append(triggerAttrs, children...)...)
+	return html.Button(lib.MergeAttrs(append(triggerAttrs, children...), props.Attrs)...)
 }
 
 // ContentHTMX creates HTMX-enhanced popover content
@@ -77,13 +79,12 @@ func ContentHTMX(props ContentProps, htmxProps HTMXProps, children ...g.Node) g.
 	)
 	
 	// Add click outside handler
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(htmxProps.ID),
 		html.Class(classes),
 		html.Role("dialog"),
 		g.Attr("data-state", "open"),
-		g.If(props.Side != "", g.Attr("data-side", props.Side)),
-		g.If(props.Align != "", g.Attr("data-align", props.Align)),
+		floating.Attrs(props.floatingProps()),
 		// Click outside to close
 		hx.On("click", fmt.Sprintf(`
 			if (event.target.id === '%s' || event.target.closest('#%s')) {
@@ -92,7 +93,7 @@ func ContentHTMX(props ContentProps, htmxProps HTMXProps, children ...g.Node) g.
 			htmx.ajax('GET', '%s', {target: '#%s-container', swap: 'outerHTML'});
 		`, htmxProps.ID, htmxProps.ID, htmxProps.ClosePath, htmxProps.ID)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // CloseHTMX creates an HTMX-enhanced close button
@@ -237,16 +238,19 @@ func ProfilePopoverHTMX(htmxProps HTMXProps, userName, userEmail string, avatarU
 	)
 }
 
-// PopoverHandlers creates HTTP handlers for popover components
-func PopoverHandlers(mux *http.ServeMux) {
+// Handlers serves the popover demo endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Demo popover handlers
 	demoProps := HTMXProps{
 		ID:         "demo-popover-htmx",
-		TogglePath: "/htmx/popover/demo/toggle",
-		ClosePath:  "/htmx/popover/demo/close",
+		TogglePath: rt.Path("/htmx/popover/demo/toggle"),
+		ClosePath:  rt.Path("/htmx/popover/demo/close"),
 	}
 	
-	mux.HandleFunc("/htmx/popover/demo/toggle", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/htmx/popover/demo/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		trigger := TriggerHTMX(
 			TriggerProps{Class: "bg-primary text-primary-foreground hover:bg-primary/90 px-4 py-2 rounded-md"},
 			demoProps,
@@ -263,7 +267,7 @@ func PopoverHandlers(mux *http.ServeMux) {
 					html.Type("button"),
 					html.Class("text-sm bg-primary text-primary-foreground hover:bg-primary/90 px-3 py-1 rounded"),
 					g.Text("Action"),
-					hx.Post("/htmx/popover/demo/action"),
+					hx.Post(rt.Path("/htmx/popover/demo/action")),
 					hx.Target("#demo-popover-htmx-container"),
 					hx.Swap("outerHTML"),
 				),
@@ -286,9 +290,9 @@ func PopoverHandlers(mux *http.ServeMux) {
 			content,
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/htmx/popover/demo/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/htmx/popover/demo/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		trigger := TriggerHTMX(
 			TriggerProps{Class: "bg-primary text-primary-foreground hover:bg-primary/90 px-4 py-2 rounded-md"},
 			demoProps,
@@ -297,9 +301,9 @@ func PopoverHandlers(mux *http.ServeMux) {
 		
 		node := RenderClosedPopover(Props{}, demoProps, trigger)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/htmx/popover/demo/action", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/htmx/popover/demo/action", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Return a success message
 		node := html.Div(
 			html.ID("demo-popover-htmx-container"),
@@ -309,20 +313,20 @@ func PopoverHandlers(mux *http.ServeMux) {
 				g.Text("✓ Action completed!"),
 				// Auto-hide after 2 seconds
 				g.Attr("x-data", "{}"),
-				g.Attr("x-init", "setTimeout(() => { htmx.ajax('GET', '/htmx/popover/demo/close', {target: '#demo-popover-htmx-container', swap: 'outerHTML'}); }, 2000)"),
+				g.Attr("x-init", "setTimeout(() => { htmx.ajax('GET', '"+demoProps.ClosePath+"', {target: '#demo-popover-htmx-container', swap: 'outerHTML'}); }, 2000)"),
 			),
 		)
 		node.Render(w)
-	})
+	}))
 	
 	// Basic popover example
 	basicProps := HTMXProps{
 		ID:         "popover-basic",
-		TogglePath: "/api/popover/basic/toggle",
-		ClosePath:  "/api/popover/basic/close",
+		TogglePath: rt.Path("/api/popover/basic/toggle"),
+		ClosePath:  rt.Path("/api/popover/basic/close"),
 	}
 	
-	mux.HandleFunc("/api/popover/basic/toggle", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/popover/basic/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Toggle popover state (in real app, track state server-side)
 		trigger := TriggerHTMX(
 			TriggerProps{},
@@ -345,9 +349,9 @@ func PopoverHandlers(mux *http.ServeMux) {
 			content,
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/popover/basic/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/popover/basic/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		trigger := TriggerHTMX(
 			TriggerProps{},
 			basicProps,
@@ -356,16 +360,16 @@ func PopoverHandlers(mux *http.ServeMux) {
 		
 		node := RenderClosedPopover(Props{}, basicProps, trigger)
 		node.Render(w)
-	})
+	}))
 	
 	// Menu popover example
 	menuProps := HTMXProps{
 		ID:         "popover-menu",
-		TogglePath: "/api/popover/menu/toggle",
-		ClosePath:  "/api/popover/menu/close",
+		TogglePath: rt.Path("/api/popover/menu/toggle"),
+		ClosePath:  rt.Path("/api/popover/menu/close"),
 	}
 	
-	mux.HandleFunc("/api/popover/menu/toggle", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/popover/menu/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		trigger := TriggerHTMX(
 			TriggerProps{Class: "inline-flex items-center gap-2"},
 			menuProps,
@@ -379,17 +383,17 @@ func PopoverHandlers(mux *http.ServeMux) {
 			menuProps,
 			trigger,
 			MenuPopoverHTMX(menuProps, []MenuItem{
-				{Label: "Edit", Icon: icons.Edit(html.Class("mr-2 h-4 w-4")), Action: "/api/item/edit"},
-				{Label: "Duplicate", Icon: icons.Copy(html.Class("mr-2 h-4 w-4")), Action: "/api/item/duplicate"},
+				{Label: "Edit", Icon: icons.Edit(html.Class("mr-2 h-4 w-4")), Action: rt.Path("/api/item/edit")},
+				{Label: "Duplicate", Icon: icons.Copy(html.Class("mr-2 h-4 w-4")), Action: rt.Path("/api/item/duplicate")},
 				{Separator: true},
-				{Label: "Archive", Icon: icons.Archive(html.Class("mr-2 h-4 w-4")), Action: "/api/item/archive"},
-				{Label: "Delete", Icon: icons.Trash(html.Class("mr-2 h-4 w-4")), Action: "/api/item/delete"},
+				{Label: "Archive", Icon: icons.Archive(html.Class("mr-2 h-4 w-4")), Action: rt.Path("/api/item/archive")},
+				{Label: "Delete", Icon: icons.Trash(html.Class("mr-2 h-4 w-4")), Action: rt.Path("/api/item/delete")},
 			}),
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/popover/menu/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/popover/menu/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		trigger := TriggerHTMX(
 			TriggerProps{Class: "inline-flex items-center gap-2"},
 			menuProps,
@@ -399,11 +403,11 @@ func PopoverHandlers(mux *http.ServeMux) {
 		
 		node := RenderClosedPopover(Props{}, menuProps, trigger)
 		node.Render(w)
-	})
+	}))
 	
 	// Handle menu actions
-	mux.HandleFunc("/api/item/", func(w http.ResponseWriter, r *http.Request) {
-		action := strings.TrimPrefix(r.URL.Path, "/api/item/")
+	rt.Handle(http.MethodPost, "/api/item/{action}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.PathValue("action")
 		
 		// Return a success message
 		trigger := TriggerHTMX(
@@ -427,17 +431,17 @@ func PopoverHandlers(mux *http.ServeMux) {
 			),
 		)
 		node.Render(w)
-	})
+	}))
 	
 	// Dynamic content popover
 	dynamicProps := HTMXProps{
 		ID:         "popover-dynamic",
-		TogglePath: "/api/popover/dynamic/toggle",
-		ClosePath:  "/api/popover/dynamic/close",
-		LoadPath:   "/api/popover/dynamic/content",
+		TogglePath: rt.Path("/api/popover/dynamic/toggle"),
+		ClosePath:  rt.Path("/api/popover/dynamic/close"),
+		LoadPath:   rt.Path("/api/popover/dynamic/content"),
 	}
 	
-	mux.HandleFunc("/api/popover/dynamic/toggle", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/popover/dynamic/toggle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		trigger := TriggerHTMX(
 			TriggerProps{},
 			dynamicProps,
@@ -452,9 +456,9 @@ func PopoverHandlers(mux *http.ServeMux) {
 			DynamicContentHTMX(ContentProps{}, dynamicProps),
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/popover/dynamic/content", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/popover/dynamic/content", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Simulate loading delay
 		// time.Sleep(500 * time.Millisecond)
 		
@@ -475,5 +479,12 @@ func PopoverHandlers(mux *http.ServeMux) {
 			),
 		)
 		node.Render(w)
-	})
+	}))
+}
+
+// PopoverHandlers creates HTTP handlers for popover components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func PopoverHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
\ No newline at end of file
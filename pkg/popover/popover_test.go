@@ -7,6 +7,7 @@ import (
 	
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -359,4 +360,12 @@ func TestClose(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":         Example,
+		"ExampleAsChild":  ExampleAsChild,
+		"ExampleWithMenu": ExampleWithMenu,
+	})
+}
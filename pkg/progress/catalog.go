@@ -0,0 +1,38 @@
+package progress
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Progress",
+		Category:    "Data Display",
+		Description: "Progress indicators",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Value", Type: catalog.Int, Default: "60", Description: "Progress from 0 to Max"},
+			{Name: "Max", Type: catalog.Int, Default: "100"},
+			{Name: "Size", Type: catalog.Enum, Default: "default", Options: []string{"sm", "default", "lg"}},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return New(Props{
+				Value: v.Int("Value"),
+				Max:   v.Int("Max"),
+				Size:  v.String("Size"),
+				Class: "w-2/3",
+				Attrs: []g.Node{html.Aria("label", "Playground progress")},
+			})
+		},
+	})
+}
@@ -9,10 +9,11 @@ import (
 
 // Props defines the properties for the Progress component
 type Props struct {
-	Value int    // Progress value (0-100)
-	Max   int    // Maximum value (default: 100)
-	Size  string // "sm" | "default" | "lg"
-	Class string // Additional custom classes
+	Value int      // Progress value (0-100)
+	Max   int      // Maximum value (default: 100)
+	Size  string   // "sm" | "default" | "lg"
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Progress component
@@ -52,7 +53,7 @@ func New(props Props) g.Node {
 	// Indicator classes
 	indicatorClasses := "h-full bg-primary transition-all"
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(rootClasses),
 		html.Role("progressbar"),
 		g.Attr("aria-valuemin", "0"),
@@ -65,7 +66,7 @@ func New(props Props) g.Node {
 			html.Class(indicatorClasses),
 			html.Style(fmt.Sprintf("width: %d%%", percentage)),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // Default creates a progress bar with default settings
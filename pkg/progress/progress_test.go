@@ -6,6 +6,7 @@ import (
 	"testing"
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/progress"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestProgress(t *testing.T) {
@@ -189,4 +190,10 @@ func TestProgress(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": progress.Example,
+	})
+}
@@ -8,19 +8,21 @@ import (
 
 // GroupProps defines properties for the RadioGroup container
 type GroupProps struct {
-	Name         string // Form field name (required for radio groups)
-	DefaultValue string // Default selected value
-	Class        string // Additional custom classes
-	Orientation  string // "vertical" | "horizontal" (default: vertical)
+	Name         string   // Form field name (required for radio groups)
+	DefaultValue string   // Default selected value
+	Class        string   // Additional custom classes
+	Orientation  string   // "vertical" | "horizontal" (default: vertical)
+	Attrs        []g.Node // Additional attributes to pass through
 }
 
 // ItemProps defines properties for individual RadioGroupItem
 type ItemProps struct {
-	ID       string // HTML id attribute
-	Value    string // The value of this radio option
-	Checked  bool   // Whether this radio is selected
-	Disabled bool   // Whether this radio is disabled
-	Class    string // Additional custom classes
+	ID       string   // HTML id attribute
+	Value    string   // The value of this radio option
+	Checked  bool     // Whether this radio is selected
+	Disabled bool     // Whether this radio is disabled
+	Class    string   // Additional custom classes
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // Group creates a new RadioGroup container
@@ -34,13 +36,13 @@ func Group(props GroupProps, children ...g.Node) g.Node {
 	
 	classes = lib.CN(classes, props.Class)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Role("radiogroup"),
 		g.If(props.Name != "", g.Attr("data-name", props.Name)),
 		g.If(props.DefaultValue != "", g.Attr("data-default-value", props.DefaultValue)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Item creates a new RadioGroupItem
@@ -82,14 +84,14 @@ func Item(props ItemProps, groupName string) g.Node {
 		"h-4 w-4 rounded-full",
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(wrapperClasses),
 		html.Input(attrs...),
 		// Indicator circle (shown when checked)
 		g.If(props.Checked,
 			renderIndicator(),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // WithLabel creates a radio item with a label
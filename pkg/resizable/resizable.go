@@ -10,36 +10,40 @@ import (
 
 // Props defines the properties for the Resizable component
 type Props struct {
-	Direction        string // "horizontal" | "vertical"
-	OnResizeStart    string // JavaScript callback
-	OnResizeEnd      string // JavaScript callback
-	Class            string // Additional custom classes
-	DefaultSize      int    // Default size percentage
-	MinSize          int    // Minimum size percentage
-	MaxSize          int    // Maximum size percentage
-	CollapsedSize    int    // Collapsed size in pixels
-	Collapsible      bool   // Whether panels can be collapsed
-	Storage          bool   // Whether to persist sizes in localStorage
-	StorageKey       string // Key for localStorage
+	Direction     string   // "horizontal" | "vertical"
+	OnResizeStart string   // JavaScript callback
+	OnResizeEnd   string   // JavaScript callback
+	Class         string   // Additional custom classes
+	DefaultSize   int      // Default size percentage
+	MinSize       int      // Minimum size percentage
+	MaxSize       int      // Maximum size percentage
+	CollapsedSize int      // Collapsed size in pixels
+	Collapsible   bool     // Whether panels can be collapsed
+	Storage       bool     // Whether to persist sizes in localStorage
+	StorageKey    string   // Key for localStorage and the layout cookie
+	PersistPath   string   // Endpoint that stores sizes in a cookie (see CookieState.Handler)
+	Attrs         []g.Node // Additional attributes to pass through
 }
 
 // PanelProps defines properties for individual panels
 type PanelProps struct {
-	DefaultSize   int    // Default size percentage
-	MinSize       int    // Minimum size percentage
-	MaxSize       int    // Maximum size percentage
-	Collapsible   bool   // Whether this panel can be collapsed
-	CollapsedSize int    // Size when collapsed
-	ID            string // Panel ID
-	Order         int    // Panel order
-	Class         string // Additional custom classes
+	DefaultSize   int      // Default size percentage
+	MinSize       int      // Minimum size percentage
+	MaxSize       int      // Maximum size percentage
+	Collapsible   bool     // Whether this panel can be collapsed
+	CollapsedSize int      // Size when collapsed
+	ID            string   // Panel ID
+	Order         int      // Panel order
+	Class         string   // Additional custom classes
+	Attrs         []g.Node // Additional attributes to pass through
 }
 
 // HandleProps defines properties for the resize handle
 type HandleProps struct {
-	WithHandle bool   // Whether to show the visual handle
-	Disabled   bool   // Whether resizing is disabled
-	Class      string // Additional custom classes
+	WithHandle bool     // Whether to show the visual handle
+	Disabled   bool     // Whether resizing is disabled
+	Class      string   // Additional custom classes
+	Attrs      []g.Node // Additional attributes to pass through
 }
 
 // PanelGroup creates a resizable panel group container
@@ -59,6 +63,7 @@ func PanelGroup(props Props, children ...g.Node) g.Node {
 		g.Attr("data-panel-group", ""),
 		g.Attr("data-panel-group-direction", props.Direction),
 		g.If(props.Storage && props.StorageKey != "", g.Attr("data-panel-group-storage", props.StorageKey)),
+		g.If(props.Storage && props.StorageKey != "" && props.PersistPath != "", g.Attr("data-panel-group-persist", props.PersistPath)),
 	}
 	
 	// Add resize event handlers if provided
@@ -69,7 +74,8 @@ func PanelGroup(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, g.Attr("data-onresizeend", props.OnResizeEnd))
 	}
 	
-	return html.Div(append(attrs, children...)...)
+	attrs = append(attrs, children...)
+	return html.Div(lib.MergeAttrs(append(attrs, resizeScript()), props.Attrs)...)
 }
 
 // Panel creates a resizable panel
@@ -109,7 +115,7 @@ func Panel(props PanelProps, children ...g.Node) g.Node {
 		html.Style(fmt.Sprintf("flex: %d %d 0%%", defaultSize, defaultSize)),
 	}
 	
-	return html.Div(append(attrs, children...)...)
+	return html.Div(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Handle creates a resize handle between panels
@@ -127,7 +133,7 @@ func Handle(props HandleProps) g.Node {
 		props.Class,
 	)
 	
-	handle := html.Div(
+	handle := html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		html.Role("separator"),
 		g.Attr("aria-valuenow", "50"),
@@ -159,7 +165,7 @@ func Handle(props HandleProps) g.Node {
 				),
 			),
 		),
-	)
+	}, props.Attrs)...)
 	
 	return handle
 }
@@ -2,11 +2,16 @@ package resizable
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestPanelGroup(t *testing.T) {
@@ -463,4 +468,71 @@ func TestIDELayout(t *testing.T) {
 	if groupCount != 2 {
 		t.Errorf("expected 2 panel groups (nested), got %d", groupCount)
 	}
-}
\ No newline at end of file
+}
+
+func TestCookieState(t *testing.T) {
+	state := CookieState{Signer: lib.NewCookieSigner([]byte("secret"))}
+
+	// Persist two groups through the handler, carrying the cookie forward
+	var cookies []*http.Cookie
+	for _, form := range []url.Values{
+		{"group": {"layout"}, "sizes": {"30,70"}},
+		{"group": {"ide"}, "sizes": {"20,50,30"}},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/layout", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+		rec := httptest.NewRecorder()
+		state.Handler().ServeHTTP(rec, req)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("expected status %d, got %d", http.StatusNoContent, rec.Code)
+		}
+		cookies = rec.Result().Cookies()
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	layout := state.StateFromRequest(req)
+
+	if got := layout.Size("layout", 0, 50); got != 30 {
+		t.Errorf("expected layout[0] = 30, got %d", got)
+	}
+	if got := layout.Size("ide", 2, 50); got != 30 {
+		t.Errorf("expected ide[2] = 30, got %d", got)
+	}
+	if got := layout.Size("missing", 0, 50); got != 50 {
+		t.Errorf("expected fallback 50, got %d", got)
+	}
+}
+
+func TestCookieStateRejectsInvalidSizes(t *testing.T) {
+	form := url.Values{"group": {"layout"}, "sizes": {"30,abc"}}
+	req := httptest.NewRequest(http.MethodPost, "/layout", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	CookieState{}.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestPanelGroupPersistPath(t *testing.T) {
+	var buf bytes.Buffer
+	err := PanelGroup(Props{Storage: true, StorageKey: "layout", PersistPath: "/layout"}).Render(&buf)
+	if err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	if !strings.Contains(buf.String(), `data-panel-group-persist="/layout"`) {
+		t.Errorf("expected persist path attribute, got %s", buf.String())
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
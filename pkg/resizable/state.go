@@ -0,0 +1,241 @@
+package resizable
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+const (
+	// LayoutCookieName is the cookie that stores panel sizes
+	LayoutCookieName = "resizable_layout"
+	// LayoutCookieMaxAge is how long panel sizes are remembered
+	LayoutCookieMaxAge = 365 * 24 * time.Hour
+)
+
+// Layout maps a panel group's StorageKey to the sizes of its panels in order
+type Layout map[string][]int
+
+// Size returns the persisted size of the panel at index in group, or fallback
+func (l Layout) Size(group string, index, fallback int) int {
+	sizes, ok := l[group]
+	if !ok || index < 0 || index >= len(sizes) {
+		return fallback
+	}
+	return sizes[index]
+}
+
+// CookieState persists panel group layouts in a signed cookie.
+// The zero value uses LayoutCookieName and lib.DefaultCookieSigner.
+type CookieState struct {
+	Name   string            // Cookie name (defaults to LayoutCookieName)
+	Signer *lib.CookieSigner // Signer (defaults to lib.DefaultCookieSigner)
+	MaxAge time.Duration     // Cookie lifetime (defaults to LayoutCookieMaxAge)
+}
+
+// StateFromRequest returns the layouts stored with the default cookie settings
+func StateFromRequest(r *http.Request) Layout {
+	return CookieState{}.StateFromRequest(r)
+}
+
+// StateFromRequest returns the layouts stored in the request cookie
+func (s CookieState) StateFromRequest(r *http.Request) Layout {
+	layout := Layout{}
+	value, ok := s.signer().Cookie(r, s.name())
+	if !ok {
+		return layout
+	}
+	values, err := url.ParseQuery(value)
+	if err != nil {
+		return layout
+	}
+	for group := range values {
+		if sizes, ok := parseSizes(values.Get(group)); ok {
+			layout[group] = sizes
+		}
+	}
+	return layout
+}
+
+// WriteState stores the layouts in the response cookie
+func (s CookieState) WriteState(w http.ResponseWriter, layout Layout) {
+	values := url.Values{}
+	for group, sizes := range layout {
+		values.Set(group, formatSizes(sizes))
+	}
+	maxAge := s.MaxAge
+	if maxAge == 0 {
+		maxAge = LayoutCookieMaxAge
+	}
+	s.signer().SetCookie(w, s.name(), values.Encode(), maxAge)
+}
+
+// Handler returns a handler that persists the sizes posted by the panel group
+// script. It expects "group" and comma-separated "sizes" form values and
+// merges them with the layouts of other groups already in the cookie.
+func (s CookieState) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		group := r.FormValue("group")
+		sizes, ok := parseSizes(r.FormValue("sizes"))
+		if group == "" || !ok {
+			http.Error(w, "Invalid layout", http.StatusBadRequest)
+			return
+		}
+
+		layout := s.StateFromRequest(r)
+		layout[group] = sizes
+		s.WriteState(w, layout)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s CookieState) name() string {
+	if s.Name == "" {
+		return LayoutCookieName
+	}
+	return s.Name
+}
+
+func (s CookieState) signer() *lib.CookieSigner {
+	if s.Signer == nil {
+		return lib.DefaultCookieSigner
+	}
+	return s.Signer
+}
+
+// parseSizes parses a comma-separated list of percentages
+func parseSizes(value string) ([]int, bool) {
+	if value == "" {
+		return nil, false
+	}
+	parts := strings.Split(value, ",")
+	sizes := make([]int, 0, len(parts))
+	for _, part := range parts {
+		size, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || size < 0 || size > 100 {
+			return nil, false
+		}
+		sizes = append(sizes, size)
+	}
+	return sizes, true
+}
+
+// formatSizes joins sizes into a comma-separated list
+func formatSizes(sizes []int) string {
+	parts := make([]string, len(sizes))
+	for i, size := range sizes {
+		parts[i] = strconv.Itoa(size)
+	}
+	return strings.Join(parts, ",")
+}
+
+// resizeScript makes the handles of the enclosing panel group draggable and
+// keyboard operable, and persists the resulting sizes to localStorage and/or
+// the server once a resize ends.
+func resizeScript() g.Node {
+	return html.Script(g.Raw(fmt.Sprintf(`
+	(function() {
+		const group = document.currentScript.parentElement;
+		if (!group || group.dataset.panelGroupBound) return;
+		group.dataset.panelGroupBound = 'true';
+		const vertical = group.dataset.panelGroupDirection === 'vertical';
+		const storageKey = group.dataset.panelGroupStorage;
+		const persistPath = group.dataset.panelGroupPersist;
+		const panels = () => Array.from(group.children).filter(el => 'panel' in el.dataset);
+		const handles = () => Array.from(group.children).filter(el => 'panelResizeHandle' in el.dataset);
+
+		function sizeOf(panel) {
+			return parseFloat(panel.dataset.panelSize);
+		}
+		function setSize(panel, size) {
+			panel.dataset.panelSize = String(size);
+			panel.style.flex = size + ' ' + size + ' 0%%';
+		}
+		function neighbours(handle) {
+			let prev = handle.previousElementSibling, next = handle.nextElementSibling;
+			while (prev && !('panel' in prev.dataset)) prev = prev.previousElementSibling;
+			while (next && !('panel' in next.dataset)) next = next.nextElementSibling;
+			return [prev, next];
+		}
+		function resize(handle, delta) {
+			const [prev, next] = neighbours(handle);
+			if (!prev || !next) return;
+			const total = sizeOf(prev) + sizeOf(next);
+			const min = Math.max(parseFloat(prev.dataset.panelMinSize), total - parseFloat(next.dataset.panelMaxSize));
+			const max = Math.min(parseFloat(prev.dataset.panelMaxSize), total - parseFloat(next.dataset.panelMinSize));
+			const size = Math.round(Math.min(max, Math.max(min, sizeOf(prev) + delta)));
+			setSize(prev, size);
+			setSize(next, total - size);
+			handle.setAttribute('aria-valuenow', String(size));
+		}
+		function persist() {
+			const sizes = panels().map(sizeOf).join(',');
+			if (storageKey) {
+				try { localStorage.setItem('panel-group:' + storageKey, sizes); } catch (e) {}
+			}
+			if (storageKey && persistPath) {
+				const body = new URLSearchParams({ group: storageKey, sizes: sizes });
+				fetch(persistPath, { method: 'POST', body: body, credentials: 'same-origin' });
+			}
+		}
+
+		// Restore from localStorage only when the server has nothing to render
+		if (storageKey && !persistPath) {
+			try {
+				const saved = localStorage.getItem('panel-group:' + storageKey);
+				if (saved) {
+					const sizes = saved.split(',').map(Number);
+					panels().forEach((panel, i) => { if (!isNaN(sizes[i])) setSize(panel, sizes[i]); });
+				}
+			} catch (e) {}
+		}
+
+		handles().forEach(handle => {
+			if ('disabled' in handle.dataset) return;
+			handle.addEventListener('pointerdown', (e) => {
+				e.preventDefault();
+				handle.setPointerCapture(e.pointerId);
+				const rect = group.getBoundingClientRect();
+				const extent = vertical ? rect.height : rect.width;
+				const start = vertical ? e.clientY : e.clientX;
+				const prev = neighbours(handle)[0];
+				const startSize = prev ? sizeOf(prev) : 0;
+				const move = (ev) => {
+					if (!prev) return;
+					const pos = vertical ? ev.clientY : ev.clientX;
+					resize(handle, startSize + (pos - start) / extent * 100 - sizeOf(prev));
+				};
+				const up = () => {
+					handle.removeEventListener('pointermove', move);
+					handle.removeEventListener('pointerup', up);
+					persist();
+				};
+				handle.addEventListener('pointermove', move);
+				handle.addEventListener('pointerup', up);
+			});
+			handle.addEventListener('keydown', (e) => {
+				const step = { ArrowLeft: -%[1]d, ArrowUp: -%[1]d, ArrowRight: %[1]d, ArrowDown: %[1]d }[e.key];
+				if (!step) return;
+				e.preventDefault();
+				resize(handle, step);
+				persist();
+			});
+		});
+	})();
+	`, keyboardStep)))
+}
+
+// keyboardStep is the percentage a handle moves per arrow key press
+const keyboardStep = 5
@@ -2,7 +2,10 @@ package scrollarea
 
 import (
 	"fmt"
+	"net/http"
+	"slices"
 	
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 )
@@ -162,6 +165,21 @@ func postsHandler(w http.ResponseWriter, r *http.Request) {
 			),
 		),
 		
+		// Infinite list
+		html.Div(
+			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Infinite List")),
+			exampleInfiniteFeed(),
+		),
+		
+		// Infinite chat history
+		html.Div(
+			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Infinite Chat History")),
+			html.Div(
+				html.Class("h-96 w-full max-w-md rounded-lg border bg-background"),
+				exampleInfiniteHistory(),
+			),
+		),
+		
 		// Image gallery scroll area
 		html.Div(
 			html.H3(html.Class("text-lg font-semibold mb-4"), g.Text("Image Gallery ScrollArea")),
@@ -420,4 +438,104 @@ func generateColoredItems() []g.Node {
 		)
 	}
 	return items
+}
+
+const (
+	exampleItems    = 100
+	examplePageSize = 15
+)
+
+var (
+	exampleFeed    = InfiniteProps{ID: "example-feed", LoadPath: "/htmx/scrollarea/feed", Class: "h-72 w-72 rounded-md border"}
+	exampleHistory = InfiniteProps{ID: "example-history", LoadPath: "/htmx/scrollarea/history", Class: "h-full", StartAtEnd: true}
+)
+
+// ExampleHandlers serves the pages of the infinite list examples
+func ExampleHandlers() []router.Registrar {
+	return []router.Registrar{
+		Handlers{Props: exampleFeed, Load: loadExampleFeed},
+		Handlers{Props: exampleHistory, Load: loadExampleHistory},
+	}
+}
+
+func exampleInfiniteFeed() g.Node {
+	items, next, _ := loadExampleFeed(nil, "", Next)
+	props := exampleFeed
+	props.Next = next
+	return InfiniteList(props, items...)
+}
+
+func exampleInfiniteHistory() g.Node {
+	items, prev, _ := loadExampleHistory(nil, "", Prev)
+	props := exampleHistory
+	props.Prev = prev
+	return InfiniteList(props, items...)
+}
+
+// loadExampleFeed returns the items after the one in the cursor
+func loadExampleFeed(r *http.Request, cursor string, dir Direction) ([]g.Node, string, error) {
+	after := 0
+	if cursor != "" {
+		if err := DecodeCursor(cursor, &after); err != nil {
+			return nil, "", err
+		}
+	}
+	
+	var numbers []int
+	for n := after + 1; n <= exampleItems && len(numbers) <= examplePageSize; n++ {
+		numbers = append(numbers, n)
+	}
+	page, err := NewPage(numbers, examplePageSize, func(n int) any { return n })
+	if err != nil {
+		return nil, "", err
+	}
+	
+	items := make([]g.Node, len(page.Items))
+	for i, n := range page.Items {
+		items[i] = html.Div(
+			html.Class("flex items-center space-x-2 px-4 py-2"),
+			html.Div(html.Class("w-2 h-2 rounded-full bg-primary")),
+			html.Span(html.Class("text-sm"), g.Textf("Feed item %d", n)),
+		)
+	}
+	return items, page.Next, nil
+}
+
+// loadExampleHistory returns the messages before the one in the cursor,
+// oldest first
+func loadExampleHistory(r *http.Request, cursor string, dir Direction) ([]g.Node, string, error) {
+	before := exampleItems + 1
+	if cursor != "" {
+		if err := DecodeCursor(cursor, &before); err != nil {
+			return nil, "", err
+		}
+	}
+	
+	var numbers []int
+	for n := before - 1; n >= 1 && len(numbers) <= examplePageSize; n-- {
+		numbers = append(numbers, n)
+	}
+	page, err := NewPage(numbers, examplePageSize, func(n int) any { return n })
+	if err != nil {
+		return nil, "", err
+	}
+	slices.Reverse(page.Items)
+	
+	items := make([]g.Node, len(page.Items))
+	for i, n := range page.Items {
+		alignClass := "justify-start"
+		bgClass := "bg-muted"
+		if n%2 == 0 {
+			alignClass = "justify-end"
+			bgClass = "bg-primary text-primary-foreground"
+		}
+		items[i] = html.Div(
+			html.Class(fmt.Sprintf("flex px-4 py-2 %s", alignClass)),
+			html.Div(
+				html.Class(fmt.Sprintf("rounded-lg px-3 py-2 max-w-[70%%] %s", bgClass)),
+				html.P(html.Class("text-sm"), g.Textf("Message %d", n)),
+			),
+		)
+	}
+	return items, page.Next, nil
 }
\ No newline at end of file
@@ -0,0 +1,236 @@
+package scrollarea
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/skeleton"
+	g "maragu.dev/gomponents"
+	hx "maragu.dev/gomponents-htmx"
+	html "maragu.dev/gomponents/html"
+)
+
+// Direction is the end of an infinite list that a page is loaded at
+type Direction string
+
+const (
+	// Next pages are appended after the last item
+	Next Direction = "next"
+	// Prev pages are prepended before the first item, keeping the scroll
+	// position (older messages in a chat)
+	Prev Direction = "prev"
+)
+
+// InfiniteProps defines the properties for an infinite list
+type InfiniteProps struct {
+	ID          string   // List id (required)
+	LoadPath    string   // Path that serves a page for the "cursor" and "direction" query parameters
+	Next        string   // Cursor of the page after the rendered items ("" when there is none)
+	Prev        string   // Cursor of the page before the rendered items ("" when there is none)
+	Trigger     string   // "intersect" (default) | "revealed"; revealed only works when the page itself scrolls
+	StartAtEnd  bool     // Scroll to the last item when the list is shown (chat)
+	Placeholder g.Node   // Shown while a page is in flight (defaults to skeleton.ListComponent(3))
+	Class       string   // Additional classes for the scroll area
+	Attrs       []g.Node // Additional attributes to pass through
+}
+
+// InfiniteList renders a scroll area that loads more items over HTMX as the
+// user reaches either end. Each page is requested from LoadPath with its
+// cursor and replaces the sentinel that requested it; page handlers render
+// their items with RenderPage to keep the list going.
+func InfiniteList(props InfiniteProps, items ...g.Node) g.Node {
+	return New(
+		Props{
+			Type:  "auto",
+			Class: props.Class,
+			Attrs: props.Attrs,
+		},
+		Viewport(
+			ViewportProps{},
+			html.Div(
+				g.If(props.ID != "", html.ID(props.ID)),
+				html.Class("flex flex-col"),
+				g.Attr("data-infinite", ""),
+				g.If(props.StartAtEnd, g.Attr("data-infinite-start", "end")),
+				Sentinel(props, Prev, props.Prev),
+				g.Group(items),
+				Sentinel(props, Next, props.Next),
+			),
+		),
+		Scrollbar(
+			ScrollbarProps{Orientation: "vertical"},
+			Thumb(ThumbProps{}),
+		),
+		html.Script(g.Raw(infiniteScript)),
+	)
+}
+
+// RenderPage renders the response for a page loaded in direction dir: the
+// items together with the sentinel that loads the page at cursor, which is
+// placed before the items for Prev and after them for Next.
+func RenderPage(props InfiniteProps, dir Direction, cursor string, items ...g.Node) g.Node {
+	if dir == Prev {
+		return g.Group{Sentinel(props, Prev, cursor), g.Group(items)}
+	}
+	return g.Group{g.Group(items), Sentinel(props, Next, cursor)}
+}
+
+// Sentinel returns the element that requests the page at cursor once it
+// scrolls into view, showing the placeholder until the page arrives.
+// It renders nothing when cursor is empty.
+func Sentinel(props InfiniteProps, dir Direction, cursor string) g.Node {
+	if cursor == "" || props.LoadPath == "" {
+		return nil
+	}
+
+	trigger := "intersect once"
+	if props.Trigger == "revealed" {
+		trigger = "revealed"
+	}
+
+	placeholder := props.Placeholder
+	if placeholder == nil {
+		placeholder = skeleton.ListComponent(3)
+	}
+
+	query := url.Values{"cursor": {cursor}, "direction": {string(dir)}}
+
+	return html.Div(
+		html.Class("p-4"),
+		html.Role("status"),
+		g.Attr("data-infinite-sentinel", string(dir)),
+		hx.Get(props.LoadPath+"?"+query.Encode()),
+		hx.Trigger(trigger),
+		hx.Swap("outerHTML"),
+		html.Span(html.Class("sr-only"), g.Text("Loading more items")),
+		html.Div(g.Attr("aria-hidden", "true"), placeholder),
+	)
+}
+
+// PageRequest returns the cursor and direction of a page request
+func PageRequest(r *http.Request) (string, Direction) {
+	dir := Direction(r.URL.Query().Get("direction"))
+	if dir != Prev {
+		dir = Next
+	}
+	return r.URL.Query().Get("cursor"), dir
+}
+
+// Page is a page of items together with the cursor of the page that follows
+// it in the same direction
+type Page[T any] struct {
+	Items []T
+	Next  string // Cursor of the following page ("" on the last page)
+}
+
+// NewPage builds a page from items fetched with a limit of limit+1. When more
+// than limit items were fetched the extra one is dropped, and the cursor of
+// the following page is encoded from the key of the last item kept.
+func NewPage[T any](items []T, limit int, key func(T) any) (Page[T], error) {
+	if limit <= 0 || len(items) <= limit {
+		return Page[T]{Items: items}, nil
+	}
+	items = items[:limit]
+	next, err := EncodeCursor(key(items[limit-1]))
+	if err != nil {
+		return Page[T]{}, err
+	}
+	return Page[T]{Items: items, Next: next}, nil
+}
+
+// EncodeCursor encodes v as an opaque, URL-safe cursor
+func EncodeCursor(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("scrollarea: encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor decodes a cursor produced by EncodeCursor into v. Cursors come
+// from the client, so the decoded value must be validated like any input.
+func DecodeCursor(cursor string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return fmt.Errorf("scrollarea: decode cursor: %w", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("scrollarea: decode cursor: %w", err)
+	}
+	return nil
+}
+
+// LoadFunc returns the items of the page at cursor in direction dir and the
+// cursor of the page after it ("" when there is none). An error is logged
+// and reported to the client as a bad request, which is usually an invalid
+// cursor; its message is not sent, as it may describe the backing store.
+type LoadFunc func(r *http.Request, cursor string, dir Direction) ([]g.Node, string, error)
+
+// Handlers serves the pages of an infinite list
+type Handlers struct {
+	Props InfiniteProps
+	Load  LoadFunc
+}
+
+// Register registers the page route on rt
+func (h Handlers) Register(rt router.Router) {
+	if h.Props.LoadPath == "" {
+		panic("scrollarea.Handlers: LoadPath is required")
+	}
+	if h.Load == nil {
+		panic("scrollarea.Handlers: Load is required")
+	}
+
+	props := h.Props
+	props.LoadPath = rt.Path(h.Props.LoadPath)
+
+	rt.Handle(http.MethodGet, h.Props.LoadPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor, dir := PageRequest(r)
+		items, next, err := h.Load(r, cursor, dir)
+		if err != nil {
+			log.Printf("scrollarea: loading %s: %v", r.URL.Path, err)
+			http.Error(w, "Unable to load page", http.StatusBadRequest)
+			return
+		}
+		RenderPage(props, dir, next, items...).Render(w)
+	}))
+}
+
+// infiniteScript scrolls lists marked data-infinite-start="end" to the bottom
+// and keeps the visible items in place when older items are prepended
+const infiniteScript = `
+(function() {
+	function viewport(el) {
+		return el.closest('[data-scroll-area-viewport]');
+	}
+	function start() {
+		document.querySelectorAll('[data-infinite-start="end"]:not([data-infinite-ready])').forEach(function(list) {
+			list.setAttribute('data-infinite-ready', '');
+			const v = viewport(list);
+			if (v) v.scrollTop = v.scrollHeight;
+		});
+	}
+	start();
+	if (window.shadcnInfinite) return;
+	window.shadcnInfinite = true;
+	document.addEventListener('htmx:load', start);
+
+	let pending = null;
+	document.addEventListener('htmx:beforeSwap', function(e) {
+		const target = e.detail.target;
+		if (!target || !target.matches('[data-infinite-sentinel="prev"]')) return;
+		const v = viewport(target);
+		if (v) pending = { viewport: v, offset: v.scrollHeight - v.scrollTop };
+	});
+	document.addEventListener('htmx:afterSwap', function() {
+		if (!pending) return;
+		pending.viewport.scrollTop = pending.viewport.scrollHeight - pending.offset;
+		pending = null;
+	});
+})();
+`
@@ -0,0 +1,149 @@
+package scrollarea
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	g "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func TestInfiniteList(t *testing.T) {
+	tests := []struct {
+		name       string
+		props      InfiniteProps
+		wantOrder  []string
+		notContain []string
+	}{
+		{
+			name:  "next page",
+			props: InfiniteProps{ID: "feed", LoadPath: "/feed", Next: "abc"},
+			wantOrder: []string{
+				`id="feed"`,
+				"Item 1",
+				`data-infinite-sentinel="next"`,
+				`hx-get="/feed?cursor=abc&amp;direction=next"`,
+				`hx-trigger="intersect once"`,
+				`hx-swap="outerHTML"`,
+				"animate-pulse",
+			},
+			notContain: []string{` data-infinite-sentinel="prev"`, " data-infinite-start"},
+		},
+		{
+			name:  "chat",
+			props: InfiniteProps{ID: "chat", LoadPath: "/chat", Prev: "old", StartAtEnd: true},
+			wantOrder: []string{
+				`data-infinite-start="end"`,
+				`data-infinite-sentinel="prev"`,
+				`hx-get="/chat?cursor=old&amp;direction=prev"`,
+				"Item 1",
+			},
+			notContain: []string{` data-infinite-sentinel="next"`},
+		},
+		{
+			name:       "last page",
+			props:      InfiniteProps{ID: "feed", LoadPath: "/feed"},
+			wantOrder:  []string{"Item 1"},
+			notContain: []string{" data-infinite-sentinel"},
+		},
+		{
+			name: "revealed with placeholder",
+			props: InfiniteProps{
+				ID: "feed", LoadPath: "/feed", Next: "abc", Trigger: "revealed",
+				Placeholder: Span(g.Text("Loading")),
+			},
+			wantOrder:  []string{`hx-trigger="revealed"`, "<span>Loading</span>"},
+			notContain: []string{"animate-pulse"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStr := renderToString(InfiniteList(tt.props, Div(g.Text("Item 1"))))
+
+			rest := gotStr
+			for _, want := range tt.wantOrder {
+				i := strings.Index(rest, want)
+				if i < 0 {
+					t.Fatalf("expected output to contain %q in order, got %s", want, gotStr)
+				}
+				rest = rest[i+len(want):]
+			}
+			for _, notWant := range tt.notContain {
+				if strings.Contains(gotStr, notWant) {
+					t.Errorf("expected output not to contain %q", notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	page, err := NewPage([]int{1, 2, 3}, 2, func(i int) any { return i })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 2 || page.Next == "" {
+		t.Fatalf("expected 2 items and a cursor, got %+v", page)
+	}
+	var key int
+	if err := DecodeCursor(page.Next, &key); err != nil || key != 2 {
+		t.Errorf("expected cursor for key 2, got %d (%v)", key, err)
+	}
+
+	last, err := NewPage([]int{1, 2}, 2, func(i int) any { return i })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(last.Items) != 2 || last.Next != "" {
+		t.Errorf("expected last page without cursor, got %+v", last)
+	}
+
+	if err := DecodeCursor("not a cursor!", &key); err == nil {
+		t.Error("expected error for invalid cursor")
+	}
+}
+
+func TestHandlers(t *testing.T) {
+	mux := http.NewServeMux()
+	Handlers{
+		Props: InfiniteProps{ID: "chat", LoadPath: "/chat"},
+		Load: func(r *http.Request, cursor string, dir Direction) ([]g.Node, string, error) {
+			return []g.Node{Div(g.Text("Older " + cursor))}, "older", nil
+		},
+	}.Register(router.ServeMux(mux))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/chat?cursor=x&direction=prev", nil))
+
+	body := rec.Body.String()
+	sentinel := strings.Index(body, `hx-get="/chat?cursor=older&amp;direction=prev"`)
+	item := strings.Index(body, "Older x")
+	if sentinel < 0 || item < 0 || sentinel > item {
+		t.Errorf("expected sentinel before older items, got %s", body)
+	}
+}
+
+func TestHandlersLoadError(t *testing.T) {
+	mux := http.NewServeMux()
+	Handlers{
+		Props: InfiniteProps{ID: "chat", LoadPath: "/chat"},
+		Load: func(r *http.Request, cursor string, dir Direction) ([]g.Node, string, error) {
+			return nil, "", errors.New("query messages: connection refused")
+		},
+	}.Register(router.ServeMux(mux))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/chat?cursor=x", nil))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+	if body := rec.Body.String(); strings.Contains(body, "connection refused") {
+		t.Errorf("expected the load error not to be sent to the client, got %q", body)
+	}
+}
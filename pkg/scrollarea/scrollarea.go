@@ -10,33 +10,38 @@ import (
 
 // Props defines the properties for the ScrollArea component
 type Props struct {
-	Orientation string // "vertical" | "horizontal" | "both"
-	Type        string // "auto" | "always" | "scroll" | "hover"
-	ScrollHideDelay int    // Delay in ms before hiding scrollbar
-	Dir         string // "ltr" | "rtl"
-	Class       string // Additional custom classes
+	Orientation     string   // "vertical" | "horizontal" | "both"
+	Type            string   // "auto" | "always" | "scroll" | "hover"
+	ScrollHideDelay int      // Delay in ms before hiding scrollbar
+	Dir             string   // "ltr" | "rtl"
+	Class           string   // Additional custom classes
+	Attrs           []g.Node // Additional attributes to pass through
 }
 
 // ViewportProps defines properties for the viewport
 type ViewportProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // ScrollbarProps defines properties for the scrollbar
 type ScrollbarProps struct {
-	Orientation string // "vertical" | "horizontal"
-	ForceMount  bool   // Always render the scrollbar
-	Class       string // Additional custom classes
+	Orientation string   // "vertical" | "horizontal"
+	ForceMount  bool     // Always render the scrollbar
+	Class       string   // Additional custom classes
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // ThumbProps defines properties for the scrollbar thumb
 type ThumbProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // CornerProps defines properties for the corner where scrollbars meet
 type CornerProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new ScrollArea container
@@ -63,7 +68,7 @@ func New(props Props, children ...g.Node) g.Node {
 		g.If(props.ScrollHideDelay > 0, g.Attr("data-scroll-hide-delay", fmt.Sprintf("%d", props.ScrollHideDelay))),
 	}
 	
-	return html.Div(append(attrs, children...)...)
+	return html.Div(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Viewport creates the scrollable viewport
@@ -73,14 +78,14 @@ func Viewport(props ViewportProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-scroll-area-viewport", ""),
 		html.Style("overflow: scroll; -ms-overflow-style: none; scrollbar-width: none;"),
 		// Hide webkit scrollbar
 		g.Attr("style", "overflow: scroll; -ms-overflow-style: none; scrollbar-width: none; &::-webkit-scrollbar { display: none; }"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Scrollbar creates a scrollbar element
@@ -110,7 +115,7 @@ func Scrollbar(props ScrollbarProps, children ...g.Node) g.Node {
 		g.If(props.ForceMount, g.Attr("data-state", "visible")),
 	}
 	
-	return html.Div(append(attrs, children...)...)
+	return html.Div(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Thumb creates the scrollbar thumb
@@ -120,11 +125,11 @@ func Thumb(props ThumbProps) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-scroll-area-thumb", ""),
 		html.Style("position: relative;"),
-	)
+	}, props.Attrs)...)
 }
 
 // Corner creates the corner element where scrollbars meet
@@ -134,10 +139,10 @@ func Corner(props CornerProps) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-scroll-area-corner", ""),
-	)
+	}, props.Attrs)...)
 }
 
 // ScrollAreaWithBar creates a scroll area with visible scrollbars
@@ -3,6 +3,7 @@ package selector
 import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
 )
 
 // Example demonstrates how to use the Select component
@@ -78,6 +79,36 @@ func Example() g.Node {
 			),
 		),
 		
+		// Listbox select
+		html.Div(
+			html.H4(html.Class("text-sm font-medium mb-4"), g.Text("Listbox")),
+			html.Div(html.Class("w-[280px] space-y-2"),
+				html.Label(html.For("select-listbox-plan"), html.Class("text-sm font-medium"), g.Text("Plan")),
+				Listbox(Props{
+					Name:        "plan",
+					Placeholder: "Select a plan",
+					Options: []OptionType{
+						{Value: "free", Label: "Free", Description: "For personal projects", Icon: icons.User()},
+					},
+					Groups: []Group{
+						{
+							Label: "Paid",
+							Options: []OptionType{
+								{Value: "pro", Label: "Pro", Description: "For small teams", Icon: icons.Users()},
+								{Value: "business", Label: "Business", Description: "For growing companies", Icon: icons.Package()},
+							},
+						},
+						{
+							Label: "Custom",
+							Options: []OptionType{
+								{Value: "enterprise", Label: "Enterprise", Description: "Contact sales", Disabled: true},
+							},
+						},
+					},
+				}),
+			),
+		),
+		
 		// Form field with label and description
 		html.Div(
 			html.H4(html.Class("text-sm font-medium mb-4"), g.Text("Form Field")),
@@ -0,0 +1,395 @@
+package selector
+
+import (
+	"fmt"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+// Listbox creates a select whose options open in a positioned listbox
+// popover, as the Radix UI Select does. Options can carry an Icon and a
+// Description, groups are labelled and separated, and the selected option
+// shows a check indicator.
+//
+// The value is submitted through a hidden input, so Listbox works in plain
+// forms. The native select from New is rendered alongside it: without
+// JavaScript it is the control the user sees, and with JavaScript it is
+// visually hidden but kept for Required validation. Listbox renders the
+// roving focus and floating positioning scripts it needs; both run once per
+// page however many listboxes there are.
+func Listbox(props Props) g.Node {
+	if props.ID == "" {
+		props.ID = "select-listbox-" + props.Name
+	}
+	value := selectedValue(props)
+	selected, hasSelected := findOption(props, value)
+
+	sizeClass := "h-9"
+	switch props.Size {
+	case "sm":
+		sizeClass = "h-8"
+	case "lg":
+		sizeClass = "h-10"
+	}
+
+	native := props
+	native.Value = value
+	native.Attrs = []g.Node{g.Attr("data-select-native", "")}
+
+	return html.Div(lib.MergeAttrs([]g.Node{
+		html.Class(lib.CN("relative w-full", props.Class)),
+		g.Attr("data-select", ""),
+		g.Attr("data-state", "closed"),
+
+		New(native),
+
+		// Trigger
+		html.Button(
+			html.Type("button"),
+			html.Class(lib.CN(
+				"border-input data-[placeholder]:text-muted-foreground [&_svg:not([class*='text-'])]:text-muted-foreground",
+				"focus-visible:border-ring focus-visible:ring-ring/50 focus-visible:ring-[3px]",
+				"aria-invalid:ring-destructive/20 dark:aria-invalid:ring-destructive/40 aria-invalid:border-destructive",
+				"dark:bg-input/30 dark:hover:bg-input/50 flex w-full items-center justify-between gap-2 rounded-md border bg-transparent px-3 py-2 text-sm whitespace-nowrap shadow-xs transition-[color,box-shadow] outline-none",
+				"disabled:cursor-not-allowed disabled:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4",
+				sizeClass,
+			)),
+			g.Attr("role", "combobox"),
+			g.Attr("aria-haspopup", "listbox"),
+			g.Attr("aria-expanded", "false"),
+			g.Attr("aria-controls", props.ID+"-content"),
+			g.Attr("aria-autocomplete", "none"),
+			g.Attr("data-select-trigger", ""),
+			g.Attr("data-state", "closed"),
+			g.If(!hasSelected, g.Attr("data-placeholder", "")),
+			g.If(props.Required, g.Attr("aria-required", "true")),
+			g.If(props.Disabled, html.Disabled()),
+			g.Attr("hidden"),
+			html.Span(
+				html.Class("flex items-center gap-2 line-clamp-1"),
+				g.Attr("data-select-value", ""),
+				g.If(hasSelected, itemText(selected)),
+				g.If(!hasSelected, g.Text(props.Placeholder)),
+			),
+			icons.ChevronDown(html.Class("size-4 opacity-50")),
+		),
+
+		// Content
+		html.Div(
+			html.ID(props.ID+"-content"),
+			html.Class(lib.CN(
+				"bg-popover text-popover-foreground z-50 flex min-w-[8rem] flex-col overflow-hidden rounded-md border shadow-md",
+				"data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0",
+				"data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95",
+				"data-[side=bottom]:slide-in-from-top-2 data-[side=left]:slide-in-from-right-2 data-[side=right]:slide-in-from-left-2 data-[side=top]:slide-in-from-bottom-2",
+			)),
+			g.Attr("data-select-content", ""),
+			g.Attr("data-state", "closed"),
+			floating.Attrs(floating.Props{
+				Side:       floating.SideBottom,
+				Align:      floating.AlignStart,
+				SideOffset: 4,
+				Collision:  floating.Collision{Padding: 8},
+			}),
+			g.Attr("hidden"),
+			scrollButton("up"),
+			html.Div(
+				html.ID(props.ID+"-listbox"),
+				html.Class("max-h-72 scroll-my-1 overflow-y-auto p-1"),
+				g.Attr("role", "listbox"),
+				g.Attr("data-select-viewport", ""),
+				g.If(props.Required, g.Attr("aria-required", "true")),
+				roving.Group(roving.Props{}),
+				roving.Script(),
+				g.Group(listboxItems(props, value)),
+			),
+			scrollButton("down"),
+		),
+
+		html.Input(
+			html.Type("hidden"),
+			g.If(props.Name != "", html.Name(props.Name)),
+			html.Value(value),
+			g.Attr("data-select-input", ""),
+			html.Disabled(),
+		),
+
+		floating.Script(),
+		html.Script(g.Raw(listboxScript)),
+	}, props.Attrs)...)
+}
+
+// selectedValue returns the value of the selected option
+func selectedValue(props Props) string {
+	if props.Value != "" {
+		return props.Value
+	}
+	for _, opt := range allOptions(props) {
+		if opt.Selected {
+			return opt.Value
+		}
+	}
+	return ""
+}
+
+// allOptions returns the ungrouped and grouped options in order
+func allOptions(props Props) []OptionType {
+	options := props.Options
+	for _, group := range props.Groups {
+		options = append(options[:len(options):len(options)], group.Options...)
+	}
+	return options
+}
+
+// findOption returns the option with value
+func findOption(props Props, value string) (OptionType, bool) {
+	if value == "" {
+		return OptionType{}, false
+	}
+	for _, opt := range allOptions(props) {
+		if opt.Value == value {
+			return opt, true
+		}
+	}
+	return OptionType{}, false
+}
+
+// listboxItems renders the ungrouped options followed by each labelled
+// group, with separators between them
+func listboxItems(props Props, value string) []g.Node {
+	var nodes []g.Node
+	index := 0
+	option := func(opt OptionType) g.Node {
+		node := listboxOption(fmt.Sprintf("%s-option-%d", props.ID, index), opt, opt.Value == value)
+		index++
+		return node
+	}
+
+	for _, opt := range props.Options {
+		nodes = append(nodes, option(opt))
+	}
+	for i, group := range props.Groups {
+		if i > 0 || len(props.Options) > 0 {
+			nodes = append(nodes, html.Div(
+				html.Class("bg-border pointer-events-none -mx-1 my-1 h-px"),
+				g.Attr("role", "separator"),
+				g.Attr("aria-hidden", "true"),
+			))
+		}
+		labelID := fmt.Sprintf("%s-group-%d", props.ID, i)
+		children := []g.Node{
+			g.Attr("role", "group"),
+			g.If(group.Label != "", g.Attr("aria-labelledby", labelID)),
+			g.If(group.Label != "", html.Div(
+				html.ID(labelID),
+				html.Class("text-muted-foreground px-2 py-1.5 text-xs"),
+				g.Text(group.Label),
+			)),
+		}
+		for _, opt := range group.Options {
+			children = append(children, option(opt))
+		}
+		nodes = append(nodes, html.Div(children...))
+	}
+	return nodes
+}
+
+// listboxOption renders an option of the listbox
+func listboxOption(id string, opt OptionType, selected bool) g.Node {
+	state := "unchecked"
+	if selected {
+		state = "checked"
+	}
+
+	return html.Div(
+		html.ID(id),
+		html.Class(lib.CN(
+			"group focus:bg-accent focus:text-accent-foreground [&_svg:not([class*='text-'])]:text-muted-foreground",
+			"relative flex w-full cursor-default items-center gap-2 rounded-sm py-1.5 pr-8 pl-2 text-sm outline-hidden select-none",
+			"data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4",
+		)),
+		g.Attr("role", "option"),
+		g.Attr("data-value", opt.Value),
+		g.Attr("data-state", state),
+		g.Attr("aria-selected", fmt.Sprintf("%t", selected)),
+		g.If(opt.Disabled, g.Group([]g.Node{
+			g.Attr("aria-disabled", "true"),
+			g.Attr("data-disabled", ""),
+		})),
+		roving.Item(selected),
+		roving.Label(opt.Label),
+		html.Span(
+			html.Class("absolute right-2 flex size-3.5 items-center justify-center"),
+			icons.Check(html.Class("size-4 hidden group-data-[state=checked]:block")),
+		),
+		g.If(opt.Description == "", itemText(opt)),
+		g.If(opt.Description != "", html.Div(
+			html.Class("flex flex-col gap-0.5"),
+			itemText(opt),
+			html.Span(
+				html.Class("text-muted-foreground text-xs"),
+				g.Text(opt.Description),
+			),
+		)),
+	)
+}
+
+// itemText renders the part of an option shown in the trigger once selected
+func itemText(opt OptionType) g.Node {
+	return html.Span(
+		html.Class("flex items-center gap-2"),
+		g.Attr("data-select-item-text", ""),
+		g.If(opt.Icon != nil, opt.Icon),
+		g.Text(opt.Label),
+	)
+}
+
+// scrollButton renders the button that scrolls the listbox while hovered
+func scrollButton(direction string) g.Node {
+	icon := icons.ChevronDown(html.Class("size-4"))
+	if direction == "up" {
+		icon = icons.ChevronUp(html.Class("size-4"))
+	}
+	return html.Div(
+		html.Class("flex cursor-default items-center justify-center py-1"),
+		g.Attr("data-select-scroll", direction),
+		g.Attr("aria-hidden", "true"),
+		g.Attr("hidden"),
+		icon,
+	)
+}
+
+// listboxScript swaps the native select for the listbox, opens and closes
+// the popover, and writes the chosen option to the hidden input. Keyboard
+// navigation and typeahead inside the listbox come from the roving script.
+const listboxScript = `
+(function() {
+	if (!window.shadcnSelect) {
+		function parts(root) {
+			return {
+				root: root,
+				native: root.querySelector('[data-select-native]'),
+				trigger: root.querySelector('[data-select-trigger]'),
+				content: root.querySelector('[data-select-content]'),
+				viewport: root.querySelector('[data-select-viewport]'),
+				input: root.querySelector('[data-select-input]')
+			};
+		}
+		function options(s) {
+			return Array.from(s.viewport.querySelectorAll('[role="option"]'));
+		}
+		function updateScroll(s) {
+			const v = s.viewport;
+			s.content.querySelector('[data-select-scroll="up"]').hidden = v.scrollTop <= 0;
+			s.content.querySelector('[data-select-scroll="down"]').hidden = v.scrollTop + v.clientHeight >= v.scrollHeight - 1;
+		}
+		function setOpen(s, open) {
+			s.root.dataset.state = open ? 'open' : 'closed';
+			s.trigger.dataset.state = s.root.dataset.state;
+			s.content.dataset.state = s.root.dataset.state;
+			s.trigger.setAttribute('aria-expanded', String(open));
+			s.content.hidden = !open;
+			if (!open) return;
+			s.content.style.minWidth = s.trigger.offsetWidth + 'px';
+			const list = options(s).filter(el => !el.hasAttribute('data-disabled'));
+			const item = list.find(el => el.dataset.state === 'checked') || list[0];
+			if (item) {
+				item.focus();
+				item.scrollIntoView({ block: 'nearest' });
+			}
+			updateScroll(s);
+		}
+		function close(s) {
+			if (s.root.dataset.state !== 'open') return;
+			setOpen(s, false);
+			s.trigger.focus();
+		}
+		function choose(s, item) {
+			options(s).forEach(el => {
+				const checked = el === item;
+				el.dataset.state = checked ? 'checked' : 'unchecked';
+				el.setAttribute('aria-selected', String(checked));
+			});
+			const text = item.querySelector('[data-select-item-text]');
+			s.trigger.querySelector('[data-select-value]').replaceChildren(text.cloneNode(true));
+			s.trigger.removeAttribute('data-placeholder');
+			s.trigger.removeAttribute('aria-invalid');
+			s.native.value = item.dataset.value;
+			s.input.value = item.dataset.value;
+			s.input.dispatchEvent(new Event('input', { bubbles: true }));
+			s.input.dispatchEvent(new Event('change', { bubbles: true }));
+			close(s);
+		}
+		function init(root) {
+			root.dataset.selectReady = '';
+			const s = parts(root);
+			const id = s.native.id;
+			s.native.removeAttribute('id');
+			s.native.removeAttribute('name');
+			s.native.tabIndex = -1;
+			s.native.setAttribute('aria-hidden', 'true');
+			s.native.className = 'pointer-events-none absolute bottom-0 left-0 h-px w-full opacity-0';
+			s.trigger.id = id;
+			s.trigger.hidden = false;
+			s.viewport.setAttribute('aria-labelledby', id);
+			s.input.disabled = s.trigger.disabled;
+			s.native.addEventListener('invalid', () => {
+				s.trigger.setAttribute('aria-invalid', 'true');
+				s.trigger.focus();
+			});
+			s.viewport.addEventListener('scroll', () => updateScroll(s));
+			s.content.querySelectorAll('[data-select-scroll]').forEach(button => {
+				let timer = 0;
+				const step = button.dataset.selectScroll === 'up' ? -8 : 8;
+				button.addEventListener('pointerenter', () => {
+					clearInterval(timer);
+					timer = setInterval(() => { s.viewport.scrollTop += step; }, 16);
+				});
+				button.addEventListener('pointerleave', () => clearInterval(timer));
+			});
+		}
+		function initAll(scope) {
+			scope.querySelectorAll('[data-select]:not([data-select-ready])').forEach(init);
+		}
+
+		document.addEventListener('click', (e) => {
+			document.querySelectorAll('[data-select][data-state="open"]').forEach(root => {
+				if (!root.contains(e.target)) setOpen(parts(root), false);
+			});
+			const root = e.target.closest('[data-select]');
+			if (!root) return;
+			const s = parts(root);
+			if (e.target.closest('[data-select-trigger]')) {
+				setOpen(s, root.dataset.state !== 'open');
+				return;
+			}
+			const item = e.target.closest('[role="option"]');
+			if (item && s.viewport.contains(item) && !item.hasAttribute('data-disabled')) choose(s, item);
+		});
+		document.addEventListener('keydown', (e) => {
+			const root = e.target.closest && e.target.closest('[data-select]');
+			if (!root) return;
+			const s = parts(root);
+			// Enter and Space open the listbox through the trigger's click
+			if (e.target === s.trigger && (e.key === 'ArrowDown' || e.key === 'ArrowUp')) {
+				e.preventDefault();
+				setOpen(s, true);
+				return;
+			}
+			if (!s.content.contains(e.target)) return;
+			if (e.key === 'Escape' || e.key === 'Tab') {
+				e.preventDefault();
+				close(s);
+			}
+		}, true);
+		document.addEventListener('htmx:load', (e) => initAll(e.target));
+		window.shadcnSelect = { init: initAll };
+	}
+	window.shadcnSelect.init(document);
+})();
+`
@@ -0,0 +1,136 @@
+package selector_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/selector"
+	g "maragu.dev/gomponents"
+)
+
+func TestListbox(t *testing.T) {
+	tests := []struct {
+		name        string
+		sel         g.Node
+		contains    []string
+		notContains []string
+	}{
+		{
+			name: "selected option",
+			sel: selector.Listbox(selector.Props{
+				Name:  "fruit",
+				Value: "banana",
+				Options: []selector.OptionType{
+					{Value: "apple", Label: "Apple"},
+					{Value: "banana", Label: "Banana"},
+					{Value: "cherry", Label: "Cherry", Disabled: true},
+				},
+			}),
+			contains: []string{
+				`data-select=""`,
+				`<select class="flex w-full rounded-md border`,
+				`id="select-listbox-fruit" name="fruit"`,
+				`data-select-native=""`,
+				`role="combobox" aria-haspopup="listbox" aria-expanded="false" aria-controls="select-listbox-fruit-content"`,
+				`<span class="flex items-center gap-2" data-select-item-text="">Banana</span>`,
+				`id="select-listbox-fruit-content"`,
+				`data-floating=""`,
+				`data-side="bottom" data-align="start" data-side-offset="4"`,
+				`role="listbox" data-select-viewport="" data-roving=""`,
+				`id="select-listbox-fruit-option-1"`,
+				`data-value="banana" data-state="checked" aria-selected="true" tabindex="0"`,
+				`data-value="apple" data-state="unchecked" aria-selected="false" tabindex="-1"`,
+				`aria-disabled="true" data-disabled=""`,
+				`data-roving-label="Cherry"`,
+				`<input type="hidden" name="fruit" value="banana" data-select-input="" disabled>`,
+				`data-select-scroll="up"`,
+				`data-select-scroll="down"`,
+				`window.shadcnSelect`,
+				`window.shadcnFloating`,
+			},
+			notContains: []string{
+				`data-placeholder=""`,
+			},
+		},
+		{
+			name: "placeholder and required",
+			sel: selector.Listbox(selector.Props{
+				ID:          "country",
+				Name:        "country",
+				Placeholder: "Select a country",
+				Required:    true,
+				Options: []selector.OptionType{
+					{Value: "us", Label: "United States"},
+				},
+			}),
+			contains: []string{
+				`id="country" name="country" required`,
+				`data-placeholder="" aria-required="true"`,
+				`<span class="flex items-center gap-2 line-clamp-1" data-select-value="">Select a country</span>`,
+				`<input type="hidden" name="country" value="" data-select-input="" disabled>`,
+			},
+		},
+		{
+			name: "groups, icons and descriptions",
+			sel: selector.Listbox(selector.Props{
+				ID:   "plan",
+				Name: "plan",
+				Options: []selector.OptionType{
+					{Value: "free", Label: "Free"},
+				},
+				Groups: []selector.Group{
+					{
+						Label: "Paid",
+						Options: []selector.OptionType{
+							{Value: "pro", Label: "Pro", Description: "For small teams", Icon: icons.Users(), Selected: true},
+						},
+					},
+					{
+						Label: "Custom",
+						Options: []selector.OptionType{
+							{Value: "enterprise", Label: "Enterprise"},
+						},
+					},
+				},
+			}),
+			contains: []string{
+				`role="group" aria-labelledby="plan-group-0"`,
+				`<div id="plan-group-0" class="text-muted-foreground px-2 py-1.5 text-xs">Paid</div>`,
+				`role="separator"`,
+				`id="plan-option-2"`,
+				`<span class="text-muted-foreground text-xs">For small teams</span>`,
+				`data-value="pro" data-state="checked"`,
+				`value="pro" data-select-input=""`,
+				`<option value="pro" selected>Pro</option>`,
+			},
+		},
+		{
+			name: "disabled",
+			sel: selector.Listbox(selector.Props{
+				Name:     "size",
+				Disabled: true,
+				Options:  []selector.OptionType{{Value: "sm", Label: "Small"}},
+			}),
+			contains: []string{
+				`aria-controls="select-listbox-size-content" aria-autocomplete="none" data-select-trigger="" data-state="closed" data-placeholder="" disabled hidden`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := renderToString(tt.sel)
+			for _, want := range tt.contains {
+				if !strings.Contains(result, want) {
+					t.Errorf("expected to contain %q, got %s", want, result)
+				}
+			}
+			for _, notWant := range tt.notContains {
+				if strings.Contains(result, notWant) {
+					t.Errorf("expected not to contain %q", notWant)
+				}
+			}
+		})
+	}
+}
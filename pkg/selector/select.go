@@ -8,26 +8,29 @@ import (
 
 // Props defines the properties for the Select component
 type Props struct {
-	ID          string   // HTML id attribute
-	Name        string   // Form field name
-	Value       string   // Selected value
-	Placeholder string   // Placeholder text
+	ID          string       // HTML id attribute
+	Name        string       // Form field name
+	Value       string       // Selected value
+	Placeholder string       // Placeholder text
 	Options     []OptionType // Available options
-	Groups      []Group  // Grouped options
-	Disabled    bool     // Whether the select is disabled
-	Required    bool     // Whether the select is required
-	Multiple    bool     // Whether multiple selection is allowed
-	Size        string   // "sm" | "default" | "lg"
-	Class       string   // Additional custom classes
-	OnChange    string   // JavaScript onChange handler
+	Groups      []Group      // Grouped options
+	Disabled    bool         // Whether the select is disabled
+	Required    bool         // Whether the select is required
+	Multiple    bool         // Whether multiple selection is allowed
+	Size        string       // "sm" | "default" | "lg"
+	Class       string       // Additional custom classes
+	OnChange    string       // JavaScript onChange handler
+	Attrs       []g.Node     // Additional attributes to pass through
 }
 
 // Option defines a select option
 type OptionType struct {
-	Value    string
-	Label    string
-	Disabled bool
-	Selected bool
+	Value       string
+	Label       string
+	Disabled    bool
+	Selected    bool
+	Icon        g.Node // Icon shown before the label (Listbox only)
+	Description string // Secondary text below the label (Listbox only)
 }
 
 // Group defines a group of options
@@ -118,7 +121,7 @@ func New(props Props) g.Node {
 		children = append(children, html.OptGroup(groupChildren...))
 	}
 
-	return html.Select(append(attrs, children...)...)
+	return html.Select(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // renderOption renders a single option
@@ -255,7 +258,7 @@ func Custom(props Props) g.Node {
 		textColorClass,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(wrapperClasses),
 		// Visual trigger
 		html.Div(
@@ -276,5 +279,5 @@ func Custom(props Props) g.Node {
 		),
 		// Actual select (invisible)
 		New(selectProps),
-	)
+	}, props.Attrs)...)
 }
\ No newline at end of file
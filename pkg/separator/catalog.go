@@ -0,0 +1,37 @@
+package separator
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Separator",
+		Category:    "Layout",
+		Description: "Visually separates content",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Orientation", Type: catalog.Enum, Default: "horizontal", Options: []string{"horizontal", "vertical"}},
+			{Name: "Decorative", Type: catalog.Bool, Default: "true", Description: "Hides the separator from assistive technology"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return html.Div(
+				html.Class(lib.CNIf(v.String("Orientation") == "vertical", "flex h-5 items-center gap-4 text-sm", "w-64 space-y-4 text-sm")),
+				html.Span(g.Text("Blog")),
+				New(Props{Orientation: v.String("Orientation"), Decorative: v.Bool("Decorative")}),
+				html.Span(g.Text("Docs")),
+			)
+		},
+	})
+}
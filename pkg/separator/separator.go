@@ -8,9 +8,10 @@ import (
 
 // Props defines the properties for the Separator component
 type Props struct {
-	Orientation string // "horizontal" | "vertical"
-	Decorative  bool   // Whether the separator is decorative (true) or semantic (false)
-	Class       string // Additional custom classes
+	Orientation string   // "horizontal" | "vertical"
+	Decorative  bool     // Whether the separator is decorative (true) or semantic (false)
+	Class       string   // Additional custom classes
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Separator component
@@ -52,7 +53,7 @@ func New(props Props) g.Node {
 		attrs = append(attrs, g.Attr("aria-orientation", props.Orientation))
 	}
 
-	return html.Div(attrs...)
+	return html.Div(lib.MergeAttrs(attrs, props.Attrs)...)
 }
 
 // Horizontal creates a horizontal separator (default)
@@ -4,73 +4,93 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
 )
 
 // Props defines the properties for the Sheet component
 type Props struct {
-	Open      bool   // Whether the sheet is open
-	Side      string // "top" | "right" | "bottom" | "left"
-	Class     string // Additional custom classes
-	OnOpenChange string // JavaScript callback for open state changes
+	Open         bool        // Whether the sheet is open
+	Side         string      // "top" | "right" | "bottom" | "left"
+	Class        string      // Additional custom classes
+	OnOpenChange string      // JavaScript callback for open state changes
+	Modal        modal.Props // Focus, Escape and overlay dismissal behaviour
+	Attrs        []g.Node    // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for the Sheet trigger
 type TriggerProps struct {
-	AsChild bool   // Whether to render as child element
-	Class   string // Additional custom classes
+	AsChild bool     // Whether to render as child element
+	Class   string   // Additional custom classes
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines properties for the Sheet content
 type ContentProps struct {
-	Side             string // "top" | "right" | "bottom" | "left"
-	Class            string // Additional custom classes
-	ShowCloseButton  bool   // Whether to show the close button
-	CloseOnOverlay   bool   // Whether clicking overlay closes the sheet
-	CloseOnEsc       bool   // Whether ESC key closes the sheet
+	Side            string // "top" | "right" | "bottom" | "left"
+	Class           string // Additional custom classes
+	ShowCloseButton bool   // Whether to show the close button
+	LabelledBy      string // ID of the title (found automatically when empty)
+	DescribedBy     string // ID of the description (found automatically when empty)
+
+	// Deprecated: overlay clicks close the sheet by default; use Props.Modal.DisableOverlayClose.
+	CloseOnOverlay bool
+	// Deprecated: Escape closes the sheet by default; use Props.Modal.DisableEscape.
+	CloseOnEsc bool
+	Attrs      []g.Node // Additional attributes to pass through
 }
 
 // OverlayProps defines properties for the Sheet overlay
 type OverlayProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // HeaderProps defines properties for the Sheet header
 type HeaderProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TitleProps defines properties for the Sheet title
 type TitleProps struct {
-	Class string // Additional custom classes
+	ID    string   // Element ID, referenced by aria-labelledby
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // DescriptionProps defines properties for the Sheet description
 type DescriptionProps struct {
-	Class string // Additional custom classes
+	ID    string   // Element ID, referenced by aria-describedby
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // FooterProps defines properties for the Sheet footer
 type FooterProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // CloseProps defines properties for the close button
 type CloseProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Sheet container
 func New(props Props, children ...g.Node) g.Node {
 	if props.Open {
 		classes := lib.CN("fixed inset-0 z-50", props.Class)
-		return html.Div(
+		return html.Div(lib.MergeAttrs([]g.Node{
 			html.Class(classes),
 			g.Attr("data-state", "open"),
+			modal.Root(props.Modal),
 			g.Group(children),
-		)
+			modal.Script(),
+		}, props.Attrs)...)
 	}
 	// Return empty div when closed
-	return html.Div(g.Attr("data-state", "closed"))
+	return html.Div(lib.MergeAttrs([]g.Node{g.Attr("data-state", "closed")}, props.Attrs)...)
 }
 
 // Trigger creates a Sheet trigger element
@@ -83,12 +103,12 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 		return children[0]
 	}
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		g.Attr("aria-haspopup", "dialog"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Overlay creates the Sheet overlay/backdrop
@@ -100,11 +120,12 @@ func Overlay(props OverlayProps) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-sheet-overlay", ""),
 		g.Attr("data-state", "open"),
-	)
+		modal.Overlay(),
+	}, props.Attrs)...)
 }
 
 // Content creates the Sheet content
@@ -151,6 +172,7 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 			html.Type("button"),
 			html.Class("absolute right-4 top-4 rounded-sm opacity-70 ring-offset-background transition-opacity hover:opacity-100 focus:outline-none focus:ring-2 focus:ring-ring focus:ring-offset-2 disabled:pointer-events-none"),
 			g.Attr("aria-label", "Close"),
+			modal.Close(),
 			// X icon
 			g.El("svg",
 				g.Attr("xmlns", "http://www.w3.org/2000/svg"),
@@ -169,15 +191,14 @@ func ContentComponent(props ContentProps, children ...g.Node) g.Node {
 		contentChildren = append([]g.Node{closeButton}, children...)
 	}
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
-		html.Role("dialog"),
-		g.Attr("aria-modal", "true"),
+		modal.Content(modal.ContentProps{LabelledBy: props.LabelledBy, DescribedBy: props.DescribedBy}),
 		g.Attr("data-state", "open"),
 		g.Attr("data-sheet-content", ""),
 		g.If(props.Side != "", g.Attr("data-side", props.Side)),
 		g.Group(contentChildren),
-	)
+	}, props.Attrs)...)
 }
 
 // Header creates a Sheet header
@@ -187,10 +208,10 @@ func HeaderComponent(props HeaderProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Footer creates a Sheet footer
@@ -200,10 +221,10 @@ func FooterComponent(props FooterProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // TitleComponent creates a Sheet title
@@ -213,10 +234,12 @@ func TitleComponent(props TitleProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.H2(
+	return html.H2(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Title(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Description creates a Sheet description
@@ -226,21 +249,24 @@ func Description(props DescriptionProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.P(
+	return html.P(lib.MergeAttrs([]g.Node{
+		g.If(props.ID != "", html.ID(props.ID)),
 		html.Class(classes),
+		modal.Description(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Close creates a close button for the sheet
 func Close(props CloseProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
+		modal.Close(),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // WithForm creates a sheet with a form
@@ -8,6 +8,8 @@ import (
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
 	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/modal"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines HTMX-specific properties for the Sheet
@@ -22,29 +24,35 @@ type HTMXProps struct {
 func NewHTMX(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 	if props.Open {
 		classes := lib.CN("fixed inset-0 z-50", props.Class)
-		return html.Div(
+		modalProps := props.Modal
+		if modalProps.ClosePath == "" {
+			modalProps.ClosePath = htmxProps.ClosePath
+		}
+		return html.Div(lib.MergeAttrs([]g.Node{
 			html.ID(htmxProps.ID),
 			html.Class(classes),
 			g.Attr("data-state", "open"),
+			modal.Root(modalProps),
 			g.Group(children),
-		)
+			modal.Script(),
+		}, props.Attrs)...)
 	}
 	// Return empty div that can be replaced by HTMX
-	return html.Div(html.ID(htmxProps.ID), g.Attr("data-state", "closed"))
+	return html.Div(lib.MergeAttrs([]g.Node{html.ID(htmxProps.ID), g.Attr("data-state", "closed")}, props.Attrs)...)
 }
 
 // TriggerHTMX creates an HTMX-enhanced trigger
 func TriggerHTMX(props TriggerProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		hx.Get(htmxProps.TriggerPath),
 		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // OverlayHTMX creates an HTMX-enhanced overlay with close functionality
@@ -55,15 +63,16 @@ func OverlayHTMX(props OverlayProps, htmxProps HTMXProps) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("data-sheet-overlay", ""),
 		g.Attr("data-state", "open"),
+		modal.Overlay(),
 		hx.Get(htmxProps.ClosePath),
 		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
-		hx.Trigger("click"),
-	)
+		hx.Trigger("click[target === this]"),
+	}, props.Attrs)...)
 }
 
 // ContentHTMX creates HTMX-enhanced sheet content
@@ -119,35 +128,28 @@ func ContentHTMX(props ContentProps, htmxProps HTMXProps, children ...g.Node) g.
 		contentChildren = append([]g.Node{closeButton}, children...)
 	}
 	
-	// Prevent clicks inside content from closing sheet
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
-		html.Role("dialog"),
-		g.Attr("aria-modal", "true"),
+		modal.Content(modal.ContentProps{LabelledBy: props.LabelledBy, DescribedBy: props.DescribedBy}),
 		g.Attr("data-state", "open"),
 		g.Attr("data-sheet-content", ""),
 		g.If(props.Side != "", g.Attr("data-side", props.Side)),
-		hx.On("click", "event.stopPropagation()"),
-		// Add escape key handler
-		g.If(props.CloseOnEsc,
-			hx.On("keyup", "if(event.key === 'Escape') htmx.ajax('GET', '"+htmxProps.ClosePath+"', {target: '#"+htmxProps.ID+"', swap: 'outerHTML'})"),
-		),
 		g.Group(contentChildren),
-	)
+	}, props.Attrs)...)
 }
 
 // CloseHTMX creates an HTMX-enhanced close button
 func CloseHTMX(props CloseProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	classes := lib.CN(props.Class)
 	
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		html.Type("button"),
 		g.If(classes != "", html.Class(classes)),
 		hx.Get(htmxProps.ClosePath),
 		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // RenderOpenSheet renders an open sheet (for server response)
@@ -273,16 +275,19 @@ type NavItem struct {
 	Action string // HTMX action endpoint
 }
 
-// SheetHandlers creates HTTP handlers for sheet components
-func SheetHandlers(mux *http.ServeMux) {
+// Handlers serves the sheet demo endpoints
+type Handlers struct{}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Basic sheet example
 	basicProps := HTMXProps{
 		ID:          "sheet-basic",
-		TriggerPath: "/api/sheet/basic/open",
-		ClosePath:   "/api/sheet/basic/close",
+		TriggerPath: rt.Path("/api/sheet/basic/open"),
+		ClosePath:   rt.Path("/api/sheet/basic/close"),
 	}
 	
-	mux.HandleFunc("/api/sheet/basic/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/basic/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		content := html.Div(
 			HeaderComponent(
 				HeaderProps{},
@@ -321,21 +326,21 @@ func SheetHandlers(mux *http.ServeMux) {
 			content,
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/sheet/basic/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/basic/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedSheet(basicProps)
 		node.Render(w)
-	})
+	}))
 	
 	// Navigation sheet example
 	navProps := HTMXProps{
 		ID:          "sheet-nav",
-		TriggerPath: "/api/sheet/nav/open",
-		ClosePath:   "/api/sheet/nav/close",
+		TriggerPath: rt.Path("/api/sheet/nav/open"),
+		ClosePath:   rt.Path("/api/sheet/nav/close"),
 	}
 	
-	mux.HandleFunc("/api/sheet/nav/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/nav/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		items := []NavItem{
 			{Label: "Dashboard", Href: "/", Icon: icons.Home(html.Class("h-4 w-4")), Active: true},
 			{Label: "Orders", Href: "/orders", Icon: icons.Package(html.Class("h-4 w-4")), Badge: "3"},
@@ -351,24 +356,24 @@ func SheetHandlers(mux *http.ServeMux) {
 			NavigationSheetHTMX(navProps, items),
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/sheet/nav/close", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/nav/close", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderClosedSheet(navProps)
 		node.Render(w)
-	})
+	}))
 	
 	// Form sheet example
 	formProps := HTMXProps{
 		ID:          "sheet-form",
-		TriggerPath: "/api/sheet/form/open",
-		ClosePath:   "/api/sheet/form/close",
+		TriggerPath: rt.Path("/api/sheet/form/open"),
+		ClosePath:   rt.Path("/api/sheet/form/close"),
 	}
 	
-	mux.HandleFunc("/api/sheet/form/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/form/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := FormSheetHTMX(
 			formProps,
-			"/api/sheet/form/submit",
+			rt.Path("/api/sheet/form/submit"),
 			"Create New Product",
 			html.Div(html.Class("grid gap-4 py-4"),
 				html.Div(html.Class("grid gap-2"),
@@ -408,9 +413,9 @@ func SheetHandlers(mux *http.ServeMux) {
 			),
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/sheet/form/submit", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/sheet/form/submit", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		name := r.FormValue("name")
 		price := r.FormValue("price")
@@ -432,17 +437,17 @@ func SheetHandlers(mux *http.ServeMux) {
 			),
 		)
 		node.Render(w)
-	})
+	}))
 	
 	// Dynamic content sheet
 	dynamicProps := HTMXProps{
 		ID:          "sheet-dynamic",
-		TriggerPath: "/api/sheet/dynamic/open",
-		ClosePath:   "/api/sheet/dynamic/close",
-		ContentPath: "/api/sheet/dynamic/content",
+		TriggerPath: rt.Path("/api/sheet/dynamic/open"),
+		ClosePath:   rt.Path("/api/sheet/dynamic/close"),
+		ContentPath: rt.Path("/api/sheet/dynamic/content"),
 	}
 	
-	mux.HandleFunc("/api/sheet/dynamic/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/dynamic/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		node := RenderOpenSheet(
 			Props{},
 			ContentProps{Side: "right", ShowCloseButton: true},
@@ -450,9 +455,9 @@ func SheetHandlers(mux *http.ServeMux) {
 			DynamicContentSheetHTMX(ContentProps{}, dynamicProps),
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/sheet/dynamic/content", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/dynamic/content", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Simulate loading delay
 		// time.Sleep(500 * time.Millisecond)
 		
@@ -495,16 +500,16 @@ func SheetHandlers(mux *http.ServeMux) {
 			),
 		)
 		node.Render(w)
-	})
+	}))
 	
 	// Multi-step form sheet
 	multiStepProps := HTMXProps{
 		ID:          "sheet-multistep",
-		TriggerPath: "/api/sheet/multistep/open",
-		ClosePath:   "/api/sheet/multistep/close",
+		TriggerPath: rt.Path("/api/sheet/multistep/open"),
+		ClosePath:   rt.Path("/api/sheet/multistep/close"),
 	}
 	
-	mux.HandleFunc("/api/sheet/multistep/open", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, "/api/sheet/multistep/open", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		step := r.URL.Query().Get("step")
 		if step == "" {
 			step = "1"
@@ -520,7 +525,7 @@ func SheetHandlers(mux *http.ServeMux) {
 					Description(DescriptionProps{}, g.Text("Let's start with your basic details.")),
 				),
 				html.Form(
-					hx.Post("/api/sheet/multistep/next?step=2"),
+					hx.Post(rt.Path("/api/sheet/multistep/next?step=2")),
 					hx.Target("#" + multiStepProps.ID),
 					hx.Swap("outerHTML"),
 					html.Div(html.Class("grid gap-4 py-4"),
@@ -548,7 +553,7 @@ func SheetHandlers(mux *http.ServeMux) {
 					Description(DescriptionProps{}, g.Text("How can we reach you?")),
 				),
 				html.Form(
-					hx.Post("/api/sheet/multistep/next?step=3"),
+					hx.Post(rt.Path("/api/sheet/multistep/next?step=3")),
 					hx.Target("#" + multiStepProps.ID),
 					hx.Swap("outerHTML"),
 					html.Div(html.Class("grid gap-4 py-4"),
@@ -566,7 +571,7 @@ func SheetHandlers(mux *http.ServeMux) {
 						html.Button(
 							html.Type("button"),
 							html.Class("border mr-auto"),
-							hx.Get("/api/sheet/multistep/open?step=1"),
+							hx.Get(rt.Path("/api/sheet/multistep/open?step=1")),
 							hx.Target("#" + multiStepProps.ID),
 							hx.Swap("outerHTML"),
 							g.Text("Back"),
@@ -603,9 +608,9 @@ func SheetHandlers(mux *http.ServeMux) {
 			content,
 		)
 		node.Render(w)
-	})
+	}))
 	
-	mux.HandleFunc("/api/sheet/multistep/next", func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodPost, "/api/sheet/multistep/next", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		step := r.URL.Query().Get("step")
 		if step == "" {
 			step = "1"
@@ -615,8 +620,15 @@ func SheetHandlers(mux *http.ServeMux) {
 		r.ParseForm()
 		
 		// Redirect to the next step
-		http.Redirect(w, r, "/api/sheet/multistep/open?step="+step, http.StatusSeeOther)
-	})
+		http.Redirect(w, r, rt.Path("/api/sheet/multistep/open?step=")+step, http.StatusSeeOther)
+	}))
+}
+
+// SheetHandlers creates HTTP handlers for sheet components
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func SheetHandlers(mux *http.ServeMux) {
+	Handlers{}.Register(router.ServeMux(mux))
 }
 
 // Example creates an example sheet
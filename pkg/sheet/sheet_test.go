@@ -6,6 +6,7 @@ import (
 	
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -485,4 +486,11 @@ func TestMobileSheet(t *testing.T) {
 			t.Errorf("expected output to contain %q", want)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example":     Example,
+		"ExampleHTMX": ExampleHTMX,
+	})
+}
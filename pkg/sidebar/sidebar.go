@@ -21,7 +21,8 @@ type Props struct {
 	Collapsible string // "offcanvas" | "icon" | "none"
 	Class       string
 	ID          string
-	Open        bool   // Whether the sidebar is open (for static rendering)
+	Open        bool     // Whether the sidebar is open (for static rendering)
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // ProviderProps defines the properties for the sidebar provider
@@ -30,6 +31,8 @@ type ProviderProps struct {
 	Open        *bool
 	Class       string
 	Style       string
+	StatePath   string   // Endpoint that persists toggles (see CookieState.Handler)
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // Provider creates a sidebar provider wrapper
@@ -44,12 +47,13 @@ func Provider(props ProviderProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-wrapper", "true"),
 		g.Attr("style", style),
 		html.Class(classes),
 		g.Group(children),
-	)
+		stateScript(props.StatePath),
+	}, props.Attrs)...)
 }
 
 // New creates a new sidebar component
@@ -67,22 +71,24 @@ func New(props Props, children ...g.Node) g.Node {
 
 	// For non-collapsible sidebar
 	if props.Collapsible == "none" {
-		return html.Div(
+		return html.Div(lib.MergeAttrs([]g.Node{
 			g.Attr("data-sidebar", "true"),
 			html.Class(lib.CN(
 				"bg-sidebar text-sidebar-foreground flex h-full w-[var(--sidebar-width)] flex-col",
 				props.Class,
 			)),
 			g.Group(children),
-		)
+		}, props.Attrs)...)
 	}
 
 	// Main sidebar container
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class("group peer text-sidebar-foreground hidden md:block"),
 		g.Attr("data-sidebar", "true"),
+		g.Attr("data-state", lib.CNIf(props.Open, "expanded", "collapsed")),
 		g.Attr("data-variant", props.Variant),
 		g.Attr("data-side", props.Side),
+		g.Attr("data-collapsible-type", props.Collapsible),
 		// Only apply collapsible attribute when sidebar is collapsed
 		g.If(!props.Open && props.Collapsible != "none", g.Attr("data-collapsible", props.Collapsible)),
 		g.If(props.ID != "", g.Attr("id", props.ID)),
@@ -124,7 +130,7 @@ func New(props Props, children ...g.Node) g.Node {
 				g.Group(children),
 			),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a sidebar trigger button
@@ -139,17 +145,17 @@ func Trigger(props Props, children ...g.Node) g.Node {
 		buttonChildren = children
 	}
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-trigger", "true"),
 		g.Attr("type", "button"),
 		html.Class(lib.CN("size-7", props.Class)),
 		g.Group(buttonChildren),
-	)
+	}, props.Attrs)...)
 }
 
 // SidebarToggle creates a sidebar toggle button with chevron icon
 func SidebarToggle(props Props) g.Node {
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-toggle", "true"),
 		g.Attr("type", "button"),
 		html.Class(lib.CN(
@@ -160,12 +166,12 @@ func SidebarToggle(props Props) g.Node {
 		// Double chevron icon
 		g.Raw(`<svg width="15" height="15" viewBox="0 0 15 15" fill="none" xmlns="http://www.w3.org/2000/svg" class="h-4 w-4"><path d="M6.1584 3.13508C6.35985 2.94621 6.67627 2.95642 6.86514 3.15788L10.6151 7.15788C10.7954 7.3502 10.7954 7.64949 10.6151 7.84182L6.86514 11.8418C6.67627 12.0433 6.35985 12.0535 6.1584 11.8646C5.95694 11.6757 5.94673 11.3593 6.1356 11.1579L9.565 7.49985L6.1356 3.84182C5.94673 3.64036 5.95694 3.32394 6.1584 3.13508Z" fill="currentColor" fill-rule="evenodd" clip-rule="evenodd"></path><path d="M3.1584 3.13508C3.35985 2.94621 3.67627 2.95642 3.86514 3.15788L7.6151 7.15788C7.7954 7.3502 7.7954 7.64949 7.6151 7.84182L3.86514 11.8418C3.67627 12.0433 3.35985 12.0535 3.1584 11.8646C2.95694 11.6757 2.94673 11.3593 3.1356 11.1579L6.565 7.49985L3.1356 3.84182C2.94673 3.64036 2.95694 3.32394 3.1584 3.13508Z" fill="currentColor" fill-rule="evenodd" clip-rule="evenodd"></path></svg>`),
 		html.Span(html.Class("sr-only"), g.Text("Toggle Sidebar")),
-	)
+	}, props.Attrs)...)
 }
 
 // Rail creates a sidebar rail (drag handle)
 func Rail(props Props) g.Node {
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-rail", "true"),
 		g.Attr("aria-label", "Toggle Sidebar"),
 		g.Attr("tabindex", "-1"),
@@ -179,81 +185,81 @@ func Rail(props Props) g.Node {
 			"[[data-side=right][data-collapsible=offcanvas]_&]:-left-2",
 			props.Class,
 		)),
-	)
+	}, props.Attrs)...)
 }
 
 // Inset creates a sidebar inset (main content area)
 func Inset(props Props, children ...g.Node) g.Node {
 	return g.El("main",
-		g.Attr("data-sidebar-inset", "true"),
+		lib.MergeAttrs([]g.Node{g.Attr("data-sidebar-inset", "true"),
 		html.Class(lib.CN(
 			"bg-background relative flex w-full flex-1 flex-col",
 			"md:peer-data-[variant=inset]:m-2 md:peer-data-[variant=inset]:ml-0 md:peer-data-[variant=inset]:rounded-xl md:peer-data-[variant=inset]:shadow-sm md:peer-data-[variant=inset]:peer-data-[state=collapsed]:ml-2",
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Header creates a sidebar header
 func HeaderComponent(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-header", "true"),
 		html.Class(lib.CN("flex flex-col gap-2 p-2", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Content creates a sidebar content area
 func ContentComponent(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-content", "true"),
 		html.Class(lib.CN(
 			"flex min-h-0 flex-1 flex-col gap-2 overflow-auto group-data-[collapsible=icon]:overflow-hidden",
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Footer creates a sidebar footer
 func FooterComponent(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-footer", "true"),
 		html.Class(lib.CN("flex flex-col gap-2 p-2", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Separator creates a sidebar separator
 func Separator(props Props) g.Node {
-	return html.Hr(
+	return html.Hr(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-separator", "true"),
 		html.Class(lib.CN("bg-sidebar-border mx-2 w-auto", props.Class)),
-	)
+	}, props.Attrs)...)
 }
 
 // Input creates a sidebar input
 func Input(props Props) g.Node {
 	return g.El("input",
-		g.Attr("data-sidebar-input", "true"),
+		lib.MergeAttrs([]g.Node{g.Attr("data-sidebar-input", "true"),
 		g.Attr("type", "text"),
 		html.Class(lib.CN("bg-background h-8 w-full shadow-none", props.Class)),
-	)
+	}, props.Attrs)...)
 }
 
 // Group creates a sidebar group
 func Group(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-group", "true"),
 		html.Class(lib.CN("relative flex w-full min-w-0 flex-col p-2", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // GroupLabel creates a sidebar group label
 func GroupLabel(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-group-label", "true"),
 		html.Class(lib.CN(
 			"text-sidebar-foreground/70 ring-sidebar-ring flex h-8 shrink-0 items-center rounded-md px-2 text-xs font-medium outline-none transition-[margin,opacity] duration-200 ease-linear focus-visible:ring-2 [&>svg]:size-4 [&>svg]:shrink-0",
@@ -261,12 +267,12 @@ func GroupLabel(props Props, children ...g.Node) g.Node {
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // GroupAction creates a sidebar group action button
 func GroupAction(props Props, children ...g.Node) g.Node {
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-group-action", "true"),
 		g.Attr("type", "button"),
 		html.Class(lib.CN(
@@ -276,34 +282,34 @@ func GroupAction(props Props, children ...g.Node) g.Node {
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // GroupContent creates a sidebar group content area
 func GroupContent(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-group-content", "true"),
 		html.Class(lib.CN("w-full text-sm", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Menu creates a sidebar menu list
 func Menu(props Props, children ...g.Node) g.Node {
-	return html.Ul(
+	return html.Ul(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-menu", "true"),
 		html.Class(lib.CN("flex w-full min-w-0 flex-col gap-1", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // MenuItem creates a sidebar menu item
 func MenuItem(props Props, children ...g.Node) g.Node {
-	return html.Li(
+	return html.Li(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-menu-item", "true"),
 		html.Class(lib.CN("group/menu-item relative", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // MenuButtonProps defines properties for menu buttons
@@ -314,6 +320,7 @@ type MenuButtonProps struct {
 	Tooltip  string
 	Href     string
 	Class    string
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 var menuButtonVariants = lib.VariantConfig{
@@ -370,13 +377,13 @@ func MenuButton(props MenuButtonProps, children ...g.Node) g.Node {
 	}
 
 	return g.El(elem,
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
 // MenuAction creates a sidebar menu action button
 func MenuAction(props Props, children ...g.Node) g.Node {
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-menu-action", "true"),
 		g.Attr("type", "button"),
 		html.Class(lib.CN(
@@ -389,12 +396,12 @@ func MenuAction(props Props, children ...g.Node) g.Node {
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // MenuBadge creates a sidebar menu badge
 func MenuBadge(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-menu-badge", "true"),
 		html.Class(lib.CN(
 			"text-sidebar-foreground pointer-events-none absolute right-1 flex h-5 min-w-5 items-center justify-center rounded-md px-1 text-xs font-medium tabular-nums select-none",
@@ -406,7 +413,7 @@ func MenuBadge(props Props, children ...g.Node) g.Node {
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // MenuSkeleton creates a sidebar menu skeleton loader
@@ -429,16 +436,16 @@ func MenuSkeleton(props Props, showIcon bool) g.Node {
 		),
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-menu-skeleton", "true"),
 		html.Class(lib.CN("flex h-8 items-center gap-2 rounded-md px-2", props.Class)),
 		g.Group(skeletonItems),
-	)
+	}, props.Attrs)...)
 }
 
 // MenuSub creates a sidebar submenu
 func MenuSub(props Props, children ...g.Node) g.Node {
-	return html.Ul(
+	return html.Ul(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-menu-sub", "true"),
 		html.Class(lib.CN(
 			"border-sidebar-border mx-3.5 flex min-w-0 translate-x-px flex-col gap-1 border-l px-2.5 py-0.5",
@@ -446,16 +453,16 @@ func MenuSub(props Props, children ...g.Node) g.Node {
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // MenuSubItem creates a sidebar submenu item
 func MenuSubItem(props Props, children ...g.Node) g.Node {
-	return html.Li(
+	return html.Li(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-menu-sub-item", "true"),
 		html.Class(lib.CN("group/menu-sub-item relative", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // MenuSubButtonProps defines properties for submenu buttons
@@ -464,6 +471,7 @@ type MenuSubButtonProps struct {
 	IsActive bool
 	Href     string
 	Class    string
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // MenuSubButton creates a sidebar submenu button
@@ -497,6 +505,6 @@ func MenuSubButton(props MenuSubButtonProps, children ...g.Node) g.Node {
 	}
 
 	return g.El(elem,
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
\ No newline at end of file
@@ -8,6 +8,7 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines properties for HTMX-enhanced sidebar
@@ -17,9 +18,26 @@ type HTMXProps struct {
 	StatePath       string
 	MobileTogglePath string
 	DefaultOpen     bool
+	State           CookieState // Cookie used to persist the open state
 }
 
-// HTMXProvider creates an HTMX-enhanced sidebar provider
+// cookieState returns the configured cookie state, falling back to DefaultOpen
+func (p HTMXProps) cookieState() CookieState {
+	state := p.State
+	if !state.DefaultOpen {
+		state.DefaultOpen = p.DefaultOpen
+	}
+	return state
+}
+
+// StateFromRequest reports whether the sidebar should render open for r
+func (p HTMXProps) StateFromRequest(r *http.Request) bool {
+	return p.cookieState().StateFromRequest(r)
+}
+
+// HTMXProvider creates an HTMX-enhanced sidebar provider. Render the sidebar
+// inside it with the state from HTMXProps.StateFromRequest so the first paint
+// already matches the persisted layout.
 func HTMXProvider(props ProviderProps, htmxProps HTMXProps, children ...g.Node) g.Node {
 	style := fmt.Sprintf("--sidebar-width: %s; --sidebar-width-icon: %s;", SidebarWidth, SidebarWidthIcon)
 	if props.Style != "" {
@@ -31,17 +49,14 @@ func HTMXProvider(props ProviderProps, htmxProps HTMXProps, children ...g.Node)
 		props.Class,
 	)
 
-	return html.Div(
-		g.Attr("id", htmxProps.ID),
+	return html.Div(lib.MergeAttrs([]g.Node{
+		g.If(htmxProps.ID != "", g.Attr("id", htmxProps.ID+"-wrapper")),
 		g.Attr("data-sidebar-wrapper", "true"),
 		g.Attr("style", style),
 		html.Class(classes),
-		hx.Get(htmxProps.StatePath),
-		hx.Trigger("load"),
-		hx.Target("this"),
-		hx.Swap("outerHTML"),
 		g.Group(children),
-	)
+		stateScript(""),
+	}, props.Attrs)...)
 }
 
 // HTMXSidebar creates an HTMX-enhanced sidebar
@@ -64,23 +79,24 @@ func HTMXSidebar(props Props, htmxProps HTMXProps, isOpen bool, children ...g.No
 
 	// For non-collapsible sidebar
 	if props.Collapsible == "none" {
-		return html.Div(
+		return html.Div(lib.MergeAttrs([]g.Node{
 			g.Attr("data-sidebar", "true"),
 			html.Class(lib.CN(
 				"bg-sidebar text-sidebar-foreground flex h-full w-[var(--sidebar-width)] flex-col",
 				props.Class,
 			)),
 			g.Group(children),
-		)
+		}, props.Attrs)...)
 	}
 
 	// Main sidebar container
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class("group peer text-sidebar-foreground hidden md:block"),
 		g.Attr("data-sidebar", "true"),
 		g.Attr("data-state", state),
 		g.Attr("data-variant", props.Variant),
 		g.Attr("data-side", props.Side),
+		g.Attr("data-collapsible-type", props.Collapsible),
 		g.Attr("data-collapsible", lib.CNIf(state == "collapsed", props.Collapsible, "")),
 		g.If(htmxProps.ID != "", g.Attr("id", htmxProps.ID)),
 		
@@ -121,7 +137,7 @@ func HTMXSidebar(props Props, htmxProps HTMXProps, isOpen bool, children ...g.No
 				g.Group(children),
 			),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // HTMXTrigger creates an HTMX-enhanced sidebar trigger
@@ -136,7 +152,7 @@ func HTMXTrigger(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 		buttonChildren = children
 	}
 
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-trigger", "true"),
 		g.Attr("type", "button"),
 		html.Class(lib.CN("size-7", props.Class)),
@@ -144,12 +160,12 @@ func HTMXTrigger(props Props, htmxProps HTMXProps, children ...g.Node) g.Node {
 		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
 		g.Group(buttonChildren),
-	)
+	}, props.Attrs)...)
 }
 
 // HTMXRail creates an HTMX-enhanced sidebar rail
 func HTMXRail(props Props, htmxProps HTMXProps) g.Node {
-	return html.Button(
+	return html.Button(lib.MergeAttrs([]g.Node{
 		g.Attr("data-sidebar-rail", "true"),
 		g.Attr("aria-label", "Toggle Sidebar"),
 		g.Attr("tabindex", "-1"),
@@ -166,7 +182,7 @@ func HTMXRail(props Props, htmxProps HTMXProps) g.Node {
 		hx.Post(htmxProps.TogglePath),
 		hx.Target("#" + htmxProps.ID),
 		hx.Swap("outerHTML"),
-	)
+	}, props.Attrs)...)
 }
 
 // MobileSheet creates a mobile sidebar sheet overlay
@@ -175,7 +191,7 @@ func MobileSheet(props Props, htmxProps HTMXProps, isOpen bool, children ...g.No
 		return nil
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class("fixed inset-0 z-50 md:hidden"),
 		// Backdrop
 		html.Div(
@@ -209,87 +225,80 @@ func MobileSheet(props Props, htmxProps HTMXProps, isOpen bool, children ...g.No
 				g.Group(children),
 			),
 		),
-	)
+	}, props.Attrs)...)
 }
 
-// Server-side state management
-var sidebarStates = make(map[string]bool)
+// Handlers serves the toggle, state and mobile toggle endpoints of a sidebar.
+// The open state is kept in a signed cookie (see HTMXProps.State), so every user
+// sees the layout they last chose. The paths in HTMX are relative to the router
+// the handlers are registered on.
+type Handlers struct {
+	Props Props     // Sidebar appearance
+	HTMX  HTMXProps // Sidebar ID, endpoint paths and state cookie
+}
 
-// SidebarHandlers creates HTTP handlers for sidebar functionality
-func SidebarHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Validate required paths
-	if htmxProps.TogglePath == "" {
-		panic("SidebarHandlers: TogglePath is required")
+	if h.HTMX.TogglePath == "" {
+		panic("sidebar.Handlers: TogglePath is required")
 	}
-	if htmxProps.StatePath == "" {
-		panic("SidebarHandlers: StatePath is required")
+	if h.HTMX.StatePath == "" {
+		panic("sidebar.Handlers: StatePath is required")
 	}
 
-	// Initialize state
-	if _, exists := sidebarStates[htmxProps.ID]; !exists {
-		sidebarStates[htmxProps.ID] = htmxProps.DefaultOpen
+	baseProps := h.Props
+	htmxProps := h.HTMX
+	htmxProps.TogglePath = rt.Path(h.HTMX.TogglePath)
+	htmxProps.StatePath = rt.Path(h.HTMX.StatePath)
+	if h.HTMX.MobileTogglePath != "" {
+		htmxProps.MobileTogglePath = rt.Path(h.HTMX.MobileTogglePath)
 	}
 
-	// Toggle handler
-	mux.HandleFunc(htmxProps.TogglePath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+	state := htmxProps.cookieState()
 
+	// Toggle handler
+	rt.Handle(http.MethodPost, h.HTMX.TogglePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Toggle state
-		sidebarStates[htmxProps.ID] = !sidebarStates[htmxProps.ID]
+		isOpen := !state.StateFromRequest(r)
+		state.WriteState(w, isOpen)
 
 		// Return updated sidebar
-		sidebar := HTMXSidebar(baseProps, htmxProps, sidebarStates[htmxProps.ID], 
+		sidebar := HTMXSidebar(baseProps, htmxProps, isOpen,
 			// You would pass the actual content here
 			HeaderComponent(Props{}, g.Text("Sidebar Header")),
 			ContentComponent(Props{}, g.Text("Sidebar Content")),
 			FooterComponent(Props{}, g.Text("Sidebar Footer")),
 		)
-		
-		sidebar.Render(w)
-	})
 
-	// State handler (for initial load)
-	mux.HandleFunc(htmxProps.StatePath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+		sidebar.Render(w)
+	}))
 
+	// State handler returns the sidebar for the persisted state
+	rt.Handle(http.MethodGet, h.HTMX.StatePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Return current state sidebar
-		sidebar := HTMXSidebar(baseProps, htmxProps, sidebarStates[htmxProps.ID],
+		sidebar := HTMXSidebar(baseProps, htmxProps, state.StateFromRequest(r),
 			// You would pass the actual content here
 			HeaderComponent(Props{}, g.Text("Sidebar Header")),
 			ContentComponent(Props{}, g.Text("Sidebar Content")),
 			FooterComponent(Props{}, g.Text("Sidebar Footer")),
 		)
-		
+
 		sidebar.Render(w)
-	})
+	}))
 
 	// Mobile toggle handler
 	if htmxProps.MobileTogglePath != "" {
 		mobileID := htmxProps.ID + "-mobile"
-		mux.HandleFunc(htmxProps.MobileTogglePath, func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodPost {
-				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-				return
-			}
-
+		rt.Handle(http.MethodPost, h.HTMX.MobileTogglePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			close := r.URL.Query().Get("close") == "true"
 			
 			if close {
 				// Return empty div to close
 				html.Div(g.Attr("id", mobileID)).Render(w)
 			} else {
-				// Toggle mobile state
-				isOpen := !close
-				if _, exists := sidebarStates[mobileID]; exists {
-					isOpen = !sidebarStates[mobileID]
-				}
-				sidebarStates[mobileID] = isOpen
+				// The mobile sheet is transient and always closes via ?close=true
+				isOpen := true
 
 				// Return mobile sheet
 				sheet := html.Div(
@@ -304,6 +313,15 @@ func SidebarHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
 				
 				sheet.Render(w)
 			}
-		})
+		}))
 	}
-}
\ No newline at end of file
+}
+
+// SidebarHandlers creates HTTP handlers for sidebar functionality. The open
+// state is kept in a signed cookie (see HTMXProps.State), so every user sees
+// the layout they last chose.
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func SidebarHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+	Handlers{Props: baseProps, HTMX: htmxProps}.Register(router.ServeMux(mux))
+}
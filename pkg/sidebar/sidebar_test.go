@@ -2,10 +2,14 @@ package sidebar
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -390,4 +394,103 @@ func TestSubMenu(t *testing.T) {
 			t.Errorf("Expected output to contain %q, but it didn't. Got:\n%s", exp, result)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestCookieState(t *testing.T) {
+	state := CookieState{Signer: lib.NewCookieSigner([]byte("secret")), DefaultOpen: true}
+
+	t.Run("defaults without cookie", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if !state.StateFromRequest(req) {
+			t.Error("Expected default open state without a cookie")
+		}
+	})
+
+	t.Run("round trips through the cookie", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		state.WriteState(rec, false)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, c := range rec.Result().Cookies() {
+			if c.Name != StateCookieName {
+				t.Errorf("Expected cookie %q, got %q", StateCookieName, c.Name)
+			}
+			req.AddCookie(c)
+		}
+		if state.StateFromRequest(req) {
+			t.Error("Expected closed state from cookie")
+		}
+	})
+
+	t.Run("ignores unsigned cookie", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: StateCookieName, Value: "false"})
+		if !state.StateFromRequest(req) {
+			t.Error("Expected unsigned cookie to be ignored")
+		}
+	})
+}
+
+func TestSidebarHandlersToggle(t *testing.T) {
+	mux := http.NewServeMux()
+	htmxProps := HTMXProps{
+		ID:          "test-sidebar",
+		TogglePath:  "/sidebar/toggle",
+		StatePath:   "/sidebar/state",
+		DefaultOpen: true,
+	}
+	SidebarHandlers(mux, Props{}, htmxProps)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/sidebar/toggle", nil))
+	if !strings.Contains(rec.Body.String(), `data-state="collapsed"`) {
+		t.Fatalf("Expected first toggle to collapse the sidebar, got:\n%s", rec.Body.String())
+	}
+
+	// The next request carries the cookie and renders the persisted state
+	req := httptest.NewRequest(http.MethodGet, "/sidebar/state", nil)
+	for _, c := range rec.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if !strings.Contains(rec.Body.String(), `data-state="collapsed"`) {
+		t.Errorf("Expected persisted collapsed state, got:\n%s", rec.Body.String())
+	}
+}
+
+func TestProviderKeyboardShortcut(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Provider(ProviderProps{StatePath: "/sidebar/persist"}).Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	result := buf.String()
+	for _, expected := range []string{`const persistPath = "/sidebar/persist";`, "e.metaKey || e.ctrlKey", "'b'"} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("Expected output to contain %q", expected)
+		}
+	}
+}
+
+func TestProviderStatePathEscaped(t *testing.T) {
+	var buf bytes.Buffer
+	path := `/state?x=';alert(1)//</script><script>`
+	if err := Provider(ProviderProps{StatePath: path}).Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	result := buf.String()
+	if !strings.Contains(result, `const persistPath = "/state?x=';alert(1)//\u003c/script\u003e\u003cscript\u003e";`) {
+		t.Errorf("Expected the state path to be encoded as a JS string, got %s", result)
+	}
+	if strings.Count(result, "</script>") != 1 {
+		t.Errorf("Expected the state path not to close the script, got %s", result)
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Examples": Examples,
+	})
+}
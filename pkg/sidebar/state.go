@@ -0,0 +1,148 @@
+package sidebar
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+const (
+	// StateCookieName matches the cookie name used by shadcn/ui
+	StateCookieName = "sidebar_state"
+	// StateCookieMaxAge is how long the sidebar state is remembered
+	StateCookieMaxAge = 7 * 24 * time.Hour
+	// KeyboardShortcut toggles the sidebar together with Ctrl or Cmd
+	KeyboardShortcut = "b"
+)
+
+// CookieState persists the open/closed state of a sidebar in a signed cookie.
+// The zero value uses StateCookieName and lib.DefaultCookieSigner.
+type CookieState struct {
+	Name        string            // Cookie name (defaults to StateCookieName)
+	Signer      *lib.CookieSigner // Signer (defaults to lib.DefaultCookieSigner)
+	MaxAge      time.Duration     // Cookie lifetime (defaults to StateCookieMaxAge)
+	DefaultOpen bool              // State used when no valid cookie is present
+}
+
+// StateFromRequest reports whether the sidebar was last left open, using the
+// default cookie settings and defaulting to open
+func StateFromRequest(r *http.Request) bool {
+	return CookieState{DefaultOpen: true}.StateFromRequest(r)
+}
+
+// WriteState stores the sidebar state using the default cookie settings
+func WriteState(w http.ResponseWriter, open bool) {
+	CookieState{}.WriteState(w, open)
+}
+
+// StateFromRequest reads the sidebar state from the request cookie
+func (s CookieState) StateFromRequest(r *http.Request) bool {
+	value, ok := s.signer().Cookie(r, s.name())
+	if !ok {
+		return s.DefaultOpen
+	}
+	open, err := strconv.ParseBool(value)
+	if err != nil {
+		return s.DefaultOpen
+	}
+	return open
+}
+
+// WriteState stores the sidebar state in the response cookie
+func (s CookieState) WriteState(w http.ResponseWriter, open bool) {
+	maxAge := s.MaxAge
+	if maxAge == 0 {
+		maxAge = StateCookieMaxAge
+	}
+	s.signer().SetCookie(w, s.name(), strconv.FormatBool(open), maxAge)
+}
+
+// Handler returns a handler that persists the state posted by the sidebar
+// script. It expects an "open" form value of "true" or "false".
+func (s CookieState) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		open, err := strconv.ParseBool(r.FormValue("open"))
+		if err != nil {
+			http.Error(w, "Invalid state", http.StatusBadRequest)
+			return
+		}
+
+		s.WriteState(w, open)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s CookieState) name() string {
+	if s.Name == "" {
+		return StateCookieName
+	}
+	return s.Name
+}
+
+func (s CookieState) signer() *lib.CookieSigner {
+	if s.Signer == nil {
+		return lib.DefaultCookieSigner
+	}
+	return s.Signer
+}
+
+// stateScript wires the trigger buttons and the Ctrl/Cmd+B shortcut for the
+// sidebar inside the current wrapper. Triggers that carry hx-post are left to
+// HTMX; the shortcut simply clicks them so the server toggles the cookie.
+func stateScript(persistPath string) g.Node {
+	// json.Marshal quotes the path as a JS string and escapes <, > and &, so
+	// it cannot end the script element
+	path, _ := json.Marshal(persistPath)
+	return html.Script(g.Raw(fmt.Sprintf(`
+	(function() {
+		const wrapper = document.currentScript.closest('[data-sidebar-wrapper]');
+		if (!wrapper || wrapper.dataset.sidebarBound) return;
+		wrapper.dataset.sidebarBound = 'true';
+		const persistPath = %s;
+
+		function toggle() {
+			const sidebar = wrapper.querySelector('[data-sidebar][data-collapsible-type]');
+			if (!sidebar) return;
+			const open = sidebar.dataset.state !== 'expanded';
+			sidebar.dataset.state = open ? 'expanded' : 'collapsed';
+			if (open) {
+				sidebar.removeAttribute('data-collapsible');
+			} else {
+				sidebar.dataset.collapsible = sidebar.dataset.collapsibleType;
+			}
+			if (persistPath) {
+				const body = new URLSearchParams({ open: String(open) });
+				fetch(persistPath, { method: 'POST', body: body, credentials: 'same-origin' });
+			}
+		}
+
+		wrapper.addEventListener('click', (e) => {
+			const trigger = e.target.closest('[data-sidebar-trigger], [data-sidebar-rail], [data-sidebar-toggle]');
+			if (!trigger || !wrapper.contains(trigger) || trigger.hasAttribute('hx-post')) return;
+			toggle();
+		});
+
+		document.addEventListener('keydown', (e) => {
+			if (e.key !== '%s' || !(e.metaKey || e.ctrlKey)) return;
+			e.preventDefault();
+			const trigger = wrapper.querySelector('[data-sidebar-trigger], [data-sidebar-rail]');
+			if (trigger) {
+				trigger.click();
+			} else {
+				toggle();
+			}
+		});
+	})();
+	`, path, KeyboardShortcut)))
+}
@@ -8,7 +8,8 @@ import (
 
 // Props defines the properties for the Skeleton component
 type Props struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Skeleton component
@@ -18,10 +19,10 @@ func New(props Props) g.Node {
 		props.Class,
 	)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		g.Attr("aria-hidden", "true"), // Hide from screen readers as it's decorative
-	)
+	}, props.Attrs)...)
 }
 
 // Default creates a default skeleton
@@ -1,6 +1,8 @@
 package slider
 
 import (
+	"fmt"
+
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 )
@@ -24,7 +26,7 @@ func Examples() g.Node {
 					Min:   0,
 					Max:   100,
 					Step:  1,
-					Value: []int{50},
+					Value: []float64{50},
 				}, HTMXProps{
 					ID:         "demo-htmx-slider",
 					UpdatePath: "/htmx/slider/update",
@@ -40,7 +42,7 @@ func Examples() g.Node {
 						Min:   0,
 						Max:   100,
 						Step:  5,
-						Value: []int{25, 75},
+						Value: []float64{25, 75},
 					}, HTMXProps{
 						ID:         "demo-htmx-range",
 						UpdatePath: "/htmx/slider-range/update",
@@ -61,7 +63,7 @@ func Examples() g.Node {
 				html.Div(
 					html.Class("space-y-2"),
 					html.H4(html.Class("text-sm font-medium"), g.Text("Default")),
-					New(Props{Value: []int{50}}),
+					New(Props{Value: []float64{50}}),
 				),
 				
 				// With custom range
@@ -71,7 +73,7 @@ func Examples() g.Node {
 					New(Props{
 						Min:   0,
 						Max:   10,
-						Value: []int{5},
+						Value: []float64{5},
 					}),
 				),
 				
@@ -83,7 +85,7 @@ func Examples() g.Node {
 						Min:   0,
 						Max:   100,
 						Step:  10,
-						Value: []int{30},
+						Value: []float64{30},
 					}),
 				),
 			),
@@ -100,7 +102,7 @@ func Examples() g.Node {
 					html.Class("space-y-2"),
 					html.H4(html.Class("text-sm font-medium"), g.Text("Basic Range")),
 					Range(Props{
-						Value: []int{25, 75},
+						Value: []float64{25, 75},
 					}),
 				),
 				
@@ -112,7 +114,7 @@ func Examples() g.Node {
 						Min:   -10,
 						Max:   40,
 						Step:  1,
-						Value: []int{18, 24},
+						Value: []float64{18, 24},
 					}),
 				),
 			),
@@ -129,7 +131,7 @@ func Examples() g.Node {
 					html.Class("flex-1 space-y-2"),
 					html.H4(html.Class("text-sm font-medium"), g.Text("Horizontal (Default)")),
 					New(Props{
-						Value: []int{60},
+						Value: []float64{60},
 					}),
 				),
 				
@@ -140,7 +142,7 @@ func Examples() g.Node {
 					html.Div(
 						html.Class("h-48 flex justify-center"),
 						Vertical(Props{
-							Value: []int{60},
+							Value: []float64{60},
 						}),
 					),
 				),
@@ -160,7 +162,7 @@ func Examples() g.Node {
 					WithLabels(Props{
 						Min:   0,
 						Max:   100,
-						Value: []int{40},
+						Value: []float64{40},
 					}),
 				),
 				
@@ -172,7 +174,7 @@ func Examples() g.Node {
 						Min:   0,
 						Max:   100,
 						Step:  5,
-						Value: []int{65},
+						Value: []float64{65},
 					}),
 				),
 			),
@@ -186,13 +188,13 @@ func Examples() g.Node {
 				html.Class("space-y-4 max-w-md"),
 				// Single value
 				WithValue(Props{
-					Value: []int{33},
+					Value: []float64{33},
 					ID:    "value-single",
 				}),
 				
 				// Range value
 				WithValue(Props{
-					Value: []int{20, 80},
+					Value: []float64{20, 80},
 					ID:    "value-range",
 				}),
 			),
@@ -209,7 +211,7 @@ func Examples() g.Node {
 					html.Class("space-y-2 pb-4"),
 					html.H4(html.Class("text-sm font-medium"), g.Text("5 Tick Marks")),
 					WithTicks(Props{
-						Value: []int{60},
+						Value: []float64{60},
 					}, 5),
 				),
 				
@@ -221,7 +223,7 @@ func Examples() g.Node {
 						Min:   0,
 						Max:   100,
 						Step:  10,
-						Value: []int{70},
+						Value: []float64{70},
 					}, 11),
 				),
 			),
@@ -238,7 +240,7 @@ func Examples() g.Node {
 					html.Class("space-y-2"),
 					html.H4(html.Class("text-sm font-medium"), g.Text("Disabled")),
 					New(Props{
-						Value:    []int{50},
+						Value:    []float64{50},
 						Disabled: true,
 					}),
 				),
@@ -248,7 +250,7 @@ func Examples() g.Node {
 					html.Class("space-y-2"),
 					html.H4(html.Class("text-sm font-medium"), g.Text("Disabled Range")),
 					Range(Props{
-						Value:    []int{30, 70},
+						Value:    []float64{30, 70},
 						Disabled: true,
 					}),
 				),
@@ -271,7 +273,7 @@ func Examples() g.Node {
 					New(Props{
 						ID:    "brightness",
 						Name:  "brightness",
-						Value: []int{75},
+						Value: []float64{75},
 					}),
 				),
 				
@@ -284,7 +286,24 @@ func Examples() g.Node {
 						Min:   0,
 						Max:   1000,
 						Step:  50,
-						Value: []int{200, 600},
+						Value: []float64{200, 600},
+					}),
+				),
+
+				// Decimal range with a named input per thumb
+				html.Div(
+					html.Class("space-y-2"),
+					html.Label(g.Text("Unit Price")),
+					WithLabels(Props{
+						Names:       []string{"min_price", "max_price"},
+						Min:         0.5,
+						Max:         99.99,
+						Step:        0.01,
+						Value:       []float64{9.99, 49.5},
+						MinDistance: 5,
+						Format:      func(v float64) string { return fmt.Sprintf("$%.2f", v) },
+						ThumbLabels: true,
+						Class:       "mt-8",
 					}),
 				),
 				
@@ -315,7 +334,7 @@ func Examples() g.Node {
 							html.Div(html.Class("flex-1"),
 								New(Props{
 									Max:   255,
-									Value: []int{128},
+									Value: []float64{128},
 									Class: "[&_[data-slider-range]]:bg-red-500",
 								}),
 							),
@@ -328,7 +347,7 @@ func Examples() g.Node {
 							html.Div(html.Class("flex-1"),
 								New(Props{
 									Max:   255,
-									Value: []int{200},
+									Value: []float64{200},
 									Class: "[&_[data-slider-range]]:bg-green-500",
 								}),
 							),
@@ -341,7 +360,7 @@ func Examples() g.Node {
 							html.Div(html.Class("flex-1"),
 								New(Props{
 									Max:   255,
-									Value: []int{64},
+									Value: []float64{64},
 									Class: "[&_[data-slider-range]]:bg-blue-500",
 								}),
 							),
@@ -369,7 +388,7 @@ func Examples() g.Node {
 								html.Div(html.Class("h-32 flex justify-center"),
 									Vertical(Props{
 										Max:   100,
-										Value: []int{75},
+										Value: []float64{75},
 									}),
 								),
 								html.Span(html.Class("text-xs text-muted-foreground"), g.Text("75%")),
@@ -380,7 +399,7 @@ func Examples() g.Node {
 								html.Div(html.Class("h-32 flex justify-center"),
 									Vertical(Props{
 										Max:   100,
-										Value: []int{60},
+										Value: []float64{60},
 									}),
 								),
 								html.Span(html.Class("text-xs text-muted-foreground"), g.Text("60%")),
@@ -391,7 +410,7 @@ func Examples() g.Node {
 								html.Div(html.Class("h-32 flex justify-center"),
 									Vertical(Props{
 										Max:   100,
-										Value: []int{50},
+										Value: []float64{50},
 									}),
 								),
 								html.Span(html.Class("text-xs text-muted-foreground"), g.Text("50%")),
@@ -402,7 +421,7 @@ func Examples() g.Node {
 								html.Div(html.Class("h-32 flex justify-center"),
 									Vertical(Props{
 										Max:   100,
-										Value: []int{65},
+										Value: []float64{65},
 									}),
 								),
 								html.Span(html.Class("text-xs text-muted-foreground"), g.Text("65%")),
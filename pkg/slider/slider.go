@@ -2,6 +2,9 @@ package slider
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
@@ -10,15 +13,20 @@ import (
 
 // Props defines the properties for the slider component
 type Props struct {
-	Min         int
-	Max         int
-	Step        int
-	Value       []int // Can have multiple values for range sliders
+	Min         float64
+	Max         float64
+	Step        float64
+	Value       []float64                  // Can have multiple values for range sliders
+	MinDistance float64                    // Minimum distance between the thumbs of range sliders
+	Format      func(value float64) string // Formats labels and ticks, e.g. as currency (default: the step's decimals)
+	ThumbLabels bool                       // Show the formatted value next to each thumb
 	Disabled    bool
 	Orientation string // "horizontal" | "vertical"
 	Class       string
 	ID          string
-	Name        string
+	Name        string   // Submits the values as Name[0], Name[1], ...
+	Names       []string // Input name per thumb, e.g. "min_price" and "max_price"; overrides Name
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // New creates a new slider component
@@ -34,7 +42,7 @@ func New(props Props, attrs ...g.Node) g.Node {
 		props.Orientation = "horizontal"
 	}
 	if len(props.Value) == 0 {
-		props.Value = []int{props.Min}
+		props.Value = []float64{props.Min}
 	}
 
 	// Calculate range percentage
@@ -102,9 +110,10 @@ func New(props Props, attrs ...g.Node) g.Node {
 			g.Attr("data-index", fmt.Sprintf("%d", i)),
 			g.Attr("tabindex", "0"),
 			g.Attr("role", "slider"),
-			g.Attr("aria-valuemin", fmt.Sprintf("%d", props.Min)),
-			g.Attr("aria-valuemax", fmt.Sprintf("%d", props.Max)),
-			g.Attr("aria-valuenow", fmt.Sprintf("%d", value)),
+			g.Attr("aria-valuemin", formatValue(props.Min)),
+			g.Attr("aria-valuemax", formatValue(props.Max)),
+			g.Attr("aria-valuenow", formatValue(value)),
+			g.If(props.Format != nil, g.Attr("aria-valuetext", props.label(value))),
 			g.Attr("aria-orientation", props.Orientation),
 			html.Class(lib.CN(
 				"border-primary bg-background ring-offset-background",
@@ -123,19 +132,20 @@ func New(props Props, attrs ...g.Node) g.Node {
 			g.If(props.Disabled,
 				g.Attr("disabled", "true"),
 			),
+			g.If(props.ThumbLabels, thumbLabel(props, value)),
 		)
 
 		sliderContent = append(sliderContent, thumb)
 	}
 
 	// Hidden inputs for form submission
-	if props.Name != "" {
-		for i, value := range props.Value {
+	for i, value := range props.Value {
+		if name := props.inputName(i); name != "" {
 			sliderContent = append(sliderContent,
 				html.Input(
 					html.Type("hidden"),
-					html.Name(fmt.Sprintf("%s[%d]", props.Name, i)),
-					html.Value(fmt.Sprintf("%d", value)),
+					html.Name(name),
+					html.Value(formatValue(value)),
 					g.If(props.ID != "", g.Attr("id", fmt.Sprintf("%s-input-%d", props.ID, i))),
 				),
 			)
@@ -145,9 +155,9 @@ func New(props Props, attrs ...g.Node) g.Node {
 	// Add any additional attributes
 	sliderContent = g.Group(append(sliderContent, attrs...))
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Group(append(attributes, sliderContent...)),
-	)
+	}, props.Attrs)...)
 }
 
 // Single creates a single-value slider
@@ -167,7 +177,7 @@ func Range(props Props) g.Node {
 	
 	// Ensure we have exactly 2 values for range
 	if len(props.Value) == 0 {
-		props.Value = []int{props.Min, props.Max}
+		props.Value = []float64{props.Min, props.Max}
 	} else if len(props.Value) == 1 {
 		props.Value = append(props.Value, props.Max)
 	} else if len(props.Value) > 2 {
@@ -178,6 +188,12 @@ func Range(props Props) g.Node {
 	if props.Value[0] > props.Value[1] {
 		props.Value[0], props.Value[1] = props.Value[1], props.Value[0]
 	}
+
+	// Keep the thumbs MinDistance apart, moving the upper one first
+	if props.Value[1]-props.Value[0] < props.MinDistance {
+		props.Value[1] = math.Min(props.Max, props.Value[0]+props.MinDistance)
+		props.Value[0] = math.Max(props.Min, props.Value[1]-props.MinDistance)
+	}
 	
 	return New(props)
 }
@@ -197,8 +213,8 @@ func WithLabels(props Props) g.Node {
 		// Labels
 		html.Div(
 			html.Class("flex justify-between"),
-			html.Span(html.Class(labelClass), g.Text(fmt.Sprintf("%d", props.Min))),
-			html.Span(html.Class(labelClass), g.Text(fmt.Sprintf("%d", props.Max))),
+			html.Span(html.Class(labelClass), g.Text(props.label(props.Min))),
+			html.Span(html.Class(labelClass), g.Text(props.label(props.Max))),
 		),
 		// Slider
 		New(props),
@@ -207,10 +223,7 @@ func WithLabels(props Props) g.Node {
 
 // WithValue creates a slider with current value display
 func WithValue(props Props) g.Node {
-	valueText := fmt.Sprintf("%d", props.Value[0])
-	if len(props.Value) > 1 {
-		valueText = fmt.Sprintf("%d - %d", props.Value[0], props.Value[1])
-	}
+	valueText := props.valueText(props.Value)
 	
 	return html.Div(
 		html.Class("space-y-2"),
@@ -229,7 +242,8 @@ func WithValue(props Props) g.Node {
 	)
 }
 
-// WithTicks creates a slider with tick marks
+// WithTicks creates a slider with tick marks. The ticks are labelled with
+// their values when props.Format is set.
 func WithTicks(props Props, tickCount int) g.Node {
 	if tickCount < 2 {
 		tickCount = 2
@@ -239,6 +253,9 @@ func WithTicks(props Props, tickCount int) g.Node {
 	if props.Orientation == "" {
 		props.Orientation = "horizontal"
 	}
+	if props.Max == 0 {
+		props.Max = 100
+	}
 	
 	// Generate tick positions
 	ticks := []g.Node{}
@@ -260,6 +277,27 @@ func WithTicks(props Props, tickCount int) g.Node {
 			),
 		)
 		ticks = append(ticks, tick)
+
+		if props.Format != nil {
+			value := props.Min + float64(i)/float64(tickCount-1)*(props.Max-props.Min)
+			ticks = append(ticks, html.Span(
+				html.Class(lib.CN(
+					"absolute text-xs text-muted-foreground whitespace-nowrap",
+					lib.CNIf(props.Orientation == "horizontal",
+						"-bottom-8 -translate-x-1/2",
+						"-right-5 translate-x-full translate-y-1/2",
+					),
+				)),
+				g.Attr("data-slider-tick-label", ""),
+				g.If(props.Orientation == "horizontal",
+					g.Attr("style", fmt.Sprintf("left: %.2f%%", position)),
+				),
+				g.If(props.Orientation == "vertical",
+					g.Attr("style", fmt.Sprintf("bottom: %.2f%%", position)),
+				),
+				g.Text(props.label(value)),
+			))
+		}
 	}
 	
 	return html.Div(
@@ -271,4 +309,109 @@ func WithTicks(props Props, tickCount int) g.Node {
 			g.Group(ticks),
 		),
 	)
-}
\ No newline at end of file
+}
+
+// thumbLabel renders the formatted value next to a thumb
+func thumbLabel(props Props, value float64) g.Node {
+	return html.Span(
+		html.Class(lib.CN(
+			"bg-primary text-primary-foreground pointer-events-none absolute rounded-md px-1.5 py-0.5 text-xs whitespace-nowrap",
+			lib.CNIf(props.Orientation == "vertical",
+				"left-full top-1/2 ml-2 -translate-y-1/2",
+				"bottom-full left-1/2 mb-2 -translate-x-1/2",
+			),
+		)),
+		g.Attr("data-slider-thumb-label", ""),
+		g.Attr("aria-hidden", "true"),
+		g.Text(props.label(value)),
+	)
+}
+
+// label formats value for display, with as many decimals as Step, or as
+// value needs when Step is unset
+func (p Props) label(value float64) string {
+	if p.Format != nil {
+		return p.Format(value)
+	}
+	if p.Step <= 0 {
+		return formatValue(value)
+	}
+	return strconv.FormatFloat(value, 'f', decimals(p.Step), 64)
+}
+
+// valueText formats the values of a slider, joining range values with " - "
+func (p Props) valueText(values []float64) string {
+	labels := make([]string, 0, 2)
+	for i, value := range values {
+		if i == 2 {
+			break
+		}
+		labels = append(labels, p.label(value))
+	}
+	return strings.Join(labels, " - ")
+}
+
+// inputName returns the name of the hidden input of thumb i, or "" when
+// the slider is not submitted
+func (p Props) inputName(i int) string {
+	if i < len(p.Names) {
+		return p.Names[i]
+	}
+	if p.Name != "" {
+		return fmt.Sprintf("%s[%d]", p.Name, i)
+	}
+	return ""
+}
+
+// formatValue formats value for attributes and inputs. It is the shortest
+// representation that parses back to value, so values round-trip exactly.
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// decimals returns the number of decimal places of value
+func decimals(value float64) int {
+	s := formatValue(value)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// snap rounds value to the nearest step from lo and clamps it to [lo, hi]
+func snap(value, lo, hi, step float64) float64 {
+	if step > 0 {
+		value = lo + math.Round((value-lo)/step)*step
+	}
+	value = math.Max(lo, math.Min(hi, value))
+
+	// Drop the floating-point error of the step arithmetic, so that 0.1 + 0.2
+	// is stored as 0.3
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(value, 'f', max(decimals(step), decimals(lo)), 64), 64)
+	return rounded
+}
+
+// spread moves the thumb at index so it stays in order, at least distance
+// away from its neighbours and within [lo, hi]. When the neighbours leave no
+// room the thumb stops short of the bounds and pushes them apart.
+func spread(values []float64, index int, distance, lo, hi float64) {
+	v := values[index]
+	if index > 0 {
+		v = math.Max(v, values[index-1]+distance)
+	}
+	if index < len(values)-1 {
+		v = math.Min(v, values[index+1]-distance)
+	}
+	// Leave room for the thumbs either side, unless they can't all fit
+	if first, last := lo+float64(index)*distance, hi-float64(len(values)-1-index)*distance; first <= last {
+		v = math.Max(first, math.Min(last, v))
+	}
+	values[index] = math.Max(lo, math.Min(hi, v))
+
+	for i := index + 1; i < len(values); i++ {
+		values[i] = math.Min(hi, math.Max(values[i], values[i-1]+distance))
+	}
+	for i := index - 1; i >= 0; i-- {
+		values[i] = math.Max(lo, math.Min(values[i], values[i+1]-distance))
+	}
+}
@@ -1,15 +1,20 @@
 package slider
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"slices"
 	"strconv"
+	"sync"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines properties for HTMX-enhanced slider
@@ -33,7 +38,7 @@ func HTMXSlider(props Props, htmxProps HTMXProps) g.Node {
 		props.Orientation = "horizontal"
 	}
 	if len(props.Value) == 0 {
-		props.Value = []int{props.Min}
+		props.Value = []float64{props.Min}
 	}
 
 	// Calculate range percentage
@@ -47,9 +52,9 @@ func HTMXSlider(props Props, htmxProps HTMXProps) g.Node {
 		g.Attr("id", htmxProps.ID),
 		g.Attr("data-slider", "true"),
 		g.Attr("data-orientation", props.Orientation),
-		g.Attr("data-min", fmt.Sprintf("%d", props.Min)),
-		g.Attr("data-max", fmt.Sprintf("%d", props.Max)),
-		g.Attr("data-step", fmt.Sprintf("%d", props.Step)),
+		g.Attr("data-min", formatValue(props.Min)),
+		g.Attr("data-max", formatValue(props.Max)),
+		g.Attr("data-step", formatValue(props.Step)),
 		html.Class(lib.CN(
 			"relative flex w-full touch-none items-center select-none",
 			"data-[disabled]:opacity-50",
@@ -118,12 +123,13 @@ func HTMXSlider(props Props, htmxProps HTMXProps) g.Node {
 		thumb := html.Div(
 			g.Attr("data-slider-thumb", "true"),
 			g.Attr("data-index", fmt.Sprintf("%d", i)),
-			g.Attr("data-value", fmt.Sprintf("%d", value)),
+			g.Attr("data-value", formatValue(value)),
 			g.Attr("tabindex", "0"),
 			g.Attr("role", "slider"),
-			g.Attr("aria-valuemin", fmt.Sprintf("%d", props.Min)),
-			g.Attr("aria-valuemax", fmt.Sprintf("%d", props.Max)),
-			g.Attr("aria-valuenow", fmt.Sprintf("%d", value)),
+			g.Attr("aria-valuemin", formatValue(props.Min)),
+			g.Attr("aria-valuemax", formatValue(props.Max)),
+			g.Attr("aria-valuenow", formatValue(value)),
+			g.If(props.Format != nil, g.Attr("aria-valuetext", props.label(value))),
 			g.Attr("aria-orientation", props.Orientation),
 			html.Class(lib.CN(
 				"border-primary bg-background ring-offset-background",
@@ -142,6 +148,7 @@ func HTMXSlider(props Props, htmxProps HTMXProps) g.Node {
 			g.If(props.Disabled,
 				g.Attr("disabled", "true"),
 			),
+			g.If(props.ThumbLabels, thumbLabel(props, value)),
 			
 			// Drag handling
 			hx.Post(htmxProps.DragPath),
@@ -150,19 +157,19 @@ func HTMXSlider(props Props, htmxProps HTMXProps) g.Node {
 			hx.Trigger("mousedown"),
 			hx.Vals(fmt.Sprintf(`{"index": %d, "action": "start"}`, i)),
 			
-			// Keyboard handling
+			// Keyboard handling; the server snaps the value to the step
 			g.Attr("onkeydown", fmt.Sprintf(`
-				const step = parseInt(document.getElementById('%s').dataset.step);
-				const min = parseInt(document.getElementById('%s').dataset.min);
-				const max = parseInt(document.getElementById('%s').dataset.max);
-				let value = parseInt(this.dataset.value);
+				const step = parseFloat(document.getElementById('%s').dataset.step);
+				const min = parseFloat(document.getElementById('%s').dataset.min);
+				const max = parseFloat(document.getElementById('%s').dataset.max);
+				let value = parseFloat(this.dataset.value);
 				let changed = false;
 				
 				if (event.key === 'ArrowRight' || event.key === 'ArrowUp') {
-					value = Mathtml.min(max, value + step);
+					value = Math.min(max, value + step);
 					changed = true;
 				} else if (event.key === 'ArrowLeft' || event.key === 'ArrowDown') {
-					value = Mathtml.max(min, value - step);
+					value = Math.max(min, value - step);
 					changed = true;
 				} else if (event.key === 'Home') {
 					value = min;
@@ -184,12 +191,12 @@ func HTMXSlider(props Props, htmxProps HTMXProps) g.Node {
 		)
 
 		// Hidden input for form submission
-		if props.Name != "" {
+		if name := props.inputName(i); name != "" {
 			sliderContent = append(sliderContent,
 				html.Input(
 					html.Type("hidden"),
-					html.Name(fmt.Sprintf("%s[%d]", props.Name, i)),
-					html.Value(fmt.Sprintf("%d", value)),
+					html.Name(name),
+					html.Value(formatValue(value)),
 					g.Attr("id", fmt.Sprintf("%s-input-%d", htmxProps.ID, i)),
 				),
 			)
@@ -198,77 +205,135 @@ func HTMXSlider(props Props, htmxProps HTMXProps) g.Node {
 		sliderContent = append(sliderContent, thumb)
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Group(append(attributes, sliderContent...)),
-	)
+	}, props.Attrs)...)
 }
 
 // SliderState represents the state of a slider
 type SliderState struct {
-	Values []int
-	Min    int
-	Max    int
-	Step   int
+	Values []float64
+	Min    float64
+	Max    float64
+	Step   float64
 }
 
-// Server-side state management
-var sliderStates = make(map[string]*SliderState)
+// State holds a slider's values between requests and the thumbs being
+// dragged. It's safe for concurrent use. The zero value is set from the Props
+// of the Handlers it's first registered with.
+type State struct {
+	mu     sync.Mutex
+	ready  bool
+	slider SliderState
+	drags  map[string]drag
+}
 
-// SliderHandlers creates HTTP handlers for slider functionality
-func SliderHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+// drag is the thumb a session is dragging
+type drag struct {
+	Index int
+	Start float64
+}
+
+// init sets the state from props unless it's set already
+func (s *State) init(props Props) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ready {
+		return
+	}
+	s.ready = true
+	s.slider = SliderState{
+		Values: slices.Clone(props.Value),
+		Min:    props.Min,
+		Max:    cmp.Or(props.Max, 100),
+		Step:   cmp.Or(props.Step, 1),
+	}
+	if len(s.slider.Values) == 0 {
+		s.slider.Values = []float64{props.Min}
+	}
+	s.drags = make(map[string]drag)
+}
+
+// Values returns the current values
+func (s *State) Values() []float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.slider.Values)
+}
+
+// props applies update, when it isn't nil, and returns base with the
+// resulting values
+func (s *State) props(base Props, update func(s *State)) Props {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if update != nil {
+		update(s)
+	}
+	base.Value = slices.Clone(s.slider.Values)
+	base.Min = s.slider.Min
+	base.Max = s.slider.Max
+	base.Step = s.slider.Step
+	return base
+}
+
+// legacy holds the state of sliders served by the deprecated SliderHandlers
+// and SliderValueHandler, which share it by ID
+var legacy = struct {
+	sync.Mutex
+	states map[string]*State
+}{states: make(map[string]*State)}
+
+func legacyState(id string) *State {
+	legacy.Lock()
+	defer legacy.Unlock()
+	if legacy.states[id] == nil {
+		legacy.states[id] = &State{}
+	}
+	return legacy.states[id]
+}
+
+// Handlers serves the init, update and drag endpoints of a slider. The paths
+// in HTMX are relative to the router the handlers are registered on.
+type Handlers struct {
+	Props Props     // Initial slider state
+	HTMX  HTMXProps // Slider ID and endpoint paths
+	Value bool      // Also serve the current value as text at UpdatePath + "/value"
+	State *State    // Values shared between requests (default: a new State per Register)
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Validate required paths
-	if htmxProps.InitPath == "" {
-		panic("SliderHandlers: InitPath is required")
+	if h.HTMX.InitPath == "" {
+		panic("slider.Handlers: InitPath is required")
 	}
-	if htmxProps.UpdatePath == "" {
-		panic("SliderHandlers: UpdatePath is required")
+	if h.HTMX.UpdatePath == "" {
+		panic("slider.Handlers: UpdatePath is required")
 	}
-	if htmxProps.DragPath == "" {
-		panic("SliderHandlers: DragPath is required")
+	if h.HTMX.DragPath == "" {
+		panic("slider.Handlers: DragPath is required")
 	}
 
+	baseProps := h.Props
+	htmxProps := h.HTMX
+	htmxProps.InitPath = rt.Path(h.HTMX.InitPath)
+	htmxProps.UpdatePath = rt.Path(h.HTMX.UpdatePath)
+	htmxProps.DragPath = rt.Path(h.HTMX.DragPath)
+
 	// Initialize state
-	if _, exists := sliderStates[htmxProps.ID]; !exists {
-		sliderStates[htmxProps.ID] = &SliderState{
-			Values: baseProps.Value,
-			Min:    baseProps.Min,
-			Max:    baseProps.Max,
-			Step:   baseProps.Step,
-		}
-		if sliderStates[htmxProps.ID].Max == 0 {
-			sliderStates[htmxProps.ID].Max = 100
-		}
-		if sliderStates[htmxProps.ID].Step == 0 {
-			sliderStates[htmxProps.ID].Step = 1
-		}
+	state := h.State
+	if state == nil {
+		state = &State{}
 	}
+	state.init(baseProps)
 
 	// Initialize handler
-	mux.HandleFunc(htmxProps.InitPath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		state := sliderStates[htmxProps.ID]
-		props := baseProps
-		props.Value = state.Values
-		props.Min = state.Min
-		props.Max = state.Max
-		props.Step = state.Step
-
-		HTMXSlider(props, htmxProps).Render(w)
-	})
+	rt.Handle(http.MethodGet, h.HTMX.InitPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		HTMXSlider(state.props(baseProps, nil), htmxProps).Render(w)
+	}))
 
 	// Update handler
-	mux.HandleFunc(htmxProps.UpdatePath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		state := sliderStates[htmxProps.ID]
-		
+	rt.Handle(http.MethodPost, h.HTMX.UpdatePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Parse form values
 		err := r.ParseForm()
 		if err != nil {
@@ -276,147 +341,111 @@ func SliderHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
 			return
 		}
 
+		var update func(s *State)
+
 		// Handle different update types
 		if percentStr := r.FormValue("percent"); percentStr != "" {
 			// Track click - find nearest thumb or add new one
 			percent, _ := strconv.ParseFloat(percentStr, 64)
-			newValue := int(percent*float64(state.Max-state.Min) + float64(state.Min))
-			
-			// Round to nearest step
-			newValue = ((newValue + state.Step/2) / state.Step) * state.Step
-			newValue = max(state.Min, min(state.Max, newValue))
-
-			// For single slider, just update the value
-			if len(state.Values) == 1 {
-				state.Values[0] = newValue
-			} else {
+			update = func(s *State) {
+				state := &s.slider
+				newValue := snap(percent*(state.Max-state.Min)+state.Min, state.Min, state.Max, state.Step)
+
+				// For single slider, just update the value
+				if len(state.Values) == 1 {
+					state.Values[0] = newValue
+					return
+				}
+
 				// For range slider, update the nearest thumb
 				minDist := state.Max - state.Min
 				nearestIdx := 0
 				for i, v := range state.Values {
-					dist := abs(v - newValue)
+					dist := math.Abs(v - newValue)
 					if dist < minDist {
 						minDist = dist
 						nearestIdx = i
 					}
 				}
 				state.Values[nearestIdx] = newValue
-				
-				// Ensure values are in order
-				if len(state.Values) == 2 && state.Values[0] > state.Values[1] {
-					state.Values[0], state.Values[1] = state.Values[1], state.Values[0]
-				}
+				spread(state.Values, nearestIdx, baseProps.MinDistance, state.Min, state.Max)
 			}
 		} else if indexStr := r.FormValue("index"); indexStr != "" {
 			// Direct thumb update
 			index, _ := strconv.Atoi(indexStr)
 			if valueStr := r.FormValue("value"); valueStr != "" {
-				value, _ := strconv.Atoi(valueStr)
-				value = max(state.Min, min(state.Max, value))
-				
-				if index >= 0 && index < len(state.Values) {
-					state.Values[index] = value
-					
-					// Ensure values are in order for range sliders
-					if len(state.Values) == 2 {
-						if index == 0 && state.Values[0] > state.Values[1] {
-							state.Values[0] = state.Values[1]
-						} else if index == 1 && state.Values[1] < state.Values[0] {
-							state.Values[1] = state.Values[0]
-						}
+				value, err := strconv.ParseFloat(valueStr, 64)
+				if err != nil || math.IsNaN(value) {
+					http.Error(w, "Invalid value", http.StatusBadRequest)
+					return
+				}
+				update = func(s *State) {
+					state := &s.slider
+					if index >= 0 && index < len(state.Values) {
+						state.Values[index] = snap(value, state.Min, state.Max, state.Step)
+
+						// Keep range thumbs in order and MinDistance apart
+						spread(state.Values, index, baseProps.MinDistance, state.Min, state.Max)
 					}
 				}
 			}
 		}
 
 		// Return updated slider
-		props := baseProps
-		props.Value = state.Values
-		props.Min = state.Min
-		props.Max = state.Max
-		props.Step = state.Step
-
-		HTMXSlider(props, htmxProps).Render(w)
-	})
+		HTMXSlider(state.props(baseProps, update), htmxProps).Render(w)
+	}))
 
 	// Drag handler
-	var dragState = make(map[string]struct {
-		Index int
-		Start int
-	})
-
-	mux.HandleFunc(htmxProps.DragPath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		state := sliderStates[htmxProps.ID]
+	rt.Handle(http.MethodPost, h.HTMX.DragPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		sessionID := r.Header.Get("X-Session-ID")
 		if sessionID == "" {
 			sessionID = "default"
 		}
 
 		// Parse request
-		var data map[string]interface{}
+		var data struct {
+			Action string `json:"action"`
+			Index  int    `json:"index"`
+		}
 		json.NewDecoder(r.Body).Decode(&data)
+		if data.Action != "start" {
+			return
+		}
 
-		action, _ := data["action"].(string)
-		index := int(data["index"].(float64))
-
-		if action == "start" && index >= 0 && index < len(state.Values) {
-			// Start dragging
-			dragState[sessionID] = struct {
-				Index int
-				Start int
-			}{
-				Index: index,
-				Start: state.Values[index],
+		// Start dragging
+		started := false
+		props := state.props(baseProps, func(s *State) {
+			if data.Index >= 0 && data.Index < len(s.slider.Values) {
+				s.drags[sessionID] = drag{Index: data.Index, Start: s.slider.Values[data.Index]}
+				started = true
 			}
-
-			// Add mouse move and up handlers
-			w.Header().Set("HX-Trigger-After-Swap", "setupDrag")
-			
-			// Return current slider
-			props := baseProps
-			props.Value = state.Values
-			props.Min = state.Min
-			props.Max = state.Max
-			props.Step = state.Step
-
-			HTMXSlider(props, htmxProps).Render(w)
+		})
+		if !started {
+			return
 		}
-	})
-}
 
-// Helper functions
-func abs(n int) int {
-	if n < 0 {
-		return -n
-	}
-	return n
-}
+		// Add mouse move and up handlers
+		w.Header().Set("HX-Trigger-After-Swap", "setupDrag")
 
-func max(a, b int) int {
-	if a > b {
-		return a
+		// Return current slider
+		HTMXSlider(props, htmxProps).Render(w)
+	}))
+
+	if h.Value {
+		h.registerValue(rt, state)
 	}
-	return b
 }
 
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
+// SliderHandlers creates HTTP handlers for slider functionality
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func SliderHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+	Handlers{Props: baseProps, HTMX: htmxProps, State: legacyState(htmxProps.ID)}.Register(router.ServeMux(mux))
 }
 
 // HTMXSliderWithValue creates a slider with live value display
 func HTMXSliderWithValue(props Props, htmxProps HTMXProps) g.Node {
-	valueText := fmt.Sprintf("%d", props.Value[0])
-	if len(props.Value) > 1 {
-		valueText = fmt.Sprintf("%d - %d", props.Value[0], props.Value[1])
-	}
+	valueText := props.valueText(props.Value)
 	
 	return html.Div(
 		html.Class("space-y-2"),
@@ -440,27 +469,28 @@ func HTMXSliderWithValue(props Props, htmxProps HTMXProps) g.Node {
 	)
 }
 
-// Additional handler for value display updates
-func SliderValueHandler(mux *http.ServeMux, htmxProps HTMXProps) {
-	mux.HandleFunc(htmxProps.UpdatePath+"/value", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		state := sliderStates[htmxProps.ID]
-		if state == nil {
+// registerValue serves the current value of state as text
+func (h Handlers) registerValue(rt router.Router, state *State) {
+	rt.Handle(http.MethodGet, h.HTMX.UpdatePath+"/value", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state.mu.Lock()
+		ready := state.ready
+		state.mu.Unlock()
+		if !ready {
 			http.Error(w, "Slider not found", http.StatusNotFound)
 			return
 		}
 
-		valueText := fmt.Sprintf("%d", state.Values[0])
-		if len(state.Values) > 1 {
-			valueText = fmt.Sprintf("%d - %d", state.Values[0], state.Values[1])
-		}
+		props := state.props(h.Props, nil)
+		w.Write([]byte(props.valueText(props.Value)))
+	}))
+}
 
-		w.Write([]byte(valueText))
-	})
+// SliderValueHandler serves the current value of a slider registered with
+// SliderHandlers as text
+//
+// Deprecated: Set Handlers.Value.
+func SliderValueHandler(mux *http.ServeMux, htmxProps HTMXProps) {
+	Handlers{HTMX: htmxProps}.registerValue(router.ServeMux(mux), legacyState(htmxProps.ID))
 }
 
 // DragScript returns JavaScript for handling drag operations
@@ -473,9 +503,9 @@ func DragScript(htmxProps HTMXProps) string {
 			
 			const index = parseInt(activeThumb.dataset.index);
 			const orientation = slider.dataset.orientation;
-			const min = parseInt(slider.dataset.min);
-			const max = parseInt(slider.dataset.max);
-			const step = parseInt(slider.dataset.step);
+			const min = parseFloat(slider.dataset.min);
+			const max = parseFloat(slider.dataset.max);
+			const step = parseFloat(slider.dataset.step);
 			const track = slider.querySelector('[data-slider-track]');
 			
 			function handleMove(e) {
@@ -489,9 +519,8 @@ func DragScript(htmxProps HTMXProps) string {
 					percent = (e.clientX - rect.left) / rect.width;
 				}
 				
-				percent = Mathtml.max(0, Mathtml.min(1, percent));
-				let value = Mathtml.round(percent * (max - min) + min);
-				value = Mathtml.round(value / step) * step;
+				percent = Math.max(0, Math.min(1, percent));
+				const value = percent * (max - min) + min;
 				
 				htmx.ajax('POST', '%s', {
 					target: '#%s',
@@ -2,8 +2,18 @@ package slider
 
 import (
 	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestNew(t *testing.T) {
@@ -31,7 +41,7 @@ func TestNew(t *testing.T) {
 			props: Props{
 				Min:   10,
 				Max:   50,
-				Value: []int{25},
+				Value: []float64{25},
 			},
 			contains: []string{
 				`aria-valuemin="10"`,
@@ -42,7 +52,7 @@ func TestNew(t *testing.T) {
 		{
 			name: "range slider",
 			props: Props{
-				Value: []int{20, 80},
+				Value: []float64{20, 80},
 			},
 			contains: []string{
 				`data-index="0"`,
@@ -55,7 +65,7 @@ func TestNew(t *testing.T) {
 			name: "vertical slider",
 			props: Props{
 				Orientation: "vertical",
-				Value:       []int{50},
+				Value:       []float64{50},
 			},
 			contains: []string{
 				`data-orientation="vertical"`,
@@ -77,7 +87,7 @@ func TestNew(t *testing.T) {
 			name: "slider with step",
 			props: Props{
 				Step:  5,
-				Value: []int{15},
+				Value: []float64{15},
 			},
 			contains: []string{
 				`aria-valuenow="15"`,
@@ -87,7 +97,7 @@ func TestNew(t *testing.T) {
 			name: "slider with hidden inputs",
 			props: Props{
 				Name:  "volume",
-				Value: []int{75},
+				Value: []float64{75},
 				ID:    "volume-slider",
 			},
 			contains: []string{
@@ -121,7 +131,7 @@ func TestNew(t *testing.T) {
 func TestSingle(t *testing.T) {
 	// Test that Single ensures only one value
 	props := Props{
-		Value: []int{20, 40, 60}, // Multiple values provided
+		Value: []float64{20, 40, 60}, // Multiple values provided
 	}
 	
 	var buf bytes.Buffer
@@ -165,7 +175,7 @@ func TestRange(t *testing.T) {
 		{
 			name: "range with one value",
 			props: Props{
-				Value: []int{30},
+				Value: []float64{30},
 			},
 			expected: []string{
 				`aria-valuenow="30"`,
@@ -175,7 +185,7 @@ func TestRange(t *testing.T) {
 		{
 			name: "range with reversed values",
 			props: Props{
-				Value: []int{80, 20}, // Should be swapped
+				Value: []float64{80, 20}, // Should be swapped
 			},
 			expected: []string{
 				`aria-valuenow="20"`,
@@ -212,7 +222,7 @@ func TestRange(t *testing.T) {
 
 func TestVertical(t *testing.T) {
 	props := Props{
-		Value: []int{50},
+		Value: []float64{50},
 	}
 	
 	var buf bytes.Buffer
@@ -276,7 +286,7 @@ func TestWithValue(t *testing.T) {
 		{
 			name: "single value display",
 			props: Props{
-				Value: []int{42},
+				Value: []float64{42},
 				ID:    "test-slider",
 			},
 			expected: `>42</span>`,
@@ -284,7 +294,7 @@ func TestWithValue(t *testing.T) {
 		{
 			name: "range value display",
 			props: Props{
-				Value: []int{10, 90},
+				Value: []float64{10, 90},
 			},
 			expected: `>10 - 90</span>`,
 		},
@@ -318,7 +328,7 @@ func TestWithValue(t *testing.T) {
 
 func TestWithTicks(t *testing.T) {
 	props := Props{
-		Value: []int{50},
+		Value: []float64{50},
 	}
 	
 	var buf bytes.Buffer
@@ -350,4 +360,197 @@ func TestWithTicks(t *testing.T) {
 			t.Errorf("Expected output to contain tick at %q, but it didn't", exp)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestDecimalValues(t *testing.T) {
+	price := func(v float64) string { return fmt.Sprintf("$%.2f", v) }
+
+	var buf bytes.Buffer
+	component := Range(Props{
+		Min:         0.5,
+		Max:         99.99,
+		Step:        0.01,
+		Value:       []float64{12.34, 13},
+		MinDistance: 5,
+		Format:      price,
+		ThumbLabels: true,
+		Names:       []string{"min_price", "max_price"},
+	})
+	if err := component.Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	result := buf.String()
+
+	expected := []string{
+		`aria-valuemin="0.5"`,
+		`aria-valuemax="99.99"`,
+		`aria-valuenow="12.34" aria-valuetext="$12.34"`,
+		`aria-valuenow="17.34" aria-valuetext="$17.34"`,
+		`data-slider-thumb-label="" aria-hidden="true">$12.34</span>`,
+		`<input type="hidden" name="min_price" value="12.34">`,
+		`<input type="hidden" name="max_price" value="17.34">`,
+	}
+	for _, exp := range expected {
+		if !strings.Contains(result, exp) {
+			t.Errorf("Expected output to contain %q, but it didn't", exp)
+		}
+	}
+
+	buf.Reset()
+	if err := WithTicks(Props{Max: 1, Step: 0.1, Format: func(v float64) string { return fmt.Sprintf("%.0f%%", v*100) }}, 3).Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, exp := range []string{`>0%</span>`, `>50%</span>`, `>100%</span>`} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Errorf("Expected tick label %q", exp)
+		}
+	}
+
+	buf.Reset()
+	if err := WithValue(Props{Step: 0.5, Value: []float64{2}}).Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `>2.0</span>`) {
+		t.Errorf("Expected the value to be shown with the step's decimals")
+	}
+
+	// Without a Step, labels keep the decimals of their values
+	buf.Reset()
+	if err := WithLabels(Props{Min: 0.5, Max: 99.99}).Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `>0.5</span>`) || !strings.Contains(buf.String(), `>99.99</span>`) {
+		t.Errorf("Expected the labels to keep their decimals without a Step, got %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := WithValue(Props{Value: []float64{12.25}}).Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `>12.25</span>`) {
+		t.Errorf("Expected the value to keep its decimals without a Step, got %s", buf.String())
+	}
+}
+
+func TestSpread(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		index    int
+		distance float64
+		expected []float64
+	}{
+		{"stops at the neighbour", []float64{4, 5}, 0, 3, []float64{2, 5}},
+		{"stops above the neighbour", []float64{0, 1}, 1, 3, []float64{0, 3}},
+		{"pushes back at the lower bound", []float64{0, 1}, 0, 3, []float64{0, 3}},
+		{"pushes back at the upper bound", []float64{9, 10}, 1, 3, []float64{7, 10}},
+		{"stays between neighbours", []float64{0, 9, 10}, 1, 3, []float64{0, 7, 10}},
+		{"no room for the distance", []float64{0, 1, 2}, 1, 6, []float64{0, 0, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := slices.Clone(tt.values)
+			spread(values, tt.index, tt.distance, 0, 10)
+			if !slices.Equal(values, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, values)
+			}
+		})
+	}
+}
+
+func TestHandlersDecimalRoundTrip(t *testing.T) {
+	mux := http.NewServeMux()
+	Handlers{
+		Props: Props{Min: 0, Max: 1, Step: 0.1, Value: []float64{0.2, 0.8}, MinDistance: 0.3, Names: []string{"lo", "hi"}},
+		HTMX: HTMXProps{
+			ID:         "decimal-slider",
+			InitPath:   "/slider/init",
+			UpdatePath: "/slider/update",
+			DragPath:   "/slider/drag",
+		},
+		Value: true,
+	}.Register(router.ServeMux(mux))
+
+	update := func(values url.Values) string {
+		req := httptest.NewRequest(http.MethodPost, "/slider/update", strings.NewReader(values.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status %d", rec.Code)
+		}
+		return rec.Body.String()
+	}
+
+	// 0.2 + 0.1 is 0.30000000000000004 in JavaScript
+	body := update(url.Values{"index": {"0"}, "value": {"0.30000000000000004"}})
+	if !strings.Contains(body, `<input type="hidden" name="lo" value="0.3"`) {
+		t.Errorf("expected the value to be snapped to the step, got %s", body)
+	}
+
+	body = update(url.Values{"index": {"0"}, "value": {"0.7"}})
+	if !strings.Contains(body, `name="lo" value="0.5"`) || !strings.Contains(body, `name="hi" value="0.8"`) {
+		t.Errorf("expected the thumbs to be kept MinDistance apart, got %s", body)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/slider/update/value", nil))
+	if rec.Body.String() != "0.5 - 0.8" {
+		t.Errorf("unexpected value text %q", rec.Body.String())
+	}
+}
+
+func TestHandlersState(t *testing.T) {
+	handlers := Handlers{
+		Props: Props{Value: []float64{10}},
+		HTMX: HTMXProps{
+			ID:         "state-slider",
+			InitPath:   "/slider/init",
+			UpdatePath: "/slider/update",
+			DragPath:   "/slider/drag",
+		},
+	}
+	a, b := http.NewServeMux(), http.NewServeMux()
+	handlers.Register(router.ServeMux(a))
+	handlers.Register(router.ServeMux(b))
+
+	post := func(mux *http.ServeMux, path, body, contentType string) {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			post(a, "/slider/update", fmt.Sprintf("index=0&value=%d", i), "application/x-www-form-urlencoded")
+			post(a, "/slider/drag", `{"index": 0, "action": "start"}`, "application/json")
+		}()
+	}
+	wg.Wait()
+
+	rec := httptest.NewRecorder()
+	b.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/slider/init", nil))
+	if !strings.Contains(rec.Body.String(), `aria-valuenow="10"`) {
+		t.Errorf("expected each Register to keep its own state, got %s", rec.Body.String())
+	}
+
+	state := &State{}
+	handlers.State = state
+	handlers.Register(router.ServeMux(http.NewServeMux()))
+	mux := http.NewServeMux()
+	handlers.Register(router.ServeMux(mux))
+	post(mux, "/slider/update", "index=0&value=42", "application/x-www-form-urlencoded")
+	if got := state.Values(); len(got) != 1 || got[0] != 42 {
+		t.Errorf("expected the shared state to be updated, got %v", got)
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Examples": Examples,
+	})
+}
@@ -3,6 +3,7 @@ package sonner
 import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
 )
 
 // Examples demonstrates various toast configurations
@@ -312,5 +313,20 @@ func Examples() g.Node {
 				}),
 			),
 		),
+
+		// Flash messages
+		html.Div(
+			html.Class("space-y-4"),
+			html.H3(html.Class("text-lg font-semibold"), g.Text("After a Redirect")),
+			html.P(
+				html.Class("text-sm text-muted-foreground"),
+				g.Text("A plain form post stores a flash message and redirects; the toast appears when the page renders again."),
+			),
+			html.Form(
+				html.Method("post"),
+				html.Action("/sonner/flash"),
+				button.New(button.Props{Variant: "outline", Size: "sm", Type: "submit"}, g.Text("Save settings")),
+			),
+		),
 	)
 }
\ No newline at end of file
@@ -7,6 +7,7 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/flash"
 )
 
 // ToastType defines the type of toast
@@ -34,15 +35,17 @@ const (
 
 // ToasterProps defines properties for the toaster container
 type ToasterProps struct {
-	Position      Position
-	Expand        bool
-	RichColors    bool
-	CloseButton   bool
-	Duration      int // milliseconds
-	Gap           int // pixels between toasts
-	MaxVisible    int
-	Class         string
-	ID            string
+	Position    Position
+	Expand      bool
+	RichColors  bool
+	CloseButton bool
+	Duration    int // milliseconds
+	Gap         int // pixels between toasts
+	MaxVisible  int
+	Class       string
+	ID          string
+	Flash       []flash.Message // Messages drained from a flash store, shown on render
+	Attrs       []g.Node        // Additional attributes to pass through
 }
 
 // ToastProps defines properties for individual toasts
@@ -55,6 +58,7 @@ type ToastProps struct {
 	CloseButton bool
 	Duration    int // milliseconds, 0 = infinite
 	Class       string
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // ToastAction defines an action button for toasts
@@ -92,7 +96,7 @@ func Toaster(props ToasterProps) g.Node {
 		PositionBottomLeft:   "bottom-4 left-4",
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("id", props.ID),
 		g.Attr("data-toaster", "true"),
 		g.Attr("data-position", string(props.Position)),
@@ -113,8 +117,28 @@ func Toaster(props ToasterProps) g.Node {
 			html.Class("flex flex-col gap-[var(--gap)]"),
 			g.Attr("data-toaster-list", "true"),
 			// Toasts are inserted here
+			flashToasts(props, props.ID),
 		),
-	)
+		g.If(len(props.Flash) > 0, flash.Script()),
+	}, props.Attrs)...)
+}
+
+// flashToasts renders messages drained from a flash store with the toaster
+// defaults
+func flashToasts(props ToasterProps, toasterID string) g.Node {
+	toasts := make([]g.Node, 0, len(props.Flash))
+	for i, m := range props.Flash {
+		toasts = append(toasts, Toast(ToastProps{
+			ID:          fmt.Sprintf("%s-flash-%d", toasterID, i),
+			Type:        ToastType(m.Type),
+			Title:       m.Title,
+			Description: m.Description,
+			Duration:    props.Duration,
+			CloseButton: props.CloseButton,
+			Attrs:       []g.Node{flash.Item()},
+		}))
+	}
+	return g.Group(toasts)
 }
 
 // Toast creates an individual toast notification
@@ -189,7 +213,7 @@ func Toast(props ToastProps) g.Node {
 		)
 	}
 
-	return html.Li(
+	return html.Li(lib.MergeAttrs([]g.Node{
 		g.Attr("id", props.ID),
 		g.Attr("data-toast", "true"),
 		g.Attr("data-type", string(props.Type)),
@@ -204,7 +228,7 @@ func Toast(props ToastProps) g.Node {
 		g.Attr("role", "status"),
 		g.Attr("aria-live", "polite"),
 		g.Group(toastContent),
-	)
+	}, props.Attrs)...)
 }
 
 // Helper functions to create toasts of specific types
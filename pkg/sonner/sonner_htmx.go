@@ -11,6 +11,8 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/flash"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXToasterProps defines properties for HTMX-enhanced toaster
@@ -50,7 +52,7 @@ func HTMXToaster(props ToasterProps, htmxProps HTMXToasterProps) g.Node {
 		PositionBottomLeft:   "bottom-4 left-4",
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("id", htmxProps.ID),
 		g.Attr("data-toaster", "true"),
 		g.Attr("data-position", string(props.Position)),
@@ -79,8 +81,10 @@ func HTMXToaster(props ToasterProps, htmxProps HTMXToasterProps) g.Node {
 			hx.Trigger("sse:toastUpdate"),
 			hx.Target("this"),
 			hx.Swap("innerHTML"),
+			flashToasts(props, htmxProps.ID),
 		),
-	)
+		g.If(len(props.Flash) > 0, flash.Script()),
+	}, props.Attrs)...)
 }
 
 // HTMXToast creates an HTMX-enhanced toast
@@ -126,17 +130,16 @@ func HTMXToast(props ToastProps, htmxProps HTMXToasterProps) g.Node {
 	return toast
 }
 
-// ToastStore manages server-side toast state
+// ToastStore manages server-side toast state. The zero value is an empty
+// store.
 type ToastStore struct {
 	mu     sync.RWMutex
 	toasts map[string][]ToastProps
 	order  map[string][]string // Maintains toast order
 }
 
-var toastStore = &ToastStore{
-	toasts: make(map[string][]ToastProps),
-	order:  make(map[string][]string),
-}
+// toastStore holds the toasts of the deprecated ToasterHandlers
+var toastStore = &ToastStore{}
 
 // AddToast adds a toast to the store
 func (s *ToastStore) AddToast(toasterID string, toast ToastProps) {
@@ -146,6 +149,10 @@ func (s *ToastStore) AddToast(toasterID string, toast ToastProps) {
 	if toast.ID == "" {
 		toast.ID = fmt.Sprintf("toast-%d", time.Now().UnixNano())
 	}
+	if s.toasts == nil {
+		s.toasts = make(map[string][]ToastProps)
+		s.order = make(map[string][]string)
+	}
 
 	s.toasts[toasterID] = append(s.toasts[toasterID], toast)
 	s.order[toasterID] = append(s.order[toasterID], toast.ID)
@@ -184,26 +191,40 @@ func (s *ToastStore) GetToasts(toasterID string) []ToastProps {
 	return s.toasts[toasterID]
 }
 
-// ToasterHandlers creates HTTP handlers for toaster functionality
-func ToasterHandlers(mux *http.ServeMux, baseProps ToasterProps, htmxProps HTMXToasterProps) {
+// Handlers serves the add, remove and update endpoints of a toaster. The
+// paths in HTMX are relative to the router the handlers are registered on.
+type Handlers struct {
+	Props ToasterProps     // Defaults applied to added toasts
+	HTMX  HTMXToasterProps // Toaster ID and endpoint paths
+	Store *ToastStore      // Toasts shared between requests (default: a new ToastStore per Register)
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Validate required paths
-	if htmxProps.AddPath == "" {
-		panic("ToasterHandlers: AddPath is required")
+	if h.HTMX.AddPath == "" {
+		panic("sonner.Handlers: AddPath is required")
 	}
-	if htmxProps.RemovePath == "" {
-		panic("ToasterHandlers: RemovePath is required")
+	if h.HTMX.RemovePath == "" {
+		panic("sonner.Handlers: RemovePath is required")
 	}
-	if htmxProps.UpdatePath == "" {
-		panic("ToasterHandlers: UpdatePath is required")
+	if h.HTMX.UpdatePath == "" {
+		panic("sonner.Handlers: UpdatePath is required")
 	}
 
-	// Add toast handler
-	mux.HandleFunc(htmxProps.AddPath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+	baseProps := h.Props
+	htmxProps := h.HTMX
+	htmxProps.AddPath = rt.Path(h.HTMX.AddPath)
+	htmxProps.RemovePath = rt.Path(h.HTMX.RemovePath)
+	htmxProps.UpdatePath = rt.Path(h.HTMX.UpdatePath)
 
+	store := h.Store
+	if store == nil {
+		store = &ToastStore{}
+	}
+
+	// Add toast handler
+	rt.Handle(http.MethodPost, h.HTMX.AddPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Parse toast data
 		var toastData struct {
 			Type        string `json:"type"`
@@ -245,45 +266,40 @@ func ToasterHandlers(mux *http.ServeMux, baseProps ToasterProps, htmxProps HTMXT
 		}
 
 		// Add to store
-		toastStore.AddToast(htmxProps.ID, toast)
+		store.AddToast(htmxProps.ID, toast)
 
 		// Trigger SSE update
 		w.Header().Set("HX-Trigger", "toastUpdate")
 		w.WriteHeader(http.StatusOK)
-	})
+	}))
 
 	// Remove toast handler
-	mux.HandleFunc(htmxProps.RemovePath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodDelete {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
+	rt.Handle(http.MethodDelete, h.HTMX.RemovePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		toastID := r.FormValue("id")
 		if toastID == "" {
 			http.Error(w, "Toast ID required", http.StatusBadRequest)
 			return
 		}
 
-		toastStore.RemoveToast(htmxProps.ID, toastID)
+		store.RemoveToast(htmxProps.ID, toastID)
 
 		// Return empty response with fade out
 		w.Header().Set("HX-Reswap", "outerHTML swap:0.3s")
 		w.Write([]byte(""))
-	})
+	}))
 
 	// Update handler (returns current toast list)
-	mux.HandleFunc(htmxProps.UpdatePath+"/list", func(w http.ResponseWriter, r *http.Request) {
-		toasts := toastStore.GetToasts(htmxProps.ID)
+	rt.Handle(http.MethodGet, h.HTMX.UpdatePath+"/list", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		toasts := store.GetToasts(htmxProps.ID)
 		
 		// Render all toasts
 		for _, toast := range toasts {
 			HTMXToast(toast, htmxProps).Render(w)
 		}
-	})
+	}))
 
 	// SSE endpoint for real-time updates
-	mux.HandleFunc(htmxProps.UpdatePath, func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle(http.MethodGet, h.HTMX.UpdatePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Set headers for SSE
 		w.Header().Set("Content-Type", "text/event-stream")
 		w.Header().Set("Cache-Control", "no-cache")
@@ -306,7 +322,14 @@ func ToasterHandlers(mux *http.ServeMux, baseProps ToasterProps, htmxProps HTMXT
 				return
 			}
 		}
-	})
+	}))
+}
+
+// ToasterHandlers creates HTTP handlers for toaster functionality
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func ToasterHandlers(mux *http.ServeMux, baseProps ToasterProps, htmxProps HTMXToasterProps) {
+	Handlers{Props: baseProps, HTMX: htmxProps, Store: toastStore}.Register(router.ServeMux(mux))
 }
 
 // Helper functions for common toast operations
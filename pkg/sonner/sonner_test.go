@@ -2,11 +2,16 @@ package sonner
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/flash"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestToaster(t *testing.T) {
@@ -52,6 +57,24 @@ func TestToaster(t *testing.T) {
 				`--gap: 24px`,
 			},
 		},
+		{
+			name: "toaster with flash messages",
+			props: ToasterProps{
+				ID:          "notifications",
+				Flash:       []flash.Message{{Type: flash.Success, Title: "Signed in", Description: "Welcome back"}},
+				CloseButton: true,
+			},
+			contains: []string{
+				`id="notifications-flash-0"`,
+				`data-type="success"`,
+				`data-duration="5000"`,
+				`data-flash=""`,
+				`Signed in`,
+				`Welcome back`,
+				`data-toast-close="notifications-flash-0"`,
+				`<script>`,
+			},
+		},
 		{
 			name: "center positions",
 			props: ToasterProps{
@@ -375,4 +398,47 @@ func TestCustomToast(t *testing.T) {
 			t.Errorf("Expected output to contain %q, but it didn't. Got:\n%s", exp, result)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestHandlersStore(t *testing.T) {
+	handlers := Handlers{
+		HTMX: HTMXToasterProps{ID: "toaster", AddPath: "/toast/add", RemovePath: "/toast/remove", UpdatePath: "/toast/updates"},
+	}
+	a, b := http.NewServeMux(), http.NewServeMux()
+	handlers.Register(router.ServeMux(a))
+	handlers.Register(router.ServeMux(b))
+
+	add := func(mux *http.ServeMux, title string) {
+		req := httptest.NewRequest(http.MethodPost, "/toast/add", strings.NewReader(`{"title": "`+title+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	list := func(mux *http.ServeMux) string {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/toast/updates/list", nil))
+		return rec.Body.String()
+	}
+
+	add(a, "Saved")
+	if !strings.Contains(list(a), "Saved") {
+		t.Errorf("expected the toast to be listed, got %s", list(a))
+	}
+	if got := list(b); got != "" {
+		t.Errorf("expected each Register to keep its own toasts, got %s", got)
+	}
+
+	store := &ToastStore{}
+	handlers.Store = store
+	mux := http.NewServeMux()
+	handlers.Register(router.ServeMux(mux))
+	add(mux, "Shared")
+	if toasts := store.GetToasts("toaster"); len(toasts) != 1 || toasts[0].Title != "Shared" {
+		t.Errorf("expected the shared store to hold the toast, got %v", toasts)
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Examples": Examples,
+	})
+}
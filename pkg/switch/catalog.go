@@ -0,0 +1,42 @@
+package switchcomp
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Switch",
+		Category:    "Forms",
+		Description: "Toggle switch component",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Size", Type: catalog.Enum, Default: "default", Options: []string{"sm", "default", "lg"}},
+			{Name: "Checked", Type: catalog.Bool, Default: "false"},
+			{Name: "Disabled", Type: catalog.Bool, Default: "false"},
+			{Name: "Label", Default: "Airplane mode"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return html.Div(
+				html.Class("flex items-center gap-2"),
+				New(Props{
+					ID:       "playground-switch",
+					Size:     v.String("Size"),
+					Checked:  v.Bool("Checked"),
+					Disabled: v.Bool("Disabled"),
+				}),
+				html.Label(html.For("playground-switch"), html.Class("text-sm font-medium"), g.Text(v.String("Label"))),
+			)
+		},
+	})
+}
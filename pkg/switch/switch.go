@@ -10,15 +10,16 @@ import (
 
 // Props defines the properties for the Switch component
 type Props struct {
-	ID       string // HTML id attribute
-	Name     string // Form field name
-	Value    string // Form field value
-	Checked  bool   // Whether the switch is on
-	Disabled bool   // Whether the switch is disabled
-	Required bool   // Whether the switch is required
-	Size     string // "sm" | "default" | "lg"
-	Class    string // Additional custom classes
-	OnChange string // JavaScript onChange handler
+	ID       string   // HTML id attribute
+	Name     string   // Form field name
+	Value    string   // Form field value
+	Checked  bool     // Whether the switch is on
+	Disabled bool     // Whether the switch is disabled
+	Required bool     // Whether the switch is required
+	Size     string   // "sm" | "default" | "lg"
+	Class    string   // Additional custom classes
+	OnChange string   // JavaScript onChange handler
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Switch component
@@ -101,7 +102,7 @@ func New(props Props) g.Node {
 		checkboxAttrs = append(checkboxAttrs, g.Attr("onchange", props.OnChange))
 	}
 
-	return html.Label(
+	return html.Label(lib.MergeAttrs([]g.Node{
 		g.If(props.ID != "", html.For(props.ID)),
 		html.Class("relative inline-block"),
 		html.Input(checkboxAttrs...),
@@ -120,7 +121,7 @@ func New(props Props) g.Node {
 				g.Attr("style", fmt.Sprintf("transform: %s;", thumbTransform)),
 			),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // Default creates a switch with default settings
@@ -6,6 +6,7 @@ import (
 	"testing"
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/switch"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestSwitch(t *testing.T) {
@@ -156,4 +157,10 @@ func TestSwitch(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": switchcomp.Example,
+	})
+}
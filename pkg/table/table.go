@@ -2,6 +2,7 @@ package table
 
 import (
 	"fmt"
+	"strconv"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
@@ -12,11 +13,12 @@ import (
 type Props struct {
 	Class string
 	ID    string
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // Table creates a table container with horizontal scroll
 func TableComponent(props Props, children ...g.Node) g.Node {
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		g.Attr("data-table-container", "true"),
 		html.Class("relative w-full overflow-x-auto"),
 		g.If(props.ID != "", g.Attr("id", props.ID+"-container")),
@@ -26,37 +28,37 @@ func TableComponent(props Props, children ...g.Node) g.Node {
 			g.If(props.ID != "", g.Attr("id", props.ID)),
 			g.Group(children),
 		),
-	)
+	}, props.Attrs)...)
 }
 
 // Header creates a table header
 func HeaderComponent(props Props, children ...g.Node) g.Node {
 	return g.El("thead",
-		g.Attr("data-table-header", "true"),
+		lib.MergeAttrs([]g.Node{g.Attr("data-table-header", "true"),
 		html.Class(lib.CN("[&_tr]:border-b", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Body creates a table body
 func Body(props Props, children ...g.Node) g.Node {
 	return g.El("tbody",
-		g.Attr("data-table-body", "true"),
+		lib.MergeAttrs([]g.Node{g.Attr("data-table-body", "true"),
 		html.Class(lib.CN("[&_tr:last-child]:border-0", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Footer creates a table footer
 func FooterComponent(props Props, children ...g.Node) g.Node {
 	return g.El("tfoot",
-		g.Attr("data-table-footer", "true"),
+		lib.MergeAttrs([]g.Node{g.Attr("data-table-footer", "true"),
 		html.Class(lib.CN(
 			"bg-muted/50 border-t font-medium [&>tr]:last:border-b-0",
 			props.Class,
 		)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // RowProps defines properties for table rows
@@ -65,43 +67,66 @@ type RowProps struct {
 	ID       string
 	Selected bool
 	OnClick  string
+	Attrs    []g.Node // Additional attributes to pass through
+}
+
+// Rows and cells are rendered many times per table, so their fixed
+// attributes are prerendered
+var (
+	rowAttr      = lib.Static(g.Attr("data-table-row", "true"))
+	rowClass     = "hover:bg-muted/50 data-[state=selected]:bg-muted border-b transition-colors"
+	rowClasses   = lib.Static(html.Class(rowClass))
+	selectedAttr = lib.Static(g.Attr("data-state", "selected"))
+
+	cellAttr    = lib.Static(g.Attr("data-table-cell", "true"))
+	cellClasses = map[string]g.Node{}
+)
+
+// alignClasses maps the Align of heads and cells to their text alignment
+var alignClasses = map[string]string{
+	"left":   "text-left",
+	"center": "text-center",
+	"right":  "text-right",
+}
+
+func init() {
+	for align := range alignClasses {
+		cellClasses[align] = lib.Static(html.Class(cellClass(align, "")))
+	}
 }
 
 // Row creates a table row
 func Row(props RowProps, children ...g.Node) g.Node {
-	attrs := []g.Node{
-		g.Attr("data-table-row", "true"),
-		html.Class(lib.CN(
-			"hover:bg-muted/50 data-[state=selected]:bg-muted border-b transition-colors",
-			props.Class,
-		)),
+	classes := rowClasses
+	if props.Class != "" {
+		classes = lib.Attr("class", lib.CN(rowClass, props.Class))
 	}
+	nodes := []g.Node{rowAttr, classes, nil, nil, nil}
 
 	if props.ID != "" {
-		attrs = append(attrs, g.Attr("id", props.ID))
+		nodes[2] = lib.Attr("id", props.ID)
 	}
 
 	if props.Selected {
-		attrs = append(attrs, g.Attr("data-state", "selected"))
+		nodes[3] = selectedAttr
 	}
 
 	if props.OnClick != "" {
-		attrs = append(attrs, g.Raw(props.OnClick))
+		nodes[4] = g.Raw(props.OnClick)
 	}
 
-	return g.El("tr",
-		g.Group(append(attrs, children...)),
-	)
+	return lib.Tag("tr", nodes, children, props.Attrs)
 }
 
 // HeadProps defines properties for table headers
 type HeadProps struct {
-	Class     string
-	Sortable  bool
-	Sorted    string // "asc" | "desc" | ""
-	Align     string // "left" | "center" | "right"
-	ColSpan   int
-	RowSpan   int
+	Class    string
+	Sortable bool
+	Sorted   string // "asc" | "desc" | ""
+	Align    string // "left" | "center" | "right"
+	ColSpan  int
+	RowSpan  int
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // Head creates a table header cell
@@ -111,11 +136,7 @@ func Head(props HeadProps, children ...g.Node) g.Node {
 		props.Align = "left"
 	}
 
-	alignClass := map[string]string{
-		"left":   "text-left",
-		"center": "text-center",
-		"right":  "text-right",
-	}[props.Align]
+	alignClass := alignClasses[props.Align]
 
 	attrs := []g.Node{
 		g.Attr("data-table-head", "true"),
@@ -129,11 +150,11 @@ func Head(props HeadProps, children ...g.Node) g.Node {
 	}
 
 	if props.ColSpan > 0 {
-		attrs = append(attrs, g.Attr("colspan", fmt.Sprintf("%d", props.ColSpan)))
+		attrs = append(attrs, g.Attr("colspan", strconv.Itoa(props.ColSpan)))
 	}
 
 	if props.RowSpan > 0 {
-		attrs = append(attrs, g.Attr("rowspan", fmt.Sprintf("%d", props.RowSpan)))
+		attrs = append(attrs, g.Attr("rowspan", strconv.Itoa(props.RowSpan)))
 	}
 
 	if props.Sortable {
@@ -159,8 +180,8 @@ func Head(props HeadProps, children ...g.Node) g.Node {
 	}
 
 	return g.El("th",
-		g.Group(append(attrs, content...)),
-	)
+		lib.MergeAttrs([]g.Node{g.Group(append(attrs, content...)),
+	}, props.Attrs)...)
 }
 
 // CellProps defines properties for table cells
@@ -169,6 +190,7 @@ type CellProps struct {
 	Align   string // "left" | "center" | "right"
 	ColSpan int
 	RowSpan int
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // Cell creates a table cell
@@ -178,42 +200,40 @@ func Cell(props CellProps, children ...g.Node) g.Node {
 		props.Align = "left"
 	}
 
-	alignClass := map[string]string{
-		"left":   "text-left",
-		"center": "text-center",
-		"right":  "text-right",
-	}[props.Align]
-
-	attrs := []g.Node{
-		g.Attr("data-table-cell", "true"),
-		html.Class(lib.CN(
-			"p-2 align-middle whitespace-nowrap",
-			alignClass,
-			"[&:has([role=checkbox])]:pr-0 [&>[role=checkbox]]:translate-y-[2px]",
-			props.Class,
-		)),
+	classes, ok := cellClasses[props.Align]
+	if !ok || props.Class != "" {
+		classes = lib.Attr("class", cellClass(props.Align, props.Class))
 	}
+	nodes := []g.Node{cellAttr, classes, nil, nil}
 
 	if props.ColSpan > 0 {
-		attrs = append(attrs, g.Attr("colspan", fmt.Sprintf("%d", props.ColSpan)))
+		nodes[2] = lib.Attr("colspan", strconv.Itoa(props.ColSpan))
 	}
 
 	if props.RowSpan > 0 {
-		attrs = append(attrs, g.Attr("rowspan", fmt.Sprintf("%d", props.RowSpan)))
+		nodes[3] = lib.Attr("rowspan", strconv.Itoa(props.RowSpan))
 	}
 
-	return g.El("td",
-		g.Group(append(attrs, children...)),
+	return lib.Tag("td", nodes, children, props.Attrs)
+}
+
+// cellClass returns the classes of a cell with align and the custom class
+func cellClass(align, class string) string {
+	return lib.CN(
+		"p-2 align-middle whitespace-nowrap",
+		alignClasses[align],
+		"[&:has([role=checkbox])]:pr-0 [&>[role=checkbox]]:translate-y-[2px]",
+		class,
 	)
 }
 
 // Caption creates a table caption
 func Caption(props Props, children ...g.Node) g.Node {
 	return g.El("caption",
-		g.Attr("data-table-caption", "true"),
+		lib.MergeAttrs([]g.Node{g.Attr("data-table-caption", "true"),
 		html.Class(lib.CN("text-muted-foreground mt-4 text-sm", props.Class)),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Helper functions for common table patterns
@@ -272,7 +292,7 @@ func StickyHeaderTable(props Props, maxHeight string, children ...g.Node) g.Node
 		maxHeight = "400px"
 	}
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class("relative overflow-auto rounded-md border"),
 		g.Attr("style", fmt.Sprintf("max-height: %s", maxHeight)),
 		g.El("table",
@@ -290,5 +310,5 @@ func StickyHeaderTable(props Props, maxHeight string, children ...g.Node) g.Node
 			</style>`),
 			g.Group(children),
 		),
-	)
+	}, props.Attrs)...)
 }
\ No newline at end of file
@@ -2,15 +2,18 @@ package table
 
 import (
 	"fmt"
+	"maps"
 	"net/http"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines properties for HTMX-enhanced tables
@@ -69,7 +72,7 @@ func HTMXSortableHead(props HeadProps, htmxProps HTMXProps, column string, child
 	}
 
 	return g.El("th",
-		append(attrs, append(children, sortIcon)...)...,
+		lib.MergeAttrs(append(attrs, append(children, sortIcon)...), props.Attrs)...,
 	)
 }
 
@@ -93,7 +96,7 @@ func HTMXSelectableRow(props RowProps, htmxProps HTMXProps, rowID string, childr
 	}
 
 	return g.El("tr",
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -113,45 +116,123 @@ type TableData struct {
 	Rows    []map[string]interface{}
 }
 
-var tableStates = make(map[string]*TableState)
-var tableData = make(map[string]*TableData)
+// State holds a table's data and its sorting, selection, page and filter
+// between requests. It's safe for concurrent use. The zero value is set from
+// the data of the Handlers it's first registered with.
+type State struct {
+	mu    sync.Mutex
+	data  *TableData
+	table TableState
+}
 
-// InitializeTable sets up initial table data
-func InitializeTable(id string, headers []string, rows []map[string]interface{}) {
-	tableData[id] = &TableData{
-		Headers: headers,
-		Rows:    rows,
-	}
-	tableStates[id] = &TableState{
+// reset replaces the data and clears the sorting, selection, page and filter
+func (s *State) reset(data TableData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = &data
+	s.table = TableState{
 		SelectedRows: make(map[string]bool),
 		CurrentPage:  1,
 		PageSize:     10,
 	}
 }
 
-// TableHandlers creates HTTP handlers for table functionality
-func TableHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
+// init resets the state to data unless it has data already
+func (s *State) init(data TableData) {
+	s.mu.Lock()
+	ready := s.data != nil
+	s.mu.Unlock()
+	if !ready {
+		s.reset(data)
+	}
+}
+
+// update applies fn to the table state and returns a copy of the result. It
+// reports false when the state has no data.
+func (s *State) update(fn func(state *TableState)) (TableState, *TableData, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data == nil {
+		return TableState{}, nil, false
+	}
+	if fn != nil {
+		fn(&s.table)
+	}
+	state := s.table
+	state.SelectedRows = maps.Clone(s.table.SelectedRows)
+	return state, s.data, true
+}
+
+// legacy holds the state of tables set up with the deprecated
+// InitializeTable and TableHandlers, which share it by ID
+var legacy = struct {
+	sync.Mutex
+	states map[string]*State
+}{states: make(map[string]*State)}
+
+func legacyState(id string) *State {
+	legacy.Lock()
+	defer legacy.Unlock()
+	if legacy.states[id] == nil {
+		legacy.states[id] = &State{}
+	}
+	return legacy.states[id]
+}
+
+// InitializeTable sets up initial table data for TableHandlers
+//
+// Deprecated: Set Handlers.Headers and Handlers.Rows.
+func InitializeTable(id string, headers []string, rows []map[string]interface{}) {
+	legacyState(id).reset(TableData{Headers: headers, Rows: rows})
+}
+
+// Handlers serves the load, sort, select, paginate and filter endpoints of a
+// table. The paths in HTMX are relative to the router the handlers are
+// registered on.
+type Handlers struct {
+	HTMX    HTMXProps                // Table ID and endpoint paths
+	Headers []string                 // Column headers, which are also the row keys
+	Rows    []map[string]interface{} // Rows by column header
+	State   *State                   // State shared between requests (default: a new State per Register)
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Validate required paths
-	if htmxProps.LoadPath == "" {
-		panic("TableHandlers: LoadPath is required")
+	if h.HTMX.LoadPath == "" {
+		panic("table.Handlers: LoadPath is required")
 	}
-	if htmxProps.SortPath == "" {
-		panic("TableHandlers: SortPath is required")
+	if h.HTMX.SortPath == "" {
+		panic("table.Handlers: SortPath is required")
 	}
-	if htmxProps.SelectPath == "" {
-		panic("TableHandlers: SelectPath is required")
+	if h.HTMX.SelectPath == "" {
+		panic("table.Handlers: SelectPath is required")
+	}
+
+	htmxProps := h.HTMX
+	htmxProps.LoadPath = rt.Path(h.HTMX.LoadPath)
+	htmxProps.SortPath = rt.Path(h.HTMX.SortPath)
+	htmxProps.SelectPath = rt.Path(h.HTMX.SelectPath)
+	if h.HTMX.PaginatePath != "" {
+		htmxProps.PaginatePath = rt.Path(h.HTMX.PaginatePath)
+	}
+	if h.HTMX.FilterPath != "" {
+		htmxProps.FilterPath = rt.Path(h.HTMX.FilterPath)
+	}
+
+	// Initialize state
+	state := h.State
+	if state == nil {
+		state = &State{}
+	}
+	if h.Headers != nil {
+		state.init(TableData{Headers: h.Headers, Rows: h.Rows})
 	}
-	
-	// Load handler
-	mux.HandleFunc(htmxProps.LoadPath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
 
-		state := tableStates[htmxProps.ID]
-		data := tableData[htmxProps.ID]
-		if state == nil || data == nil {
+	// Renders the table after applying update to its state
+	serve := func(w http.ResponseWriter, update func(state *TableState)) {
+		state, data, ok := state.update(update)
+		if !ok {
 			http.Error(w, "Table not initialized", http.StatusNotFound)
 			return
 		}
@@ -159,12 +240,12 @@ func TableHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
 		// Apply sorting
 		rows := make([]map[string]interface{}, len(data.Rows))
 		copy(rows, data.Rows)
-		
+
 		if state.SortColumn != "" {
 			sort.Slice(rows, func(i, j int) bool {
 				valI := fmt.Sprintf("%v", rows[i][state.SortColumn])
 				valJ := fmt.Sprintf("%v", rows[j][state.SortColumn])
-				
+
 				if state.SortOrder == "desc" {
 					return valI > valJ
 				}
@@ -187,133 +268,90 @@ func TableHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
 		}
 
 		// Apply pagination
-		start := (state.CurrentPage - 1) * state.PageSize
-		end := start + state.PageSize
-		if end > len(rows) {
-			end = len(rows)
-		}
+		start := min((state.CurrentPage-1)*state.PageSize, len(rows))
+		end := min(start+state.PageSize, len(rows))
 		pageRows := rows[start:end]
 
 		// Render table
-		renderTable(w, data.Headers, pageRows, state, htmxProps)
-	})
+		renderTable(w, data.Headers, pageRows, &state, htmxProps)
+	}
 
-	// Sort handler
-	mux.HandleFunc(htmxProps.SortPath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+	// Load handler
+	rt.Handle(http.MethodGet, h.HTMX.LoadPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serve(w, nil)
+	}))
 
+	// Sort handler
+	rt.Handle(http.MethodGet, h.HTMX.SortPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		column := r.URL.Query().Get("column")
-		state := tableStates[htmxProps.ID]
-		if state == nil {
-			http.Error(w, "Table state not found", http.StatusNotFound)
-			return
-		}
 
-		// Toggle sort order
-		if state.SortColumn == column {
-			if state.SortOrder == "asc" {
-				state.SortOrder = "desc"
-			} else if state.SortOrder == "desc" {
-				state.SortColumn = ""
-				state.SortOrder = ""
+		serve(w, func(state *TableState) {
+			// Toggle sort order
+			if state.SortColumn == column {
+				if state.SortOrder == "asc" {
+					state.SortOrder = "desc"
+				} else if state.SortOrder == "desc" {
+					state.SortColumn = ""
+					state.SortOrder = ""
+				} else {
+					state.SortOrder = "asc"
+				}
 			} else {
+				state.SortColumn = column
 				state.SortOrder = "asc"
 			}
-		} else {
-			state.SortColumn = column
-			state.SortOrder = "asc"
-		}
-
-		// Render updated table
-		handleLoadPath(w, r, htmxProps)
-	})
+		})
+	}))
 
 	// Select handler
-	mux.HandleFunc(htmxProps.SelectPath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
+	rt.Handle(http.MethodPost, h.HTMX.SelectPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.ParseForm()
 		rowID := r.FormValue("rowId")
-		
-		state := tableStates[htmxProps.ID]
-		if state == nil {
-			http.Error(w, "Table state not found", http.StatusNotFound)
-			return
-		}
-
-		// Toggle selection
-		if state.SelectedRows[rowID] {
-			delete(state.SelectedRows, rowID)
-		} else {
-			state.SelectedRows[rowID] = true
-		}
 
-		// Render updated table
-		handleLoadPath(w, r, htmxProps)
-	})
+		serve(w, func(state *TableState) {
+			// Toggle selection
+			if state.SelectedRows[rowID] {
+				delete(state.SelectedRows, rowID)
+			} else {
+				state.SelectedRows[rowID] = true
+			}
+		})
+	}))
 
 	// Pagination handler
 	if htmxProps.PaginatePath != "" {
-		mux.HandleFunc(htmxProps.PaginatePath, func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodGet {
-				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-				return
-			}
-
+		rt.Handle(http.MethodGet, h.HTMX.PaginatePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 			if page < 1 {
 				page = 1
 			}
 
-			state := tableStates[htmxProps.ID]
-			if state == nil {
-				http.Error(w, "Table state not found", http.StatusNotFound)
-				return
-			}
-
-			state.CurrentPage = page
-
-			// Render updated table
-			handleLoadPath(w, r, htmxProps)
-		})
+			serve(w, func(state *TableState) {
+				state.CurrentPage = page
+			})
+		}))
 	}
 
 	// Filter handler
 	if htmxProps.FilterPath != "" {
-		mux.HandleFunc(htmxProps.FilterPath, func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodPost {
-				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-				return
-			}
-
+		rt.Handle(http.MethodPost, h.HTMX.FilterPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			r.ParseForm()
 			filter := r.FormValue("filter")
 
-			state := tableStates[htmxProps.ID]
-			if state == nil {
-				http.Error(w, "Table state not found", http.StatusNotFound)
-				return
-			}
-
-			state.Filter = filter
-			state.CurrentPage = 1 // Reset to first page
-
-			// Render updated table
-			handleLoadPath(w, r, htmxProps)
-		})
+			serve(w, func(state *TableState) {
+				state.Filter = filter
+				state.CurrentPage = 1 // Reset to first page
+			})
+		}))
 	}
 }
 
-// Helper function to handle load path logic
-func handleLoadPath(w http.ResponseWriter, r *http.Request, htmxProps HTMXProps) {
-	// TODO: Implement load path logic
-	TableHandlers(nil, htmxProps)
+// TableHandlers creates HTTP handlers for a table initialized with
+// InitializeTable
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func TableHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
+	Handlers{HTMX: htmxProps, State: legacyState(htmxProps.ID)}.Register(router.ServeMux(mux))
 }
 
 // Helper function to render table
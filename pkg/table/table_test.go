@@ -2,10 +2,21 @@ package table
 
 import (
 	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/rizome-dev/shadcn-gomponents/lib/icons"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/badge"
+	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
 	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestTable(t *testing.T) {
@@ -436,4 +447,98 @@ func TestHelperTables(t *testing.T) {
 			t.Errorf("Expected responsive table wrapper")
 		}
 	})
-}
\ No newline at end of file
+}
+
+// BenchmarkRows renders a 1,000-row table whose rows hold a badge and an
+// icon button, the hot path of data-heavy pages. Compare allocs/op with
+// -benchmem.
+func TestHandlers(t *testing.T) {
+	handlers := Handlers{
+		HTMX: HTMXProps{
+			ID:         "state-table",
+			LoadPath:   "/table/load",
+			SortPath:   "/table/sort",
+			SelectPath: "/table/select",
+		},
+		Headers: []string{"Name"},
+		Rows:    []map[string]interface{}{{"Name": "b"}, {"Name": "a"}, {"Name": "c"}},
+	}
+	a, b := http.NewServeMux(), http.NewServeMux()
+	handlers.Register(router.ServeMux(a))
+	handlers.Register(router.ServeMux(b))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			a.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/table/sort?column=Name", nil))
+		}()
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodPost, "/table/select", strings.NewReader("rowId=row-0"))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			a.ServeHTTP(httptest.NewRecorder(), req)
+		}()
+	}
+	wg.Wait()
+
+	w := httptest.NewRecorder()
+	b.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/table/sort?column=Name", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	body := w.Body.String()
+	if strings.Index(body, ">a</td>") > strings.Index(body, ">b</td>") || !strings.Contains(body, `data-sorted="asc"`) {
+		t.Errorf("expected a table of its own sorted ascending, got %s", body)
+	}
+	if strings.Contains(body, `data-state="selected"`) {
+		t.Errorf("expected no rows selected in the second table, got %s", body)
+	}
+
+	w = httptest.NewRecorder()
+	mux := http.NewServeMux()
+	Handlers{HTMX: handlers.HTMX}.Register(router.ServeMux(mux))
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/table/load", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d without data, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Examples": Examples,
+	})
+}
+
+func BenchmarkRows(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		rows := make([]g.Node, 1000)
+		for i := range rows {
+			rows[i] = Row(RowProps{},
+				Cell(CellProps{Class: "font-medium"}, g.Text("INV"+strconv.Itoa(i))),
+				Cell(CellProps{}, badge.Secondary(g.Text("Paid"))),
+				Cell(CellProps{Align: "right"}, g.Text("$250.00")),
+				Cell(CellProps{Align: "right"},
+					button.New(button.Props{Variant: "ghost", Size: "icon"},
+						icons.MoreHorizontal(),
+						html.Span(html.Class("sr-only"), g.Text("Open menu")),
+					),
+				),
+			)
+		}
+		if err := TableComponent(Props{}, Body(Props{}, rows...)).Render(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTable(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := Examples().Render(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
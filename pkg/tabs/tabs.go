@@ -6,31 +6,36 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
 )
 
 // Props defines the properties for the Tabs component
 type Props struct {
-	DefaultValue string // Default active tab
-	Class        string // Additional custom classes
-	ID           string // Unique identifier for the tabs
+	DefaultValue string   // Default active tab
+	Class        string   // Additional custom classes
+	ID           string   // Unique identifier for the tabs
+	Attrs        []g.Node // Additional attributes to pass through
 }
 
 // ListProps defines the properties for TabsList
 type ListProps struct {
-	Class string // Additional custom classes
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // TriggerProps defines the properties for TabsTrigger
 type TriggerProps struct {
-	Value    string // Unique value for the tab
-	Class    string // Additional custom classes
-	Disabled bool   // Whether the tab is disabled
+	Value    string   // Unique value for the tab
+	Class    string   // Additional custom classes
+	Disabled bool     // Whether the tab is disabled
+	Attrs    []g.Node // Additional attributes to pass through
 }
 
 // ContentProps defines the properties for TabsContent
 type ContentProps struct {
-	Value string // Value that corresponds to a trigger
-	Class string // Additional custom classes
+	Value string   // Value that corresponds to a trigger
+	Class string   // Additional custom classes
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Tabs component
@@ -52,7 +57,7 @@ func New(props Props, children ...g.Node) g.Node {
 	
 	// Add JavaScript for interactivity
 	return g.Group([]g.Node{
-		html.Div(append(attrs, children...)...),
+		html.Div(lib.MergeAttrs(append(attrs, children...), props.Attrs)...),
 		tabsScript(props.ID),
 	})
 }
@@ -64,12 +69,19 @@ func TabsList(props ListProps, children ...g.Node) g.Node {
 		props.Class,
 	)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		dataAttr("slot", "tabs-list"),
 		html.Role("tablist"),
+		ariaAttr("orientation", "horizontal"),
+		roving.Group(roving.Props{
+			Orientation:      roving.OrientationHorizontal,
+			DisableTypeahead: true,
+			ActivateOnFocus:  true,
+		}),
 		g.Group(children),
-	)
+		roving.Script(),
+	}, props.Attrs)...)
 }
 
 // Trigger creates a TabsTrigger component
@@ -82,13 +94,14 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	attrs := []g.Node{
 		html.Type("button"),
 		html.Class(classes),
+		html.ID(fmt.Sprintf("trigger-%s", props.Value)),
 		dataAttr("slot", "tabs-trigger"),
 		dataAttr("tabs-value", props.Value),
 		dataAttr("state", "inactive"),
 		html.Role("tab"),
 		ariaAttr("selected", "false"),
 		ariaAttr("controls", fmt.Sprintf("content-%s", props.Value)),
-		html.TabIndex("-1"),
+		roving.Item(false),
 	}
 	
 	if props.Disabled {
@@ -96,7 +109,7 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	}
 	
 	return html.Button(
-		append(attrs, g.Group(children))...,
+		lib.MergeAttrs(append(attrs, g.Group(children)), props.Attrs)...,
 	)
 }
 
@@ -104,7 +117,7 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 func TabsContent(props ContentProps, children ...g.Node) g.Node {
 	classes := lib.CN("flex-1 outline-none", props.Class)
 	
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class(classes),
 		dataAttr("slot", "tabs-content"),
 		dataAttr("tabs-value", props.Value),
@@ -115,7 +128,7 @@ func TabsContent(props ContentProps, children ...g.Node) g.Node {
 		html.TabIndex("0"),
 		html.Style("display: none;"),
 		g.Group(children),
-	)
+	}, props.Attrs)...)
 }
 
 // Helper functions
@@ -191,7 +204,8 @@ func tabsScript(id string) g.Node {
 			activateTab(firstValue);
 		}
 		
-		// Add click handlers to triggers
+		// Add click handlers to triggers (arrow keys, Home and End are handled
+		// by the roving focus script, which clicks tabs as they gain focus)
 		triggers.forEach(trigger => {
 			trigger.addEventListener('click', () => {
 				if (!trigger.disabled) {
@@ -199,43 +213,6 @@ func tabsScript(id string) g.Node {
 					activateTab(value);
 				}
 			});
-			
-			// Add keyboard navigation
-			trigger.addEventListener('keydown', (e) => {
-				if (trigger.disabled) return;
-				
-				let newIndex = -1;
-				const currentIndex = Array.from(triggers).indexOf(trigger);
-				
-				switch (e.key) {
-					case 'ArrowLeft':
-						e.preventDefault();
-						newIndex = currentIndex - 1;
-						if (newIndex < 0) newIndex = triggers.length - 1;
-						break;
-					case 'ArrowRight':
-						e.preventDefault();
-						newIndex = currentIndex + 1;
-						if (newIndex >= triggers.length) newIndex = 0;
-						break;
-					case 'Home':
-						e.preventDefault();
-						newIndex = 0;
-						break;
-					case 'End':
-						e.preventDefault();
-						newIndex = triggers.length - 1;
-						break;
-				}
-				
-				if (newIndex !== -1) {
-					const newTrigger = triggers[newIndex];
-					if (!newTrigger.disabled) {
-						newTrigger.click();
-						newTrigger.focus();
-					}
-				}
-			});
 		});
 	})();
 	`, selector)))
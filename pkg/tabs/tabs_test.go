@@ -6,7 +6,10 @@ import (
 	"testing"
 	
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 // renderToString renders a node to a string for testing
@@ -308,4 +311,28 @@ func TestDataAndAriaAttributes(t *testing.T) {
 			t.Errorf("expected aria attribute to be rendered correctly, got: %s", html)
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	list := TabsList(ListProps{},
+		Trigger(TriggerProps{Value: "account"}, g.Text("Account")),
+		Trigger(TriggerProps{Value: "password"}, g.Text("Password")),
+	)
+	a11ytest.Conformance(t, list, a11y.Options{}, []string{
+		`role="tablist"`,
+		`aria-orientation="horizontal"`,
+		`data-roving-orientation="horizontal"`,
+		`data-roving-typeahead="false"`,
+		`data-roving-activate=""`,
+		`role="tab"`,
+		`id="trigger-account"`,
+		`tabindex="-1" data-roving-item=""`,
+		"window.shadcnRoving",
+	}, nil)
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
@@ -0,0 +1,42 @@
+package textarea
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+	html "maragu.dev/gomponents/html"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Textarea",
+		Category:    "Forms",
+		Description: "Multiline text input",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Placeholder", Default: "Type your message here."},
+			{Name: "Rows", Type: catalog.Int, Default: "3"},
+			{Name: "Resize", Type: catalog.Enum, Default: "vertical", Options: []string{"none", "both", "horizontal", "vertical"}},
+			{Name: "Disabled", Type: catalog.Bool, Default: "false"},
+			{Name: "ReadOnly", Type: catalog.Bool, Default: "false"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return New(Props{
+				Placeholder: v.String("Placeholder"),
+				Rows:        v.Int("Rows"),
+				Resize:      v.String("Resize"),
+				Disabled:    v.Bool("Disabled"),
+				ReadOnly:    v.Bool("ReadOnly"),
+				Class:       "max-w-sm",
+				Attrs:       []g.Node{html.Aria("label", "Playground textarea")},
+			})
+		},
+	})
+}
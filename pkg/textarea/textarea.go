@@ -10,22 +10,23 @@ import (
 
 // Props defines the properties for the Textarea component
 type Props struct {
-	ID          string // HTML id attribute
-	Name        string // Form field name
-	Value       string // Initial value
-	Placeholder string // Placeholder text
-	Rows        int    // Number of visible rows
-	Cols        int    // Number of visible columns
-	MaxLength   int    // Maximum character length
-	MinLength   int    // Minimum character length
-	Disabled    bool   // Whether the textarea is disabled
-	Required    bool   // Whether the textarea is required
-	ReadOnly    bool   // Whether the textarea is read-only
-	AutoResize  bool   // Whether to enable auto-resize
-	Resize      string // CSS resize property: "none" | "both" | "horizontal" | "vertical"
-	Class       string // Additional custom classes
-	OnChange    string // JavaScript onChange handler
-	OnInput     string // JavaScript onInput handler
+	ID          string   // HTML id attribute
+	Name        string   // Form field name
+	Value       string   // Initial value
+	Placeholder string   // Placeholder text
+	Rows        int      // Number of visible rows
+	Cols        int      // Number of visible columns
+	MaxLength   int      // Maximum character length
+	MinLength   int      // Minimum character length
+	Disabled    bool     // Whether the textarea is disabled
+	Required    bool     // Whether the textarea is required
+	ReadOnly    bool     // Whether the textarea is read-only
+	AutoResize  bool     // Whether to enable auto-resize
+	Resize      string   // CSS resize property: "none" | "both" | "horizontal" | "vertical"
+	Class       string   // Additional custom classes
+	OnChange    string   // JavaScript onChange handler
+	OnInput     string   // JavaScript onInput handler
+	Attrs       []g.Node // Additional attributes to pass through
 }
 
 // New creates a new Textarea component
@@ -115,10 +116,10 @@ func New(props Props) g.Node {
 
 	// Add the value as a child text node if provided
 	if props.Value != "" {
-		return html.Textarea(append(attrs, g.Text(props.Value))...)
+		return html.Textarea(lib.MergeAttrs(append(attrs, g.Text(props.Value)), props.Attrs)...)
 	}
 
-	return html.Textarea(attrs...)
+	return html.Textarea(lib.MergeAttrs(attrs, props.Attrs)...)
 }
 
 // Default creates a textarea with default settings
@@ -6,6 +6,7 @@ import (
 	"testing"
 	g "maragu.dev/gomponents"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/textarea"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestTextarea(t *testing.T) {
@@ -197,4 +198,10 @@ func TestTextarea(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": textarea.Example,
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
+	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/button"
 )
 
@@ -96,6 +97,18 @@ func Example() g.Node {
 				),
 			),
 			
+			// Server-side jobs
+			html.Div(
+				html.H5(html.Class("text-xs font-medium uppercase text-muted-foreground mb-4 mt-8"), g.Text("Server Jobs")),
+				button.New(button.Props{Variant: "outline", Size: "sm"},
+					hx.Post("/htmx/toast/promise"),
+					hx.Target("#promise-toasts"),
+					hx.Swap("beforeend"),
+					g.Text("Start export"),
+				),
+				html.Div(html.ID("promise-toasts"), html.Class("mt-4 space-y-2")),
+			),
+			
 			// Different durations
 			html.Div(
 				html.H5(html.Class("text-xs font-medium uppercase text-muted-foreground mb-4 mt-8"), g.Text("Different Durations")),
@@ -0,0 +1,421 @@
+package toast
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
+	g "maragu.dev/gomponents"
+	hx "maragu.dev/gomponents-htmx"
+	html "maragu.dev/gomponents/html"
+)
+
+// JobState is the lifecycle stage of a Job
+type JobState string
+
+const (
+	JobPending  JobState = "pending"
+	JobSuccess  JobState = "success"
+	JobError    JobState = "error"
+	JobCanceled JobState = "canceled"
+)
+
+// JobStatus is a snapshot of a Job
+type JobStatus struct {
+	State   JobState
+	Message string // Loading, progress, success or error message
+	Percent int    // Completion from 0 to 100, or -1 when unknown
+}
+
+// Done reports whether the job has settled
+func (s JobStatus) Done() bool {
+	return s.State != JobPending
+}
+
+// Job is server-side work a promise toast reports on. The function passed to
+// Jobs.Start receives it to report progress.
+type Job struct {
+	id     string
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	status   JobStatus
+	changed  chan struct{}
+	finished time.Time
+}
+
+// ID returns the job's ID
+func (j *Job) ID() string {
+	return j.id
+}
+
+// Status returns the job's current status
+func (j *Job) Status() JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status
+}
+
+// Progress reports how far the job has got. percent is from 0 to 100, or -1
+// when unknown; a non-empty message replaces the one shown.
+func (j *Job) Progress(percent int, message string) {
+	j.update(func(s *JobStatus) {
+		s.Percent = min(max(percent, -1), 100)
+		if message != "" {
+			s.Message = message
+		}
+	})
+}
+
+// Cancel asks the job to stop by cancelling its context
+func (j *Job) Cancel() {
+	j.cancel()
+}
+
+func (j *Job) update(fn func(s *JobStatus)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status.Done() {
+		return
+	}
+	fn(&j.status)
+	if j.status.Done() {
+		j.finished = time.Now()
+	}
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+// watch returns the current status and a channel closed on the next change
+func (j *Job) watch() (JobStatus, <-chan struct{}) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status, j.changed
+}
+
+// Jobs tracks the jobs behind promise toasts
+type Jobs struct {
+	Retain time.Duration // How long settled jobs can still be queried (default: 5m)
+
+	mu   sync.Mutex
+	jobs map[string]*Job
+}
+
+// NewJobs creates an empty job registry
+func NewJobs() *Jobs {
+	return &Jobs{jobs: make(map[string]*Job)}
+}
+
+// Start runs fn in its own goroutine and returns the job tracking it. The job
+// shows loading until fn returns: a nil error settles it as success with the
+// returned message, an error as failure with the error's message, and an
+// error after Cancel as canceled.
+func (js *Jobs) Start(loading string, fn func(ctx context.Context, job *Job) (string, error)) *Job {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &Job{
+		id:      newJobID(),
+		cancel:  cancel,
+		status:  JobStatus{State: JobPending, Message: loading, Percent: -1},
+		changed: make(chan struct{}),
+	}
+
+	js.mu.Lock()
+	js.prune()
+	if js.jobs == nil {
+		js.jobs = make(map[string]*Job)
+	}
+	js.jobs[job.id] = job
+	js.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		message, err := run(ctx, job, fn)
+		job.update(func(s *JobStatus) {
+			switch {
+			case err != nil && ctx.Err() != nil:
+				s.State, s.Message = JobCanceled, "Canceled"
+			case err != nil:
+				s.State, s.Message = JobError, err.Error()
+			default:
+				s.State, s.Message = JobSuccess, message
+				s.Percent = 100
+			}
+		})
+	}()
+	return job
+}
+
+func run(ctx context.Context, job *Job, fn func(ctx context.Context, job *Job) (string, error)) (message string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job failed: %v", r)
+		}
+	}()
+	return fn(ctx, job)
+}
+
+// Get returns the job with id
+func (js *Jobs) Get(id string) (*Job, bool) {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	js.prune()
+	job, ok := js.jobs[id]
+	return job, ok
+}
+
+// prune drops jobs settled longer than Retain ago; js.mu must be held
+func (js *Jobs) prune() {
+	retain := js.Retain
+	if retain == 0 {
+		retain = 5 * time.Minute
+	}
+	for id, job := range js.jobs {
+		job.mu.Lock()
+		expired := !job.finished.IsZero() && time.Since(job.finished) > retain
+		job.mu.Unlock()
+		if expired {
+			delete(js.jobs, id)
+		}
+	}
+}
+
+func newJobID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic("toast: unable to generate job ID: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
+
+// PromiseHTMXProps defines the endpoints promise toasts update from. Each
+// path has the job ID appended as a final segment.
+type PromiseHTMXProps struct {
+	StatusPath   string        // Polled for the current state (required)
+	EventsPath   string        // Server-sent events of state changes; used instead of polling when set
+	CancelPath   string        // Cancels the job; empty hides the cancel action
+	PollInterval time.Duration // Time between polls (default: 1s)
+}
+
+// PromiseToast renders a toast bound to job. It shows the job's current state
+// and follows it to success or error by polling StatusPath, or over
+// EventsPath when set. Messages are rendered as text, so they need no
+// escaping.
+func PromiseToast(job *Job, htmxProps PromiseHTMXProps) g.Node {
+	status := job.Status()
+	if htmxProps.EventsPath == "" {
+		return promiseBody(job.id, status, htmxProps, !status.Done())
+	}
+
+	// The stream swaps the body into a stable container and is closed by the
+	// final "done" event
+	return html.Div(
+		html.ID(promiseID(job.id)),
+		hx.Ext("sse"),
+		g.Attr("sse-connect", htmxProps.EventsPath+"/"+job.id),
+		g.Attr("sse-swap", "status,done"),
+		g.Attr("sse-close", "done"),
+		hx.Swap("innerHTML"),
+		promiseBody(job.id, status, htmxProps, false),
+	)
+}
+
+func promiseID(jobID string) string {
+	return "promise-toast-" + jobID
+}
+
+func promiseBody(jobID string, status JobStatus, htmxProps PromiseHTMXProps, poll bool) g.Node {
+	variant := VariantDefault
+	icon := getLoadingIcon()
+	switch status.State {
+	case JobSuccess:
+		variant, icon = VariantSuccess, getDefaultIcon(VariantSuccess)
+	case JobError:
+		variant, icon = VariantError, getDefaultIcon(VariantError)
+	case JobCanceled:
+		icon = nil
+	}
+
+	attrs := []g.Node{
+		g.Attr("data-toast", "true"),
+		g.Attr("data-variant", string(variant)),
+		g.Attr("data-job-state", string(status.State)),
+		html.Class(lib.CN(
+			"toast",
+			"relative flex w-full flex-col gap-2 overflow-hidden rounded-md border p-4 pr-6 shadow-lg transition-all",
+			getVariantClasses(variant),
+		)),
+	}
+	if status.State == JobError {
+		attrs = append(attrs, g.Attr("role", "alert"))
+	} else {
+		attrs = append(attrs, g.Attr("role", "status"), g.Attr("aria-live", "polite"))
+	}
+	if status.State == JobSuccess {
+		attrs = append(attrs, g.Attr("data-duration", "4000"))
+	}
+	if poll {
+		interval := htmxProps.PollInterval
+		if interval == 0 {
+			interval = time.Second
+		}
+		attrs = append(attrs,
+			html.ID(promiseID(jobID)),
+			hx.Get(htmxProps.StatusPath+"/"+jobID),
+			hx.Trigger(fmt.Sprintf("every %dms", interval.Milliseconds())),
+			hx.Target("this"),
+			hx.Swap("outerHTML"),
+		)
+	} else if htmxProps.EventsPath == "" {
+		attrs = append(attrs, html.ID(promiseID(jobID)))
+	}
+
+	row := []g.Node{html.Class("flex w-full items-center gap-2")}
+	if icon != nil {
+		row = append(row, html.Div(html.Class("flex-shrink-0"), icon))
+	}
+	row = append(row, html.Div(html.Class("flex-1 text-sm"), g.Text(status.Message)))
+	if !status.Done() && htmxProps.CancelPath != "" {
+		row = append(row, html.Button(
+			html.Type("button"),
+			html.Class(lib.CN(
+				"ml-auto flex-shrink-0 rounded-md px-3 py-1 text-sm font-medium",
+				"hover:opacity-90 focus:outline-none focus:ring-2 focus:ring-offset-2",
+				getActionButtonClasses(variant),
+			)),
+			hx.Post(htmxProps.CancelPath+"/"+jobID),
+			hx.Swap("none"),
+			g.Text("Cancel"),
+		))
+	}
+
+	content := []g.Node{html.Div(row...)}
+	if !status.Done() && status.Percent >= 0 {
+		content = append(content, html.Div(
+			g.Attr("role", "progressbar"),
+			g.Attr("aria-label", "Progress"),
+			g.Attr("aria-valuemin", "0"),
+			g.Attr("aria-valuemax", "100"),
+			g.Attr("aria-valuenow", fmt.Sprint(status.Percent)),
+			html.Class("h-1 w-full overflow-hidden rounded-full bg-secondary"),
+			html.Div(
+				html.Class("h-full bg-primary transition-all"),
+				html.Style(fmt.Sprintf("width: %d%%", status.Percent)),
+			),
+		))
+	}
+	if status.Done() {
+		content = append(content, html.Button(
+			html.Type("button"),
+			html.Class("toast-close absolute right-1 top-1 rounded-md p-1 opacity-70 transition-opacity hover:opacity-100 focus:outline-none focus:ring-2"),
+			g.Attr("aria-label", "Close"),
+			g.Attr("onclick", `const t = this.closest('[data-toast]'); t.setAttribute('data-state', 'closed'); setTimeout(() => t.remove(), 300);`),
+			g.Raw(getCloseIconString()),
+		))
+	}
+
+	return html.Div(append(attrs, content...)...)
+}
+
+// PromiseHandlers serves the status, events and cancel endpoints of promise
+// toasts. The paths in HTMX are relative to the router the handlers are
+// registered on; PromiseToast needs them as the browser requests them.
+type PromiseHandlers struct {
+	Jobs *Jobs            // Jobs the toasts report on
+	HTMX PromiseHTMXProps // Endpoint paths
+}
+
+// Register adds the routes to rt
+func (h PromiseHandlers) Register(rt router.Router) {
+	if h.Jobs == nil {
+		panic("toast.PromiseHandlers: Jobs is required")
+	}
+	if h.HTMX.StatusPath == "" {
+		panic("toast.PromiseHandlers: StatusPath is required")
+	}
+
+	htmxProps := h.HTMX
+	htmxProps.StatusPath = rt.Path(h.HTMX.StatusPath)
+	if h.HTMX.EventsPath != "" {
+		htmxProps.EventsPath = rt.Path(h.HTMX.EventsPath)
+	}
+	if h.HTMX.CancelPath != "" {
+		htmxProps.CancelPath = rt.Path(h.HTMX.CancelPath)
+	}
+
+	rt.Handle(http.MethodGet, h.HTMX.StatusPath+"/{job}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		job, ok := h.Jobs.Get(r.PathValue("job"))
+		if !ok {
+			// 286 tells htmx to stop polling; the empty body removes the toast
+			w.WriteHeader(286)
+			return
+		}
+		status := job.Status()
+		promiseBody(job.id, status, htmxProps, !status.Done()).Render(w)
+	}))
+
+	if h.HTMX.EventsPath != "" {
+		rt.Handle(http.MethodGet, h.HTMX.EventsPath+"/{job}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			flusher, ok := w.(http.Flusher)
+			if !ok {
+				http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+
+			job, ok := h.Jobs.Get(r.PathValue("job"))
+			if !ok {
+				writeEvent(w, "done", "")
+				flusher.Flush()
+				return
+			}
+			for {
+				status, changed := job.watch()
+				var body strings.Builder
+				_ = promiseBody(job.id, status, htmxProps, false).Render(&body)
+				event := "status"
+				if status.Done() {
+					event = "done"
+				}
+				writeEvent(w, event, body.String())
+				flusher.Flush()
+				if status.Done() {
+					return
+				}
+				select {
+				case <-changed:
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}))
+	}
+
+	if h.HTMX.CancelPath != "" {
+		rt.Handle(http.MethodPost, h.HTMX.CancelPath+"/{job}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			job, ok := h.Jobs.Get(r.PathValue("job"))
+			if !ok {
+				http.Error(w, "Job not found", http.StatusNotFound)
+				return
+			}
+			job.Cancel()
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+}
+
+// writeEvent writes a server-sent event, splitting data across data lines
+func writeEvent(w http.ResponseWriter, event, data string) {
+	fmt.Fprintf(w, "event: %s\n", event)
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	fmt.Fprint(w, "\n")
+}
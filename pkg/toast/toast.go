@@ -2,11 +2,13 @@ package toast
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/flash"
 )
 
 // Variant defines the visual style of the toast
@@ -46,21 +48,25 @@ type Props struct {
 	Progress    bool          // Show progress bar
 	Class       string        // Additional CSS classes
 	OnClose     string        // JavaScript to run on close
+	Attrs       []g.Node      // Additional attributes to pass through
 }
 
 // ActionProps defines properties for toast action buttons
 type ActionProps struct {
-	Label   string // Button text
-	OnClick string // JavaScript click handler
-	Class   string // Additional CSS classes
+	Label   string   // Button text
+	OnClick string   // JavaScript click handler
+	Class   string   // Additional CSS classes
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // ToasterProps defines properties for the Toaster container
 type ToasterProps struct {
-	ID       string   // Container ID
-	Position Position // Where to show toasts
-	MaxToast int      // Maximum visible toasts
-	Class    string   // Additional CSS classes
+	ID       string          // Container ID
+	Position Position        // Where to show toasts
+	MaxToast int             // Maximum visible toasts
+	Class    string          // Additional CSS classes
+	Flash    []flash.Message // Messages drained from a flash store, shown on render
+	Attrs    []g.Node        // Additional attributes to pass through
 }
 
 // New creates a single toast notification
@@ -133,7 +139,7 @@ func New(props Props) g.Node {
 
 	// Add action button if provided
 	if props.Action != nil {
-		contentNodes = append(contentNodes, html.Button(
+		contentNodes = append(contentNodes, html.Button(lib.MergeAttrs([]g.Node{
 			html.Type("button"),
 			html.Class(lib.CN(
 				"ml-auto flex-shrink-0 rounded-md px-3 py-1 text-sm font-medium",
@@ -144,7 +150,7 @@ func New(props Props) g.Node {
 			// Use g.Raw to inject the onclick attribute without escaping
 			g.If(props.Action.OnClick != "", g.Raw(` onclick="`+props.Action.OnClick+`"`)),
 			g.Text(props.Action.Label),
-		))
+		}, props.Action.Attrs)...))
 	}
 
 	// Build the toast
@@ -185,7 +191,7 @@ func New(props Props) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, toastContent...)...,
+		lib.MergeAttrs(append(attrs, toastContent...), props.Attrs)...,
 	)
 }
 
@@ -202,7 +208,7 @@ func Toaster(props ToasterProps) g.Node {
 		props.ID = "toaster"
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(props.ID),
 		g.Attr("data-toaster", "true"),
 		g.Attr("data-position", string(props.Position)),
@@ -220,7 +226,26 @@ func Toaster(props ToasterProps) g.Node {
 				to { width: 0%; }
 			}
 		`),
-	)
+		flashToasts(props),
+		g.If(len(props.Flash) > 0, flash.Script()),
+	}, props.Attrs)...)
+}
+
+// flashToasts renders messages drained from a flash store
+func flashToasts(props ToasterProps) g.Node {
+	toasts := make([]g.Node, 0, len(props.Flash))
+	for i, m := range props.Flash {
+		toasts = append(toasts, New(Props{
+			ID:          fmt.Sprintf("%s-flash-%d", props.ID, i),
+			Title:       m.Title,
+			Description: m.Description,
+			Variant:     Variant(m.Type),
+			Duration:    5 * time.Second,
+			Closable:    true,
+			Attrs:       []g.Node{flash.Item()},
+		}))
+	}
+	return g.Group(toasts)
 }
 
 // Helper functions for creating common toasts
@@ -302,6 +327,8 @@ func WithAction(title, description, actionLabel string, actionHandler string) g.
 }
 
 // Promise creates a promise-style toast (loading -> success/error)
+//
+// Deprecated: Use PromiseToast, which follows a server-side Job.
 func Promise(id, loadingMessage string) g.Node {
 	return New(Props{
 		ID:          id,
@@ -312,35 +339,64 @@ func Promise(id, loadingMessage string) g.Node {
 	})
 }
 
-// Helper to update a promise toast to success
+// PromiseSuccess returns JavaScript that moves a Promise toast to success.
+// The ID and message are quoted as JavaScript strings.
+//
+// Deprecated: Use PromiseToast, which follows a server-side Job.
 func PromiseSuccess(id, message string) string {
 	return fmt.Sprintf(`
-		const toast = document.getElementById('%s');
+		const toast = document.getElementById(%s);
 		if (toast) {
-			toast.querySelector('.text-sm').textContent = '%s';
+			toast.querySelector('.text-sm').textContent = %s;
 			toast.setAttribute('data-variant', 'success');
 			const icon = toast.querySelector('svg').parentElement;
-			icon.innerHTML = '%s';
+			icon.innerHTML = %s;
 			setTimeout(() => {
 				toast.setAttribute('data-state', 'closed');
 				setTimeout(() => toast.remove(), 300);
 			}, 3000);
 		}
-	`, id, message, getSuccessIconString())
+	`, jsString(id), jsString(message), jsString(getSuccessIconString()))
 }
 
-// Helper to update a promise toast to error
+// PromiseError returns JavaScript that moves a Promise toast to error. The ID
+// and message are quoted as JavaScript strings.
+//
+// Deprecated: Use PromiseToast, which follows a server-side Job.
 func PromiseError(id, message string) string {
+	closeButton := `<button type="button" class="toast-close absolute right-1 top-1 rounded-md p-1 opacity-70 transition-opacity hover:opacity-100" aria-label="Close" onclick="this.closest('[data-toast]').setAttribute('data-state', 'closed'); setTimeout(() => this.closest('[data-toast]').remove(), 300);">` + getCloseIconString() + `</button>`
 	return fmt.Sprintf(`
-		const toast = document.getElementById('%s');
+		const toast = document.getElementById(%s);
 		if (toast) {
-			toast.querySelector('.text-sm').textContent = '%s';
+			toast.querySelector('.text-sm').textContent = %s;
 			toast.setAttribute('data-variant', 'error');
 			const icon = toast.querySelector('svg').parentElement;
-			icon.innerHTML = '%s';
-			toast.innerHTML += '<button type="button" class="toast-close absolute right-1 top-1 rounded-md p-1 opacity-70 transition-opacity hover:opacity-100" onclick="this.closest(\\'[data-toast]\\').setAttribute(\\'data-state\\', \\'closed\\'); setTimeout(() => this.closest(\\'[data-toast]\\').remove(), 300);">%s</button>';
+			icon.innerHTML = %s;
+			toast.insertAdjacentHTML('beforeend', %s);
 		}
-	`, id, message, getErrorIconString(), getCloseIconString())
+	`, jsString(id), jsString(message), jsString(getErrorIconString()), jsString(closeButton))
+}
+
+// jsString quotes s as a single-quoted JavaScript string literal that is also
+// safe inside a script element or an HTML attribute
+func jsString(s string) string {
+	var b strings.Builder
+	b.WriteByte('\'')
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '\'':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r < 0x20 || r == '<' || r == '>' || r == '&' || r == '"' || r == '\u2028' || r == '\u2029':
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('\'')
+	return b.String()
 }
 
 // Styling helper functions
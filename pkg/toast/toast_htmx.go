@@ -11,6 +11,7 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines properties for HTMX-enhanced toasts
@@ -145,7 +146,7 @@ func HTMXToast(props Props, htmxProps HTMXProps) g.Node {
 	}
 
 	return html.Div(
-		append(attrs, toastContent...)...,
+		lib.MergeAttrs(append(attrs, toastContent...), props.Attrs)...,
 	)
 }
 
@@ -162,7 +163,7 @@ func HTMXToaster(props ToasterProps, htmxProps HTMXProps) g.Node {
 		props.ID = "htmx-toaster"
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.ID(props.ID),
 		g.Attr("data-toaster", "true"),
 		g.Attr("data-position", string(props.Position)),
@@ -188,33 +189,111 @@ func HTMXToaster(props ToasterProps, htmxProps HTMXProps) g.Node {
 				opacity: 0;
 			}
 		`),
-	)
+	}, props.Attrs)...)
 }
 
-// Toast storage
+// ToastStore holds the toasts shown by Handlers. The zero value is an empty
+// store.
 type ToastStore struct {
 	mu     sync.RWMutex
 	toasts map[string]*Props
 	order  []string
+	count  int
 }
 
-var globalToastStore = &ToastStore{
-	toasts: make(map[string]*Props),
-	order:  []string{},
+// globalToastStore holds the toasts of the deprecated ToastHandlers
+var globalToastStore = &ToastStore{}
+
+// add stores props under a new ID, dropping the oldest toasts beyond max
+func (s *ToastStore) add(props *Props, max int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.toasts == nil {
+		s.toasts = make(map[string]*Props)
+	}
+	s.count++
+	props.ID = fmt.Sprintf("toast-%d-%d", time.Now().UnixNano(), s.count)
+	s.toasts[props.ID] = props
+	s.order = append(s.order, props.ID)
+
+	if len(s.order) > max {
+		// Remove oldest toasts
+		toRemove := len(s.order) - max
+		for i := 0; i < toRemove; i++ {
+			delete(s.toasts, s.order[i])
+		}
+		s.order = s.order[toRemove:]
+	}
 }
 
-// ToastHandlers creates HTTP handlers for toast functionality
-func ToastHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
+// remove deletes the toast with id
+func (s *ToastStore) remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.toasts, id)
+	newOrder := []string{}
+	for _, o := range s.order {
+		if o != id {
+			newOrder = append(newOrder, o)
+		}
+	}
+	s.order = newOrder
+}
+
+// clear deletes all toasts
+func (s *ToastStore) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.toasts = nil
+	s.order = nil
+}
+
+// Toasts returns the stored toasts, oldest first
+func (s *ToastStore) Toasts() []Props {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	toasts := make([]Props, 0, len(s.order))
+	for _, id := range s.order {
+		toasts = append(toasts, *s.toasts[id])
+	}
+	return toasts
+}
+
+// Handlers serves the show, dismiss and clear endpoints of the toaster. The paths
+// in HTMX are relative to the router the handlers are registered on.
+type Handlers struct {
+	HTMX  HTMXProps   // Toaster ID and endpoint paths
+	Store *ToastStore // Toasts shared between requests (default: a new ToastStore per Register)
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Validate required paths
-	if htmxProps.ShowPath == "" {
-		panic("ToastHandlers: ShowPath is required")
+	if h.HTMX.ShowPath == "" {
+		panic("toast.Handlers: ShowPath is required")
 	}
-	if htmxProps.DismissPath == "" {
-		panic("ToastHandlers: DismissPath is required")
+	if h.HTMX.DismissPath == "" {
+		panic("toast.Handlers: DismissPath is required")
+	}
+
+	htmxProps := h.HTMX
+	htmxProps.ShowPath = rt.Path(h.HTMX.ShowPath)
+	htmxProps.DismissPath = rt.Path(h.HTMX.DismissPath)
+	if h.HTMX.ClearPath != "" {
+		htmxProps.ClearPath = rt.Path(h.HTMX.ClearPath)
+	}
+
+	store := h.Store
+	if store == nil {
+		store = &ToastStore{}
 	}
 	
 	// Show toast handler
-	mux.HandleFunc(htmxProps.ShowPath, func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle("", h.HTMX.ShowPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet && r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
@@ -251,30 +330,15 @@ func ToastHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
 		}
 		props.Closable = true
 
-		// Store toast
-		globalToastStore.mu.Lock()
-		props.ID = fmt.Sprintf("toast-%d", time.Now().UnixNano())
-		globalToastStore.toasts[props.ID] = &props
-		globalToastStore.order = append(globalToastStore.order, props.ID)
-		
-		// Limit number of toasts
-		maxToasts := 3
-		if len(globalToastStore.order) > maxToasts {
-			// Remove oldest toasts
-			toRemove := len(globalToastStore.order) - maxToasts
-			for i := 0; i < toRemove; i++ {
-				delete(globalToastStore.toasts, globalToastStore.order[i])
-			}
-			globalToastStore.order = globalToastStore.order[toRemove:]
-		}
-		globalToastStore.mu.Unlock()
+		// Store toast, keeping at most 3
+		store.add(&props, 3)
 
 		// Render the toast
 		HTMXToast(props, htmxProps).Render(w)
-	})
+	}))
 
 	// Dismiss toast handler
-	mux.HandleFunc(htmxProps.DismissPath, func(w http.ResponseWriter, r *http.Request) {
+	rt.Handle("", h.HTMX.DismissPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		toastID := r.URL.Query().Get("id")
 		if toastID == "" {
 			http.Error(w, "Missing toast ID", http.StatusBadRequest)
@@ -282,41 +346,36 @@ func ToastHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
 		}
 
 		// Remove from store
-		globalToastStore.mu.Lock()
-		delete(globalToastStore.toasts, toastID)
-		newOrder := []string{}
-		for _, id := range globalToastStore.order {
-			if id != toastID {
-				newOrder = append(newOrder, id)
-			}
-		}
-		globalToastStore.order = newOrder
-		globalToastStore.mu.Unlock()
+		store.remove(toastID)
 
 		// Return empty response (toast will remove itself)
 		w.WriteHeader(http.StatusOK)
-	})
+	}))
 
 	// Clear all toasts handler
 	if htmxProps.ClearPath != "" {
-		mux.HandleFunc(htmxProps.ClearPath, func(w http.ResponseWriter, r *http.Request) {
+		rt.Handle("", h.HTMX.ClearPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodPost && r.Method != http.MethodDelete {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 				return
 			}
 
 			// Clear store
-			globalToastStore.mu.Lock()
-			globalToastStore.toasts = make(map[string]*Props)
-			globalToastStore.order = []string{}
-			globalToastStore.mu.Unlock()
+			store.clear()
 
 			// Return empty toaster
 			html.Div(html.ID(htmxProps.ToasterID)).Render(w)
-		})
+		}))
 	}
 }
 
+// ToastHandlers creates HTTP handlers for toast functionality
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func ToastHandlers(mux *http.ServeMux, htmxProps HTMXProps) {
+	Handlers{HTMX: htmxProps, Store: globalToastStore}.Register(router.ServeMux(mux))
+}
+
 // ShowToastScript generates JavaScript to trigger a toast via HTMX
 func ShowToastScript(title, description string, variant Variant) string {
 	return fmt.Sprintf(`
@@ -2,12 +2,18 @@ package toast_test
 
 import (
 	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 	g "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
+	"github.com/rizome-dev/shadcn-gomponents/lib/flash"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/toast"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -276,6 +282,21 @@ func TestToaster(t *testing.T) {
 				`data-max-toasts="5"`,
 			},
 		},
+		{
+			name: "toaster with flash messages",
+			toaster: toast.Toaster(toast.ToasterProps{
+				Flash: []flash.Message{{Type: flash.Error, Title: "Payment failed"}},
+			}),
+			contains: []string{
+				`id="toaster-flash-0"`,
+				`data-variant="error"`,
+				`data-duration="5000"`,
+				`data-flash=""`,
+				`Payment failed`,
+				`aria-label="Close"`,
+				`<script>`,
+			},
+		},
 		{
 			name: "toaster with custom ID",
 			toaster: toast.Toaster(toast.ToasterProps{
@@ -421,4 +442,166 @@ func TestToastShouldNotContain(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestPromiseScriptsEscapeMessages(t *testing.T) {
+	message := `it's done</script><img src=x onerror=alert(1)>`
+	for _, script := range []string{
+		toast.PromiseSuccess("a'b", message),
+		toast.PromiseError("a'b", message),
+	} {
+		for _, unsafe := range []string{`'a'b'`, `it's`, `</script>`, `<img`} {
+			if strings.Contains(script, unsafe) {
+				t.Errorf("expected script not to contain %q.\nGot: %s", unsafe, script)
+			}
+		}
+		if !strings.Contains(script, `'it\'s done\u003c/script\u003e`) {
+			t.Errorf("expected script to contain the escaped message.\nGot: %s", script)
+		}
+	}
+}
+
+func TestPromiseToast(t *testing.T) {
+	jobs := toast.NewJobs()
+	htmxProps := toast.PromiseHTMXProps{
+		StatusPath: "/jobs/status",
+		EventsPath: "/jobs/events",
+		CancelPath: "/jobs/cancel",
+	}
+	mux := http.NewServeMux()
+	router.Mount(router.ServeMux(mux), toast.PromiseHandlers{Jobs: jobs, HTMX: htmxProps})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	progressed := make(chan struct{})
+	finish := make(chan error)
+	job := jobs.Start("Exporting <rows>...", func(ctx context.Context, job *toast.Job) (string, error) {
+		job.Progress(40, "Exported 4 of 10")
+		close(progressed)
+		select {
+		case err := <-finish:
+			return "Export <ready>", err
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	})
+	<-progressed
+
+	polling := renderToString(toast.PromiseToast(job, toast.PromiseHTMXProps{StatusPath: "/jobs/status"}))
+	for _, expected := range []string{
+		`hx-get="/jobs/status/` + job.ID() + `"`,
+		`hx-trigger="every 1000ms"`,
+		`aria-valuenow="40"`,
+		`Exported 4 of 10`,
+	} {
+		if !strings.Contains(polling, expected) {
+			t.Errorf("expected polling toast to contain %q.\nGot: %s", expected, polling)
+		}
+	}
+
+	streaming := renderToString(toast.PromiseToast(job, htmxProps))
+	for _, expected := range []string{
+		`sse-connect="/jobs/events/` + job.ID() + `"`,
+		`sse-close="done"`,
+		`hx-post="/jobs/cancel/` + job.ID() + `"`,
+	} {
+		if !strings.Contains(streaming, expected) {
+			t.Errorf("expected streaming toast to contain %q.\nGot: %s", expected, streaming)
+		}
+	}
+
+	finish <- nil
+	for !job.Status().Done() {
+		time.Sleep(time.Millisecond)
+	}
+
+	w := get("/jobs/status/" + job.ID())
+	if body := w.Body.String(); !strings.Contains(body, `data-job-state="success"`) || !strings.Contains(body, "Export &lt;ready&gt;") || strings.Contains(body, "hx-trigger") {
+		t.Errorf("status after success = %s", body)
+	}
+	if w := get("/jobs/status/unknown"); w.Code != 286 {
+		t.Errorf("status of unknown job = %d, want 286", w.Code)
+	}
+
+	w = get("/jobs/events/" + job.ID())
+	if body := w.Body.String(); !strings.HasPrefix(body, "event: done\ndata: <div") {
+		t.Errorf("events after success = %q", body)
+	}
+}
+
+func TestPromiseToastCancel(t *testing.T) {
+	jobs := toast.NewJobs()
+	mux := http.NewServeMux()
+	router.Mount(router.ServeMux(mux), toast.PromiseHandlers{Jobs: jobs, HTMX: toast.PromiseHTMXProps{
+		StatusPath: "/jobs/status",
+		EventsPath: "/jobs/events",
+		CancelPath: "/jobs/cancel",
+	}})
+
+	job := jobs.Start("Working...", func(ctx context.Context, job *toast.Job) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+
+	events := make(chan string)
+	go func() {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs/events/"+job.ID(), nil))
+		events <- w.Body.String()
+	}()
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/jobs/cancel/"+job.ID(), nil))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("cancel = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	select {
+	case body := <-events:
+		if !strings.Contains(body, "event: done\n") || !strings.Contains(body, `data-job-state="canceled"`) {
+			t.Errorf("events = %q, want a canceled done event", body)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event stream did not finish after cancel")
+	}
+}
+
+func TestHandlersStore(t *testing.T) {
+	handlers := toast.Handlers{
+		HTMX: toast.HTMXProps{ToasterID: "toaster", ShowPath: "/toast/show", DismissPath: "/toast/dismiss", ClearPath: "/toast/clear"},
+	}
+	store := &toast.ToastStore{}
+	handlers.Store = store
+	mux := http.NewServeMux()
+	handlers.Register(router.ServeMux(mux))
+	handlers.Store = nil
+	handlers.Register(router.ServeMux(http.NewServeMux()))
+
+	for _, title := range []string{"One", "Two", "Three", "Four"} {
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/toast/show?title="+title, nil))
+	}
+	toasts := store.Toasts()
+	if len(toasts) != 3 || toasts[0].Title != "Two" || toasts[2].Title != "Four" {
+		t.Fatalf("expected the 3 newest toasts in the shared store, got %v", toasts)
+	}
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/toast/dismiss?id="+toasts[0].ID, nil))
+	if got := store.Toasts(); len(got) != 2 || got[0].Title != "Three" {
+		t.Errorf("expected the dismissed toast to be removed, got %v", got)
+	}
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/toast/clear", nil))
+	if got := store.Toasts(); len(got) != 0 {
+		t.Errorf("expected no toasts after clearing, got %v", got)
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": toast.Example,
+	})
+}
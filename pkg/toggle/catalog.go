@@ -0,0 +1,38 @@
+package toggle
+
+import (
+	_ "embed"
+
+	"github.com/rizome-dev/shadcn-gomponents/lib/catalog"
+	g "maragu.dev/gomponents"
+)
+
+//go:embed example.go
+var exampleSource string
+
+func init() {
+	catalog.Register(catalog.Component{
+		Name:        "Toggle",
+		Category:    "Forms",
+		Description: "Toggle button component",
+		Source:      exampleSource,
+		Examples: []catalog.Example{
+			{Name: "Overview", Func: "Example", Render: Example},
+		},
+		Props: []catalog.Prop{
+			{Name: "Variant", Type: catalog.Enum, Default: "default", Options: []string{"default", "outline"}},
+			{Name: "Size", Type: catalog.Enum, Default: "default", Options: []string{"sm", "default", "lg"}},
+			{Name: "Pressed", Type: catalog.Bool, Default: "false"},
+			{Name: "Disabled", Type: catalog.Bool, Default: "false"},
+			{Name: "Label", Default: "Bold", Description: "Text of the toggle"},
+		},
+		Playground: func(v catalog.Values) g.Node {
+			return New(Props{
+				Variant:  v.String("Variant"),
+				Size:     v.String("Size"),
+				Pressed:  v.Bool("Pressed"),
+				Disabled: v.Bool("Disabled"),
+			}, g.Text(v.String("Label")))
+		},
+	})
+}
@@ -82,14 +82,9 @@ func New(props Props, children ...g.Node) g.Node {
 	if props.OnClick != "" {
 		attrs = append(attrs, g.Attr("onclick", props.OnClick))
 	}
-	
-	// Add any additional attributes
-	if len(props.Attrs) > 0 {
-		attrs = append(attrs, props.Attrs...)
-	}
 
 	// Combine attributes and children
-	return html.Button(append(attrs, children...)...)
+	return html.Button(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Default creates a toggle with default settings
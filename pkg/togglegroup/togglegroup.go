@@ -5,6 +5,7 @@ import (
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/toggle"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
 )
 
 // SelectionType defines the selection type for the toggle group
@@ -24,16 +25,18 @@ type Props struct {
 	Size     string        // "sm" | "default" | "lg"
 	Disabled bool          // Whether the entire group is disabled
 	Class    string        // Additional custom classes
+	Attrs    []g.Node      // Additional attributes to pass through
 }
 
 // ItemProps defines the properties for a ToggleGroupItem
 type ItemProps struct {
-	Value       string // The value this item represents
-	Pressed     bool   // Whether this item is pressed/selected
-	Disabled    bool   // Whether this specific item is disabled
-	AriaLabel   string // Accessibility label
-	Class       string // Additional custom classes
-	OnClick     string // JavaScript onClick handler
+	Value     string   // The value this item represents
+	Pressed   bool     // Whether this item is pressed/selected
+	Disabled  bool     // Whether this specific item is disabled
+	AriaLabel string   // Accessibility label
+	Class     string   // Additional custom classes
+	OnClick   string   // JavaScript onClick handler
+	Attrs     []g.Node // Additional attributes to pass through
 }
 
 // New creates a new ToggleGroup component
@@ -83,8 +86,13 @@ func New(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, g.Attr("data-value", valueStr))
 	}
 
+	attrs = append(attrs, roving.Group(roving.Props{
+		Orientation:      roving.OrientationHorizontal,
+		DisableTypeahead: true,
+	}))
+
 	return html.Div(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(append(attrs, roving.Script()), children...), props.Attrs)...,
 	)
 }
 
@@ -119,9 +127,10 @@ func Item(props ItemProps, groupProps Props, children ...g.Node) g.Node {
 			props.Class,
 		),
 		OnClick: props.OnClick,
-		Attrs: []g.Node{
+		Attrs: append([]g.Node{
 			g.Attr("data-value", props.Value),
-		},
+			roving.Item(pressed),
+		}, props.Attrs...),
 	}
 
 	// Create and return the toggle
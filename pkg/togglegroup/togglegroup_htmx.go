@@ -1,16 +1,21 @@
 package togglegroup
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
 
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/toggle"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/roving"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines properties for HTMX-enhanced toggle groups
@@ -62,8 +67,13 @@ func HTMXToggleGroup(props Props, htmxProps HTMXProps, children ...g.Node) g.Nod
 		attrs = append(attrs, g.Attr("data-value", valueStr))
 	}
 
+	attrs = append(attrs, roving.Group(roving.Props{
+		Orientation:      roving.OrientationHorizontal,
+		DisableTypeahead: true,
+	}))
+
 	return html.Div(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(append(attrs, roving.Script()), children...), props.Attrs)...,
 	)
 }
 
@@ -110,6 +120,7 @@ func HTMXItem(props ItemProps, groupProps Props, htmxProps HTMXProps, children .
 		g.Attr("aria-pressed", fmt.Sprintf("%t", pressed)),
 		g.Attr("data-state", lib.CNIf(pressed, "on", "off")),
 		g.Attr("data-value", props.Value),
+		roving.Item(pressed),
 		html.Class(toggle.GetToggleClasses(toggleProps)),
 		hx.Post(htmxProps.TogglePath),
 		hx.Target("#" + htmxProps.ID),
@@ -126,7 +137,7 @@ func HTMXItem(props ItemProps, groupProps Props, htmxProps HTMXProps, children .
 	}
 
 	return html.Button(
-		append(buttonAttrs, buttonContent...)...,
+		lib.MergeAttrs(append(buttonAttrs, buttonContent...), props.Attrs)...,
 	)
 }
 
@@ -136,69 +147,131 @@ type ToggleGroupState struct {
 	Values []string
 }
 
-// Server-side state management
-var toggleGroupStates = make(map[string]*ToggleGroupState)
+// State holds a toggle group's pressed values between requests. It's safe
+// for concurrent use. The zero value is set from the Props of the Handlers
+// it's first registered with.
+type State struct {
+	mu    sync.Mutex
+	ready bool
+	group ToggleGroupState
+}
 
-// ToggleGroupHandlers creates HTTP handlers for toggle group functionality
-func ToggleGroupHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+// init sets the state from props unless it's set already
+func (s *State) init(props Props) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ready {
+		return
+	}
+	s.ready = true
+	s.group = ToggleGroupState{
+		Type:   cmp.Or(props.Type, TypeSingle),
+		Values: slices.Clone(props.Value),
+	}
+}
+
+// Values returns the pressed values
+func (s *State) Values() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.group.Values)
+}
+
+// JSON returns the type and pressed values as JSON
+func (s *State) JSON() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := json.Marshal(map[string]interface{}{
+		"type":   s.group.Type,
+		"values": s.group.Values,
+	})
+	return string(data), err
+}
+
+// props applies update, when it isn't nil, and returns base with the
+// resulting values
+func (s *State) props(base Props, update func(state *ToggleGroupState)) Props {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if update != nil {
+		update(&s.group)
+	}
+	base.Type = s.group.Type
+	base.Value = slices.Clone(s.group.Values)
+	return base
+}
+
+// legacy holds the state of groups served by the deprecated
+// ToggleGroupHandlers, which GetToggleStateJSON reads by ID
+var legacy = struct {
+	sync.Mutex
+	states map[string]*State
+}{states: make(map[string]*State)}
+
+func legacyState(id string) *State {
+	legacy.Lock()
+	defer legacy.Unlock()
+	if legacy.states[id] == nil {
+		legacy.states[id] = &State{}
+	}
+	return legacy.states[id]
+}
+
+// Handlers serves the load and toggle endpoints of a toggle group. The paths
+// in HTMX are relative to the router the handlers are registered on.
+type Handlers struct {
+	Props Props     // Initial group state
+	HTMX  HTMXProps // Group ID and endpoint paths
+	State *State    // Values shared between requests (default: a new State per Register)
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Validate required paths
-	if htmxProps.LoadPath == "" {
-		panic("ToggleGroupHandlers: LoadPath is required")
+	if h.HTMX.LoadPath == "" {
+		panic("togglegroup.Handlers: LoadPath is required")
 	}
-	if htmxProps.TogglePath == "" {
-		panic("ToggleGroupHandlers: TogglePath is required")
+	if h.HTMX.TogglePath == "" {
+		panic("togglegroup.Handlers: TogglePath is required")
 	}
-	
+
+	baseProps := h.Props
+	htmxProps := h.HTMX
+	htmxProps.LoadPath = rt.Path(h.HTMX.LoadPath)
+	htmxProps.TogglePath = rt.Path(h.HTMX.TogglePath)
+
 	// Initialize state
-	if _, exists := toggleGroupStates[htmxProps.ID]; !exists {
-		toggleGroupStates[htmxProps.ID] = &ToggleGroupState{
-			Type:   baseProps.Type,
-			Values: baseProps.Value,
-		}
-		if toggleGroupStates[htmxProps.ID].Type == "" {
-			toggleGroupStates[htmxProps.ID].Type = TypeSingle
-		}
+	state := h.State
+	if state == nil {
+		state = &State{}
 	}
+	state.init(baseProps)
 
 	// Load handler
-	mux.HandleFunc(htmxProps.LoadPath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		state := toggleGroupStates[htmxProps.ID]
-		props := baseProps
-		props.Type = state.Type
-		props.Value = state.Values
-
+	rt.Handle(http.MethodGet, h.HTMX.LoadPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Render the toggle group with current state
-		renderToggleGroup(w, props, htmxProps)
-	})
+		renderToggleGroup(w, state.props(baseProps, nil), htmxProps)
+	}))
 
 	// Toggle handler
-	mux.HandleFunc(htmxProps.TogglePath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
+	rt.Handle(http.MethodPost, h.HTMX.TogglePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Parse the value
 		r.ParseForm()
 		value := r.FormValue("value")
-		
-		state := toggleGroupStates[htmxProps.ID]
-		
-		// Update state based on type
-		if state.Type == TypeSingle {
-			// Single selection - replace all values
-			if len(state.Values) > 0 && state.Values[0] == value {
-				// Clicking the same item deselects it
-				state.Values = []string{}
-			} else {
-				state.Values = []string{value}
+
+		props := state.props(baseProps, func(state *ToggleGroupState) {
+			// Update state based on type
+			if state.Type == TypeSingle {
+				// Single selection - replace all values
+				if len(state.Values) > 0 && state.Values[0] == value {
+					// Clicking the same item deselects it
+					state.Values = []string{}
+				} else {
+					state.Values = []string{value}
+				}
+				return
 			}
-		} else {
+
 			// Multiple selection - toggle the value
 			found := false
 			newValues := []string{}
@@ -213,14 +286,18 @@ func ToggleGroupHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProp
 				newValues = append(newValues, value)
 			}
 			state.Values = newValues
-		}
+		})
 
 		// Render updated toggle group
-		props := baseProps
-		props.Type = state.Type
-		props.Value = state.Values
 		renderToggleGroup(w, props, htmxProps)
-	})
+	}))
+}
+
+// ToggleGroupHandlers creates HTTP handlers for toggle group functionality
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func ToggleGroupHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+	Handlers{Props: baseProps, HTMX: htmxProps, State: legacyState(htmxProps.ID)}.Register(router.ServeMux(mux))
 }
 
 // Helper function to render toggle group (you would implement this based on your items)
@@ -281,16 +358,16 @@ func HTMXAlignment(htmxProps HTMXProps, defaultValue string) g.Node {
 	)
 }
 
-// GetToggleStateJSON returns the current state as JSON
+// GetToggleStateJSON returns the current state of a group served by
+// ToggleGroupHandlers as JSON
+//
+// Deprecated: Use State.JSON.
 func GetToggleStateJSON(id string) (string, error) {
-	state, exists := toggleGroupStates[id]
+	legacy.Lock()
+	state, exists := legacy.states[id]
+	legacy.Unlock()
 	if !exists {
 		return "", fmt.Errorf("toggle group %s not found", id)
 	}
-	
-	data, err := json.Marshal(map[string]interface{}{
-		"type":   state.Type,
-		"values": state.Values,
-	})
-	return string(data), err
-}
\ No newline at end of file
+	return state.JSON()
+}
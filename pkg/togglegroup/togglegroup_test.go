@@ -1,10 +1,17 @@
 package togglegroup_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 	"github.com/rizome-dev/shadcn-gomponents/pkg/togglegroup"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y"
+	"github.com/rizome-dev/shadcn-gomponents/lib/a11y/a11ytest"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func renderToString(node g.Node) string {
@@ -216,6 +223,18 @@ func TestToggleGroupItem(t *testing.T) {
 				`data-state="on"`,
 			},
 		},
+		{
+			name: "item with attrs",
+			item: togglegroup.Item(togglegroup.ItemProps{
+				Value: "bold",
+				Attrs: []g.Node{g.Attr("class", "ml-1"), g.Attr("data-testid", "bold")},
+			}, groupProps, g.Text("Bold")),
+			contains: []string{
+				`data-value="bold"`,
+				`data-testid="bold"`,
+				`first:border-l ml-1"`,
+			},
+		},
 		{
 			name: "item with pressed prop",
 			item: togglegroup.Item(togglegroup.ItemProps{
@@ -300,4 +319,93 @@ func TestToggleGroupItem(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestHandlersWithPrefix(t *testing.T) {
+	mux := http.NewServeMux()
+	router.Mount(router.WithPrefix(router.ServeMux(mux), "/ui"), togglegroup.Handlers{
+		HTMX: togglegroup.HTMXProps{
+			ID:         "prefixed-group",
+			LoadPath:   "/toggle-group/load",
+			TogglePath: "/toggle-group/toggle",
+		},
+	})
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui/toggle-group/load", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /ui/toggle-group/load = %d, want %d", w.Code, http.StatusOK)
+	}
+	if !strings.Contains(w.Body.String(), `hx-get="/ui/toggle-group/load"`) {
+		t.Errorf("expected rendered group to load from the prefixed path.\nGot: %s", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui/toggle-group/toggle", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET /ui/toggle-group/toggle = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandlersState(t *testing.T) {
+	state := &togglegroup.State{}
+	handlers := togglegroup.Handlers{
+		Props: togglegroup.Props{Type: togglegroup.TypeMultiple},
+		HTMX: togglegroup.HTMXProps{
+			ID:         "state-group",
+			LoadPath:   "/toggle-group/load",
+			TogglePath: "/toggle-group/toggle",
+		},
+		State: state,
+	}
+	mux := http.NewServeMux()
+	handlers.Register(router.ServeMux(mux))
+
+	values := []string{"bold", "italic", "underline", "strike"}
+	var wg sync.WaitGroup
+	for _, value := range values {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodPost, "/toggle-group/toggle", strings.NewReader("value="+value))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			mux.ServeHTTP(httptest.NewRecorder(), req)
+		}()
+	}
+	wg.Wait()
+
+	if got := state.Values(); len(got) != len(values) {
+		t.Errorf("expected every toggle to be kept, got %v", got)
+	}
+
+	handlers.State = nil
+	other := http.NewServeMux()
+	handlers.Register(router.ServeMux(other))
+	w := httptest.NewRecorder()
+	other.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/toggle-group/load", nil))
+	if strings.Contains(w.Body.String(), "data-value=") {
+		t.Errorf("expected handlers without a State to start empty, got %s", w.Body.String())
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": togglegroup.Example,
+	})
+}
+
+func TestKeyboardConformance(t *testing.T) {
+	props := togglegroup.Props{Value: []string{"center"}}
+	group := togglegroup.New(props,
+		togglegroup.Item(togglegroup.ItemProps{Value: "left", AriaLabel: "Align left"}, props, g.Text("L")),
+		togglegroup.Item(togglegroup.ItemProps{Value: "center", AriaLabel: "Align center"}, props, g.Text("C")),
+	)
+	a11ytest.Conformance(t, group, a11y.Options{Fragment: true}, []string{
+		`role="group"`,
+		`aria-pressed="true"`,
+		`data-roving-orientation="horizontal"`,
+		`data-roving-typeahead="false"`,
+		`tabindex="0" data-roving-item=""`,
+		"window.shadcnRoving",
+	}, nil)
+}
@@ -6,6 +6,7 @@ import (
 	g "maragu.dev/gomponents"
 	html "maragu.dev/gomponents/html"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
 )
 
 // Side defines the preferred side for the tooltip
@@ -29,29 +30,32 @@ const (
 
 // Props defines the properties for the Tooltip component
 type Props struct {
-	ID           string // HTML id attribute
-	Content      string // The tooltip content
-	Side         Side   // Preferred side: "top" | "right" | "bottom" | "left"
-	Align        Align  // Alignment: "start" | "center" | "end"
-	DelayMs      int    // Delay in milliseconds before showing
-	SideOffset   int    // Distance from trigger in pixels
-	AlignOffset  int    // Offset along the side in pixels
-	Class        string // Additional custom classes for content
-	ContentClass string // Classes specifically for content wrapper
-	ArrowClass   string // Classes for the arrow
-	Open         bool   // Whether tooltip is open (for controlled mode)
-	AsChild      bool   // Whether to render trigger as child
+	ID           string             // HTML id attribute
+	Content      string             // The tooltip content
+	Side         Side               // Preferred side: "top" | "right" | "bottom" | "left"
+	Align        Align              // Alignment: "start" | "center" | "end"
+	DelayMs      int                // Delay in milliseconds before showing
+	SideOffset   int                // Distance from trigger in pixels
+	AlignOffset  int                // Offset along the side in pixels
+	Class        string             // Additional custom classes for content
+	ContentClass string             // Classes specifically for content wrapper
+	ArrowClass   string             // Classes for the arrow
+	Open         bool               // Whether tooltip is open (for controlled mode)
+	AsChild      bool               // Whether to render trigger as child
+	Collision    floating.Collision // Viewport collision handling
+	Attrs        []g.Node           // Additional attributes to pass through
 }
 
 // TriggerProps defines properties for the tooltip trigger
 type TriggerProps struct {
-	ID        string // HTML id for the trigger
-	Class     string // Additional custom classes
-	AsChild   bool   // Whether to render as child
-	OnHover   string // JavaScript onMouseEnter handler
-	OnLeave   string // JavaScript onMouseLeave handler
-	OnFocus   string // JavaScript onFocus handler
-	OnBlur    string // JavaScript onBlur handler
+	ID      string   // HTML id for the trigger
+	Class   string   // Additional custom classes
+	AsChild bool     // Whether to render as child
+	OnHover string   // JavaScript onMouseEnter handler
+	OnLeave string   // JavaScript onMouseLeave handler
+	OnFocus string   // JavaScript onFocus handler
+	OnBlur  string   // JavaScript onBlur handler
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // New creates a tooltip wrapper with provider
@@ -78,7 +82,7 @@ func New(props Props, trigger, content g.Node) g.Node {
 	triggerID := fmt.Sprintf("%s-trigger", tooltipID)
 	contentID := fmt.Sprintf("%s-content", tooltipID)
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class("relative inline-flex"),
 		g.Attr("data-tooltip-container", "true"),
 		
@@ -90,8 +94,7 @@ func New(props Props, trigger, content g.Node) g.Node {
 			html.ID(contentID),
 			g.Attr("role", "tooltip"),
 			g.Attr("data-state", lib.CNIf(props.Open, "open", "closed")),
-			g.Attr("data-side", string(props.Side)),
-			g.Attr("data-align", string(props.Align)),
+			floating.Attrs(props.floatingProps()),
 			html.Class(lib.CN(
 				"tooltip-content",
 				"absolute z-50 w-max rounded-md bg-primary px-3 py-1.5 text-xs text-primary-foreground",
@@ -118,6 +121,7 @@ func New(props Props, trigger, content g.Node) g.Node {
 					getArrowClasses(props.Side),
 					props.ArrowClass,
 				)),
+				floating.Arrow(),
 			),
 		),
 		
@@ -131,7 +135,7 @@ func New(props Props, trigger, content g.Node) g.Node {
 				transition-delay: %dms;
 			}
 		`, triggerID, contentID, triggerID, contentID, triggerID, contentID, props.DelayMs)),
-	)
+	}, props.Attrs)...)
 }
 
 // Trigger creates a tooltip trigger element
@@ -170,7 +174,7 @@ func Trigger(props TriggerProps, children ...g.Node) g.Node {
 	}
 
 	return html.Span(
-		append(attrs, children...)...,
+		lib.MergeAttrs(append(attrs, children...), props.Attrs)...,
 	)
 }
 
@@ -213,6 +217,17 @@ func Provider(delayMs int, children ...g.Node) g.Node {
 
 // Helper functions
 
+// floatingProps returns the placement handed to the positioning script
+func (props Props) floatingProps() floating.Props {
+	return floating.Props{
+		Side:        string(props.Side),
+		Align:       string(props.Align),
+		SideOffset:  props.SideOffset,
+		AlignOffset: props.AlignOffset,
+		Collision:   props.Collision,
+	}
+}
+
 func getPositionClasses(side Side, align Align) string {
 	// Base positioning classes
 	switch side {
@@ -8,6 +8,8 @@ import (
 	html "maragu.dev/gomponents/html"
 	hx "maragu.dev/gomponents-htmx"
 	"github.com/rizome-dev/shadcn-gomponents/lib"
+	"github.com/rizome-dev/shadcn-gomponents/lib/floating"
+	"github.com/rizome-dev/shadcn-gomponents/lib/router"
 )
 
 // HTMXProps defines properties for HTMX-enhanced tooltips
@@ -57,7 +59,7 @@ func HTMXTooltip(props Props, htmxProps HTMXProps, trigger, content g.Node) g.No
 		)
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class("relative inline-flex"),
 		g.Attr("data-htmx-tooltip", "true"),
 		
@@ -71,8 +73,7 @@ func HTMXTooltip(props Props, htmxProps HTMXProps, trigger, content g.Node) g.No
 			html.ID(contentID),
 			g.Attr("role", "tooltip"),
 			g.Attr("data-state", lib.CNIf(props.Open, "open", "closed")),
-			g.Attr("data-side", string(props.Side)),
-			g.Attr("data-align", string(props.Align)),
+			floating.Attrs(props.floatingProps()),
 			html.Class(lib.CN(
 				"htmx-tooltip-content",
 				"absolute z-50 w-max rounded-md bg-primary px-3 py-1.5 text-xs text-primary-foreground",
@@ -100,6 +101,7 @@ func HTMXTooltip(props Props, htmxProps HTMXProps, trigger, content g.Node) g.No
 					getArrowClasses(props.Side),
 					props.ArrowClass,
 				)),
+				floating.Arrow(),
 			),
 		),
 		
@@ -118,7 +120,7 @@ func HTMXTooltip(props Props, htmxProps HTMXProps, trigger, content g.Node) g.No
 			}
 		`, tooltipID, contentID, tooltipID, contentID, tooltipID, contentID, 
 		   props.DelayMs, tooltipID, contentID)),
-	)
+	}, props.Attrs)...)
 }
 
 // HTMXTrigger creates an HTMX-enhanced tooltip trigger
@@ -149,21 +151,32 @@ func HTMXTrigger(htmxProps HTMXProps, children ...g.Node) g.Node {
 	)
 }
 
-// TooltipHandlers creates HTTP handlers for tooltip functionality
-func TooltipHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+// Handlers serves the show and hide endpoints of a tooltip. The paths in HTMX
+// are relative to the router the handlers are registered on.
+type Handlers struct {
+	Props Props     // Static tooltip content
+	HTMX  HTMXProps // Endpoint paths
+}
+
+// Register adds the routes to rt
+func (h Handlers) Register(rt router.Router) {
 	// Validate required paths
-	if htmxProps.ShowPath == "" && htmxProps.HidePath == "" {
-		panic("TooltipHandlers: At least one of ShowPath or HidePath is required")
+	if h.HTMX.ShowPath == "" && h.HTMX.HidePath == "" {
+		panic("tooltip.Handlers: At least one of ShowPath or HidePath is required")
+	}
+
+	baseProps := h.Props
+	htmxProps := h.HTMX
+	if h.HTMX.ShowPath != "" {
+		htmxProps.ShowPath = rt.Path(h.HTMX.ShowPath)
+	}
+	if h.HTMX.HidePath != "" {
+		htmxProps.HidePath = rt.Path(h.HTMX.HidePath)
 	}
 
 	// Handler to show tooltip content
 	if htmxProps.ShowPath != "" {
-		mux.HandleFunc(htmxProps.ShowPath, func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodGet {
-				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-				return
-			}
-
+		rt.Handle(http.MethodGet, h.HTMX.ShowPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// You can customize this to load dynamic content
 			// For now, return the static content
 			content := baseProps.Content
@@ -173,23 +186,25 @@ func TooltipHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
 
 			// Write the content
 			g.Text(content).Render(w)
-		})
+		}))
 	}
 
 	// Handler for hide events (if needed for tracking)
 	if htmxProps.HidePath != "" {
-		mux.HandleFunc(htmxProps.HidePath, func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodPost {
-				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-				return
-			}
-
+		rt.Handle(http.MethodPost, h.HTMX.HidePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Could log tooltip hide events or update state
 			w.WriteHeader(http.StatusOK)
-		})
+		}))
 	}
 }
 
+// TooltipHandlers creates HTTP handlers for tooltip functionality
+//
+// Deprecated: Use Handlers, which can be mounted on any router.Router.
+func TooltipHandlers(mux *http.ServeMux, baseProps Props, htmxProps HTMXProps) {
+	Handlers{Props: baseProps, HTMX: htmxProps}.Register(router.ServeMux(mux))
+}
+
 // HTMXDynamicTooltip creates a tooltip that loads content dynamically
 func HTMXDynamicTooltip(trigger g.Node, loadPath string) g.Node {
 	return HTMXTooltip(
@@ -290,14 +305,17 @@ func HTMXProgressTooltip(taskID string, trigger g.Node) g.Node {
 	)
 }
 
-// BatchTooltipHandler creates a handler for multiple tooltips with shared logic
-func BatchTooltipHandler(mux *http.ServeMux, basePath string, contentFunc func(string) g.Node) {
-	mux.HandleFunc(basePath, func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+// BatchHandler serves the content of many tooltips from one endpoint, keyed by
+// the "id" query parameter
+type BatchHandler struct {
+	Path    string                // Endpoint path, relative to the router
+	Content func(id string) g.Node // Returns nil for unknown tooltips
+}
 
+// Register adds the route to rt
+func (h BatchHandler) Register(rt router.Router) {
+	contentFunc := h.Content
+	rt.Handle(http.MethodGet, h.Path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Extract tooltip ID from query params or path
 		tooltipID := r.URL.Query().Get("id")
 		if tooltipID == "" {
@@ -314,5 +332,12 @@ func BatchTooltipHandler(mux *http.ServeMux, basePath string, contentFunc func(s
 
 		// Render the content
 		content.Render(w)
-	})
-}
\ No newline at end of file
+	}))
+}
+
+// BatchTooltipHandler creates a handler for multiple tooltips with shared logic
+//
+// Deprecated: Use BatchHandler, which can be mounted on any router.Router.
+func BatchTooltipHandler(mux *http.ServeMux, basePath string, contentFunc func(string) g.Node) {
+	BatchHandler{Path: basePath, Content: contentFunc}.Register(router.ServeMux(mux))
+}
@@ -8,8 +8,9 @@ import (
 
 // Props defines common properties for typography components
 type Props struct {
-	Class string      // Additional custom classes
-	ID    string      // HTML id attribute
+	Class string   // Additional custom classes
+	ID    string   // HTML id attribute
+	Attrs []g.Node // Additional attributes to pass through
 }
 
 // H1 creates a large heading
@@ -24,7 +25,7 @@ func H1(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.H1(append(attrs, children...)...)
+	return html.H1(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // H2 creates a section heading
@@ -39,7 +40,7 @@ func H2(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.H2(append(attrs, children...)...)
+	return html.H2(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // H3 creates a sub-section heading
@@ -54,7 +55,7 @@ func H3(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.H3(append(attrs, children...)...)
+	return html.H3(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // H4 creates a small heading
@@ -69,7 +70,7 @@ func H4(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.H4(append(attrs, children...)...)
+	return html.H4(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // P creates a paragraph
@@ -84,7 +85,7 @@ func P(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.P(append(attrs, children...)...)
+	return html.P(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Lead creates a lead paragraph (larger text)
@@ -99,7 +100,7 @@ func Lead(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.P(append(attrs, children...)...)
+	return html.P(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Large creates large text
@@ -114,7 +115,7 @@ func Large(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Div(append(attrs, children...)...)
+	return html.Div(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Small creates small text
@@ -129,7 +130,7 @@ func Small(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Small(append(attrs, children...)...)
+	return html.Small(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Muted creates muted text
@@ -144,7 +145,7 @@ func Muted(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.P(append(attrs, children...)...)
+	return html.P(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Blockquote creates a blockquote
@@ -159,7 +160,7 @@ func Blockquote(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return g.El("blockquote", append(attrs, children...)...)
+	return g.El("blockquote", lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // List creates an unordered list
@@ -174,7 +175,7 @@ func List(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Ul(append(attrs, children...)...)
+	return html.Ul(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // OrderedList creates an ordered list
@@ -189,7 +190,7 @@ func OrderedList(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Ol(append(attrs, children...)...)
+	return html.Ol(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // ListItem creates a list item
@@ -202,7 +203,7 @@ func ListItem(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Li(append(attrs, children...)...)
+	return html.Li(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // InlineCode creates inline code
@@ -217,7 +218,7 @@ func InlineCode(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Code(append(attrs, children...)...)
+	return html.Code(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Pre creates a preformatted text block
@@ -232,12 +233,12 @@ func Pre(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Pre(append(attrs, children...)...)
+	return html.Pre(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Code creates a code block (pre with code inside)
 func Code(props Props, children ...g.Node) g.Node {
-	return Pre(Props{Class: props.Class, ID: props.ID},
+	return Pre(Props{Class: props.Class, ID: props.ID, Attrs: props.Attrs},
 		html.Code(
 			html.Class("font-mono text-sm"),
 			g.Group(children),
@@ -249,7 +250,8 @@ func Code(props Props, children ...g.Node) g.Node {
 type TableProps struct {
 	Class   string
 	ID      string
-	Caption string // Optional table caption
+	Caption string   // Optional table caption
+	Attrs   []g.Node // Additional attributes to pass through
 }
 
 // Table creates a styled table
@@ -271,10 +273,10 @@ func Table(props TableProps, children ...g.Node) g.Node {
 		}, children...)
 	}
 
-	return html.Div(
+	return html.Div(lib.MergeAttrs([]g.Node{
 		html.Class("my-6 w-full overflow-y-auto"),
 		html.Table(append(attrs, tableChildren...)...),
-	)
+	}, props.Attrs)...)
 }
 
 // TableHeader creates a table header
@@ -287,7 +289,7 @@ func TableHeader(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return g.El("thead", append(attrs, children...)...)
+	return g.El("thead", lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // TableBody creates a table body
@@ -300,7 +302,7 @@ func TableBody(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return g.El("tbody", append(attrs, children...)...)
+	return g.El("tbody", lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // TableRow creates a table row
@@ -315,7 +317,7 @@ func TableRow(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Tr(append(attrs, children...)...)
+	return html.Tr(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // TableHead creates a table header cell
@@ -330,7 +332,7 @@ func TableHead(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Th(append(attrs, children...)...)
+	return html.Th(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // TableCell creates a table data cell
@@ -345,7 +347,7 @@ func TableCell(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Td(append(attrs, children...)...)
+	return html.Td(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Link creates a styled link
@@ -363,7 +365,7 @@ func Link(href string, props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.A(append(attrs, children...)...)
+	return html.A(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // ExternalLink creates a link that opens in a new tab
@@ -383,7 +385,7 @@ func ExternalLink(href string, props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.A(append(attrs, children...)...)
+	return html.A(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Mark creates highlighted text
@@ -398,7 +400,7 @@ func Mark(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Mark(append(attrs, children...)...)
+	return html.Mark(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Kbd creates keyboard input styling
@@ -413,7 +415,7 @@ func Kbd(props Props, children ...g.Node) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Kbd(append(attrs, children...)...)
+	return html.Kbd(lib.MergeAttrs(append(attrs, children...), props.Attrs)...)
 }
 
 // Hr creates a horizontal rule
@@ -428,5 +430,5 @@ func Hr(props Props) g.Node {
 		attrs = append(attrs, html.ID(props.ID))
 	}
 
-	return html.Hr(attrs...)
+	return html.Hr(lib.MergeAttrs(attrs, props.Attrs)...)
 }
\ No newline at end of file
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	g "maragu.dev/gomponents"
+	"github.com/rizome-dev/shadcn-gomponents/lib/snapshot"
 )
 
 func TestHeadings(t *testing.T) {
@@ -472,4 +473,10 @@ func TestHr(t *testing.T) {
 			t.Errorf("expected HTML to contain %q, but got:\n%s", want, html)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshot.Examples(t, map[string]func() g.Node{
+		"Example": Example,
+	})
+}
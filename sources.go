@@ -0,0 +1,10 @@
+// Package shadcn holds the sources of the component and lib packages, which
+// the shadcn-gomponents command copies into applications that want to own
+// and restyle their components.
+package shadcn
+
+//go:generate go run gen_sources.go
+
+// Module is the import path of this module, which copied sources are
+// rewritten away from
+const Module = "github.com/rizome-dev/shadcn-gomponents"
@@ -0,0 +1,75 @@
+// Code generated by gen_sources.go; DO NOT EDIT.
+
+package shadcn
+
+import "embed"
+
+// Sources holds the Go files of the pkg and lib packages, without their
+// tests
+//
+//go:embed lib/attrs.go lib/clock.go lib/cn.go lib/cookie.go lib/render.go lib/variants.go
+//go:embed lib/a11y/a11y.go lib/a11y/aria.go lib/a11y/middleware.go lib/a11y/rules.go
+//go:embed lib/catalog/catalog.go lib/catalog/gallery.go lib/catalog/source.go
+//go:embed lib/diff/diff.go
+//go:embed lib/flash/flash.go lib/flash/script.go
+//go:embed lib/floating/floating.go
+//go:embed lib/icons/icon.go lib/icons/icons.go
+//go:embed lib/modal/modal.go
+//go:embed lib/otp/issuer.go lib/otp/otp.go lib/otp/qr.go
+//go:embed lib/router/router.go
+//go:embed lib/roving/roving.go
+//go:embed lib/snapshot/normalize.go lib/snapshot/snapshot.go
+//go:embed lib/stream/script.go lib/stream/stream.go
+//go:embed pkg/accordion/accordion.go pkg/accordion/catalog.go pkg/accordion/example.go
+//go:embed pkg/alert/alert.go pkg/alert/catalog.go pkg/alert/example.go
+//go:embed pkg/alertdialog/alertdialog.go pkg/alertdialog/alertdialog_htmx.go pkg/alertdialog/catalog.go pkg/alertdialog/example.go pkg/alertdialog/handlers_example.go
+//go:embed pkg/all/all.go
+//go:embed pkg/aspectratio/aspectratio.go pkg/aspectratio/catalog.go pkg/aspectratio/example.go
+//go:embed pkg/avatar/avatar.go pkg/avatar/catalog.go pkg/avatar/example.go
+//go:embed pkg/badge/badge.go pkg/badge/catalog.go pkg/badge/example.go
+//go:embed pkg/breadcrumb/breadcrumb.go pkg/breadcrumb/catalog.go pkg/breadcrumb/example.go pkg/breadcrumb/routes.go
+//go:embed pkg/button/button.go pkg/button/catalog.go pkg/button/example.go
+//go:embed pkg/calendar/calendar.go pkg/calendar/calendar_htmx.go pkg/calendar/catalog.go pkg/calendar/example.go
+//go:embed pkg/card/card.go pkg/card/catalog.go pkg/card/example.go
+//go:embed pkg/carousel/carousel.go pkg/carousel/carousel_htmx.go pkg/carousel/catalog.go pkg/carousel/example.go
+//go:embed pkg/chart/catalog.go pkg/chart/chart.go pkg/chart/chart_htmx.go pkg/chart/example.go
+//go:embed pkg/checkbox/catalog.go pkg/checkbox/checkbox.go pkg/checkbox/example.go
+//go:embed pkg/collapsible/catalog.go pkg/collapsible/collapsible.go pkg/collapsible/collapsible_htmx.go pkg/collapsible/example.go
+//go:embed pkg/combobox/catalog.go pkg/combobox/combobox.go pkg/combobox/combobox_htmx.go pkg/combobox/example.go
+//go:embed pkg/command/catalog.go pkg/command/command.go pkg/command/command_htmx.go pkg/command/example.go
+//go:embed pkg/contextmenu/catalog.go pkg/contextmenu/contextmenu.go pkg/contextmenu/contextmenu_htmx.go pkg/contextmenu/example.go
+//go:embed pkg/datatable/catalog.go pkg/datatable/columns.go pkg/datatable/datatable.go pkg/datatable/edit.go pkg/datatable/example.go pkg/datatable/export.go pkg/datatable/group.go pkg/datatable/view.go
+//go:embed pkg/datepicker/catalog.go pkg/datepicker/datepicker.go pkg/datepicker/example.go
+//go:embed pkg/dialog/catalog.go pkg/dialog/dialog.go pkg/dialog/dialog_htmx.go pkg/dialog/example.go
+//go:embed pkg/drawer/catalog.go pkg/drawer/drawer.go pkg/drawer/drawer_htmx.go pkg/drawer/example.go
+//go:embed pkg/dropdownmenu/catalog.go pkg/dropdownmenu/dropdownmenu.go pkg/dropdownmenu/dropdownmenu_htmx.go pkg/dropdownmenu/example.go
+//go:embed pkg/form/catalog.go pkg/form/example.go pkg/form/form.go
+//go:embed pkg/hovercard/catalog.go pkg/hovercard/example.go pkg/hovercard/hovercard.go pkg/hovercard/hovercard_htmx.go
+//go:embed pkg/input/catalog.go pkg/input/example.go pkg/input/input.go
+//go:embed pkg/inputotp/catalog.go pkg/inputotp/example.go pkg/inputotp/inputotp.go pkg/inputotp/inputotp_htmx.go
+//go:embed pkg/label/catalog.go pkg/label/example.go pkg/label/label.go
+//go:embed pkg/menubar/catalog.go pkg/menubar/example.go pkg/menubar/menubar.go pkg/menubar/menubar_htmx.go
+//go:embed pkg/navigationmenu/catalog.go pkg/navigationmenu/example.go pkg/navigationmenu/navigationmenu.go pkg/navigationmenu/navigationmenu_htmx.go
+//go:embed pkg/pagination/catalog.go pkg/pagination/example.go pkg/pagination/model.go pkg/pagination/pagination.go
+//go:embed pkg/popover/catalog.go pkg/popover/example.go pkg/popover/popover.go pkg/popover/popover_htmx.go
+//go:embed pkg/progress/catalog.go pkg/progress/example.go pkg/progress/progress.go
+//go:embed pkg/radio/catalog.go pkg/radio/example.go pkg/radio/radio.go
+//go:embed pkg/resizable/catalog.go pkg/resizable/example.go pkg/resizable/resizable.go pkg/resizable/state.go
+//go:embed pkg/scrollarea/catalog.go pkg/scrollarea/example.go pkg/scrollarea/infinite.go pkg/scrollarea/scrollarea.go pkg/scrollarea/test.go
+//go:embed pkg/selector/catalog.go pkg/selector/example.go pkg/selector/listbox.go pkg/selector/select.go
+//go:embed pkg/separator/catalog.go pkg/separator/example.go pkg/separator/separator.go
+//go:embed pkg/sheet/catalog.go pkg/sheet/example.go pkg/sheet/sheet.go pkg/sheet/sheet_htmx.go
+//go:embed pkg/sidebar/catalog.go pkg/sidebar/example.go pkg/sidebar/sidebar.go pkg/sidebar/sidebar_htmx.go pkg/sidebar/state.go
+//go:embed pkg/skeleton/catalog.go pkg/skeleton/example.go pkg/skeleton/skeleton.go
+//go:embed pkg/slider/catalog.go pkg/slider/example.go pkg/slider/slider.go pkg/slider/slider_htmx.go
+//go:embed pkg/sonner/catalog.go pkg/sonner/example.go pkg/sonner/sonner.go pkg/sonner/sonner_htmx.go
+//go:embed pkg/switch/catalog.go pkg/switch/example.go pkg/switch/switch.go
+//go:embed pkg/table/catalog.go pkg/table/example.go pkg/table/table.go pkg/table/table_htmx.go
+//go:embed pkg/tabs/catalog.go pkg/tabs/example.go pkg/tabs/tabs.go
+//go:embed pkg/textarea/catalog.go pkg/textarea/example.go pkg/textarea/textarea.go
+//go:embed pkg/toast/catalog.go pkg/toast/example.go pkg/toast/promise.go pkg/toast/toast.go pkg/toast/toast_htmx.go
+//go:embed pkg/toggle/catalog.go pkg/toggle/example.go pkg/toggle/toggle.go
+//go:embed pkg/togglegroup/catalog.go pkg/togglegroup/example.go pkg/togglegroup/togglegroup.go pkg/togglegroup/togglegroup_htmx.go
+//go:embed pkg/tooltip/catalog.go pkg/tooltip/example.go pkg/tooltip/tooltip.go pkg/tooltip/tooltip_htmx.go
+//go:embed pkg/typography/catalog.go pkg/typography/example.go pkg/typography/typography.go
+var Sources embed.FS
@@ -0,0 +1,42 @@
+package shadcn_test
+
+import (
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	shadcn "github.com/rizome-dev/shadcn-gomponents"
+)
+
+func TestSources(t *testing.T) {
+	var embedded []string
+	err := fs.WalkDir(shadcn.Sources, ".", func(name string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			embedded = append(embedded, name)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want []string
+	for _, pattern := range []string{"lib/*.go", "lib/*/*.go", "pkg/*/*.go"} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range matches {
+			if !strings.HasSuffix(name, "_test.go") {
+				want = append(want, filepath.ToSlash(name))
+			}
+		}
+	}
+	slices.Sort(embedded)
+	slices.Sort(want)
+	if !slices.Equal(embedded, want) {
+		t.Errorf("expected %d embedded sources, got %d; run go generate", len(want), len(embedded))
+	}
+}